@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -31,10 +32,29 @@ func (s *Server) CreateAccount(ctx context.Context, req *pb.CreateAccountRequest
 		return nil, status.Error(codes.PermissionDenied, "no permission to create an account for other users")
 	}
 
+	product, err := s.store.GetAccountProduct(ctx, req.GetProductCode())
+	if err == nil && product.Internal {
+		err = pgx.ErrNoRows
+	}
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, invalidArgumentsError([]*errdetails.BadRequest_FieldViolation{
+				fieldViolation("product_code", fmt.Errorf("unknown product")),
+			})
+		}
+		return nil, status.Errorf(codes.Internal, "failed to retrieve account product: %s", err)
+	}
+
+	if !product.AllowsCurrency(req.GetCurrency()) {
+		return nil, invalidArgumentsError([]*errdetails.BadRequest_FieldViolation{
+			fieldViolation("currency", fmt.Errorf("not offered for product %s", product.Code)),
+		})
+	}
+
 	account, err := s.store.CreateAccount(ctx, db.CreateAccountParams{
-		OwnerID:  req.GetOwnerId(),
-		Currency: req.GetCurrency(),
-		Balance:  0,
+		OwnerID:     req.GetOwnerId(),
+		Currency:    req.GetCurrency(),
+		ProductCode: product.Code,
 	})
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -48,6 +68,7 @@ func (s *Server) CreateAccount(ctx context.Context, req *pb.CreateAccountRequest
 		return nil, status.Errorf(codes.Internal, "failed to create account: %s", err)
 	}
 
+	auditTarget(ctx, "account", account.ID, nil, auditAccountFields(account))
 	return &pb.CreateAccountResponse{Account: convertAccount(account)}, nil
 }
 
@@ -62,6 +83,10 @@ func validateCreateAccountRequest(req *pb.CreateAccountRequest) []*errdetails.Ba
 		violations = append(violations, fieldViolation("currency", err))
 	}
 
+	if err := validator.ValidateString(req.GetProductCode(), 1, 50); err != nil {
+		violations = append(violations, fieldViolation("product_code", err))
+	}
+
 	return violations
 }
 
@@ -113,8 +138,16 @@ func (s *Server) GetAccounts(ctx context.Context, req *pb.GetAccountsRequest) (*
 		return nil, invalidArgumentsError(violations)
 	}
 
+	ownerID := payload.UserID
+	if req.OwnerId != nil {
+		if payload.Role != utils.BankerRole && payload.UserID != req.GetOwnerId() {
+			return nil, status.Error(codes.PermissionDenied, "no permission to retrieve accounts that do not belong to you")
+		}
+		ownerID = req.GetOwnerId()
+	}
+
 	accounts, err := s.store.ListAccount(ctx, db.ListAccountParams{
-		OwnerID: payload.UserID,
+		OwnerID: ownerID,
 		Limit:   req.GetLimit(),
 		Offset:  req.GetOffset(),
 	})
@@ -122,10 +155,6 @@ func (s *Server) GetAccounts(ctx context.Context, req *pb.GetAccountsRequest) (*
 		return nil, status.Errorf(codes.Internal, "failed to retrieve account: %s", err)
 	}
 
-	if payload.Role != utils.BankerRole && payload.UserID != accounts[0].OwnerID {
-		return nil, status.Error(codes.PermissionDenied, "no permission to retrieve accounts that do not belong to you")
-	}
-
 	pbAccounts := []*pb.Account{}
 	for _, account := range accounts {
 		pbAccounts = append(pbAccounts, convertAccount(account))
@@ -140,6 +169,12 @@ func validateGetAccountsRequest(req *pb.GetAccountsRequest) []*errdetails.BadReq
 	defaultLimit := int32(5)
 	defaultOffset := int32(0)
 
+	if req.OwnerId != nil {
+		if err := validator.ValidateID(req.GetOwnerId()); err != nil {
+			violations = append(violations, fieldViolation("owner_id", err))
+		}
+	}
+
 	if req.Limit != nil {
 		if err := validator.ValidateLimit(req.GetLimit()); err != nil {
 			violations = append(violations, fieldViolation("limit", err))
@@ -161,10 +196,13 @@ func validateGetAccountsRequest(req *pb.GetAccountsRequest) []*errdetails.BadReq
 
 func convertAccount(account db.Account) *pb.Account {
 	return &pb.Account{
-		Id:        account.ID,
-		OwnerId:   account.OwnerID,
-		Balance:   account.Balance,
-		Currency:  account.Currency,
-		CreatedAt: timestamppb.New(account.CreatedAt),
+		Id:           account.ID,
+		OwnerId:      account.OwnerID,
+		Balance:      account.Balance,
+		Currency:     account.Currency,
+		CreatedAt:    timestamppb.New(account.CreatedAt),
+		Status:       accountStatuses[account.Status],
+		StatusReason: account.StatusReason,
+		ProductCode:  account.ProductCode,
 	}
 }
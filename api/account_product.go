@@ -0,0 +1,65 @@
+package api
+
+import (
+	"context"
+
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var accountTypes = map[db.AccountType]pb.AccountType{
+	db.AccountTypeChecking: pb.AccountType_ACCOUNT_TYPE_CHECKING,
+	db.AccountTypeSavings:  pb.AccountType_ACCOUNT_TYPE_SAVINGS,
+	db.AccountTypeBusiness: pb.AccountType_ACCOUNT_TYPE_BUSINESS,
+}
+
+func (s *Server) ListAccountProducts(ctx context.Context, req *pb.ListAccountProductsRequest) (*pb.ListAccountProductsResponse, error) {
+	_, err := s.authorizeUser(ctx, utils.SelfAndBanker)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	products, err := s.store.ListAccountProducts(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list account products: %s", err)
+	}
+
+	rsp := &pb.ListAccountProductsResponse{Products: []*pb.AccountProduct{}}
+	for _, product := range products {
+		rsp.Products = append(rsp.Products, convertAccountProduct(product))
+	}
+	return rsp, nil
+}
+
+func convertAccountProduct(product db.AccountProduct) *pb.AccountProduct {
+	pbProduct := &pb.AccountProduct{
+		Code:              product.Code,
+		Name:              product.Name,
+		Type:              accountTypes[product.Type],
+		AllowedCurrencies: product.AllowedCurrencies,
+		MinBalance:        product.MinBalance,
+		OverdraftLimit:    product.OverdraftLimit,
+	}
+	if product.MonthlyWithdrawalLimit.Valid {
+		pbProduct.MonthlyWithdrawalLimit = &product.MonthlyWithdrawalLimit.Int32
+	}
+	if product.PerTransferLimit.Valid {
+		pbProduct.PerTransferLimit = &product.PerTransferLimit.Int32
+	}
+	if product.DailyAmountLimit.Valid {
+		pbProduct.DailyAmountLimit = &product.DailyAmountLimit.Int32
+	}
+	if product.DailyCountLimit.Valid {
+		pbProduct.DailyCountLimit = &product.DailyCountLimit.Int32
+	}
+	if product.MonthlyAmountLimit.Valid {
+		pbProduct.MonthlyAmountLimit = &product.MonthlyAmountLimit.Int32
+	}
+	if product.MonthlyCountLimit.Valid {
+		pbProduct.MonthlyCountLimit = &product.MonthlyCountLimit.Int32
+	}
+	return pbProduct
+}
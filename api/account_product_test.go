@@ -0,0 +1,105 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/token"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListAccountProducts(t *testing.T) {
+	user, _ := randomUser(t)
+
+	savings := db.AccountProduct{
+		Code:                   "savings",
+		Name:                   "High Yield Savings",
+		Type:                   db.AccountTypeSavings,
+		AllowedCurrencies:      []string{"USD"},
+		MonthlyWithdrawalLimit: pgtype.Int4{Int32: 6, Valid: true},
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.TokenMaker) context.Context
+		checkResponse func(t *testing.T, res *pb.ListAccountProductsResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAccountProducts(gomock.Any()).
+					Times(1).
+					Return([]db.AccountProduct{randomAccountProduct(), savings}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAccountProductsResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetProducts(), 2)
+
+				require.Nil(t, res.GetProducts()[0].MonthlyWithdrawalLimit)
+				require.Equal(t, pb.AccountType_ACCOUNT_TYPE_SAVINGS, res.GetProducts()[1].GetType())
+				require.Equal(t, int32(6), res.GetProducts()[1].GetMonthlyWithdrawalLimit())
+			},
+		},
+		{
+			name: "MissingAuthorization",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAccountProducts(gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAccountProductsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAccountProducts(gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAccountProductsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		res, err := server.ListAccountProducts(testCase.buildContext(t, server.tokenMaker), &pb.ListAccountProductsRequest{})
+		testCase.checkResponse(t, res, err)
+	}
+}
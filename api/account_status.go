@@ -0,0 +1,274 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/utils"
+	"github.com/valkyraycho/bank_project/validator"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var accountStatuses = map[db.AccountStatus]pb.AccountStatus{
+	db.AccountStatusActive: pb.AccountStatus_ACCOUNT_STATUS_ACTIVE,
+	db.AccountStatusFrozen: pb.AccountStatus_ACCOUNT_STATUS_FROZEN,
+	db.AccountStatusClosed: pb.AccountStatus_ACCOUNT_STATUS_CLOSED,
+}
+
+func (s *Server) FreezeAccount(ctx context.Context, req *pb.FreezeAccountRequest) (*pb.FreezeAccountResponse, error) {
+	payload, err := s.authorizeUser(ctx, []string{utils.BankerRole})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	violations := validateAccountStatusChange(req.GetId(), req.GetReason())
+	if len(violations) > 0 {
+		return nil, invalidArgumentsError(violations)
+	}
+
+	account, err := s.getAccountInStatus(ctx, req.GetId(), db.AccountStatusActive)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err = s.changeAccountStatus(ctx, account, db.AccountStatusFrozen, req.GetReason(), payload.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FreezeAccountResponse{Account: convertAccount(account)}, nil
+}
+
+func (s *Server) UnfreezeAccount(ctx context.Context, req *pb.UnfreezeAccountRequest) (*pb.UnfreezeAccountResponse, error) {
+	payload, err := s.authorizeUser(ctx, []string{utils.BankerRole})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	violations := validateAccountStatusChange(req.GetId(), req.GetReason())
+	if len(violations) > 0 {
+		return nil, invalidArgumentsError(violations)
+	}
+
+	account, err := s.getAccountInStatus(ctx, req.GetId(), db.AccountStatusFrozen)
+	if err != nil {
+		return nil, err
+	}
+
+	if account.Balance > s.cfg.ApprovalThreshold {
+		request, err := s.store.RequestApprovalTx(ctx, db.RequestApprovalTxParams{
+			Action:      db.ApprovalActionUnfreezeAccount,
+			Payload:     db.UnfreezeAccountApproval{AccountID: account.ID, Reason: req.GetReason()},
+			Summary:     fmt.Sprintf("unfreeze account %d holding %d %s", account.ID, account.Balance, account.Currency),
+			RequestedBy: payload.UserID,
+			ExpiresAt:   time.Now().Add(s.cfg.ApprovalTTL),
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to request approval: %s", err)
+		}
+
+		auditTarget(ctx, "approval_request", request.ID, nil, auditApprovalFields(request))
+		return &pb.UnfreezeAccountResponse{ApprovalRequest: convertApprovalRequest(request)}, nil
+	}
+
+	account, err = s.changeAccountStatus(ctx, account, db.AccountStatusActive, req.GetReason(), payload.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UnfreezeAccountResponse{Account: convertAccount(account)}, nil
+}
+
+func (s *Server) getAccountInStatus(ctx context.Context, id int32, accountStatus db.AccountStatus) (db.Account, error) {
+	account, err := s.store.GetAccount(ctx, id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return db.Account{}, status.Errorf(codes.NotFound, "account not found: %s", err)
+		}
+		return db.Account{}, status.Errorf(codes.Internal, "failed to retrieve account: %s", err)
+	}
+
+	if account.Status != accountStatus {
+		return db.Account{}, status.Errorf(codes.FailedPrecondition, "account is %s", account.Status)
+	}
+
+	if err := s.checkCustomerAccount(ctx, account); err != nil {
+		return db.Account{}, err
+	}
+	return account, nil
+}
+
+// checkCustomerAccount refuses status changes on the bank's internal
+// accounts, which interest, fee and cash postings rely on.
+func (s *Server) checkCustomerAccount(ctx context.Context, account db.Account) error {
+	product, err := s.store.GetAccountProduct(ctx, account.ProductCode)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to retrieve account product: %s", err)
+	}
+	if product.Internal {
+		return status.Errorf(codes.FailedPrecondition, "status changes are not allowed on internal account %d", account.ID)
+	}
+	return nil
+}
+
+// changeAccountStatus moves an account to another status. The update only
+// applies while the account is still in the status it was read with, so a
+// concurrent change is reported instead of overwritten.
+func (s *Server) changeAccountStatus(ctx context.Context, before db.Account, to db.AccountStatus, reason string, actorID int32) (db.Account, error) {
+	account, err := s.store.UpdateAccountStatus(ctx, db.UpdateAccountStatusParams{
+		ID:              before.ID,
+		CurrentStatus:   before.Status,
+		Status:          to,
+		StatusReason:    reason,
+		StatusChangedBy: pgtype.Int4{Int32: actorID, Valid: true},
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return db.Account{}, status.Error(codes.Aborted, "account status changed concurrently")
+		}
+		return db.Account{}, status.Errorf(codes.Internal, "failed to update account status: %s", err)
+	}
+
+	auditTarget(ctx, "account", account.ID, auditAccountFields(before), auditAccountFields(account))
+	return account, nil
+}
+
+func validateAccountStatusChange(id int32, reason string) []*errdetails.BadRequest_FieldViolation {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+
+	if err := validator.ValidateID(id); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	if err := validator.ValidateString(reason, 1, 200); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+
+	return violations
+}
+
+func (s *Server) CloseAccount(ctx context.Context, req *pb.CloseAccountRequest) (*pb.CloseAccountResponse, error) {
+	payload, err := s.authorizeUser(ctx, utils.SelfAndBanker)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	violations := validateCloseAccountRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentsError(violations)
+	}
+
+	account, err := s.store.GetAccount(ctx, req.GetId())
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "account not found: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to retrieve account: %s", err)
+	}
+
+	if payload.Role != utils.BankerRole {
+		if payload.UserID != account.OwnerID {
+			return nil, status.Error(codes.PermissionDenied, "no permission to close an account that does not belong to you")
+		}
+		if account.Status == db.AccountStatusFrozen {
+			return nil, status.Error(codes.FailedPrecondition, "a frozen account can only be closed by a banker")
+		}
+	}
+
+	if err := s.checkCustomerAccount(ctx, account); err != nil {
+		return nil, err
+	}
+
+	if req.SweepToAccountId != nil {
+		sweepAccount, err := s.store.GetAccount(ctx, req.GetSweepToAccountId())
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				return nil, status.Errorf(codes.NotFound, "sweep account not found: %s", err)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to retrieve sweep account: %s", err)
+		}
+
+		if sweepAccount.OwnerID != account.OwnerID {
+			if payload.Role != utils.BankerRole {
+				return nil, status.Error(codes.PermissionDenied, "the balance can only be swept to another account of yours")
+			}
+
+			request, err := s.store.RequestApprovalTx(ctx, db.RequestApprovalTxParams{
+				Action: db.ApprovalActionCloseAccount,
+				Payload: db.CloseAccountApproval{
+					AccountID:        account.ID,
+					SweepToAccountID: sweepAccount.ID,
+					Reason:           req.GetReason(),
+				},
+				Summary:     fmt.Sprintf("close account %d holding %d %s and sweep it to account %d of user %d", account.ID, account.Balance, account.Currency, sweepAccount.ID, sweepAccount.OwnerID),
+				RequestedBy: payload.UserID,
+				ExpiresAt:   time.Now().Add(s.cfg.ApprovalTTL),
+			})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to request approval: %s", err)
+			}
+
+			auditTarget(ctx, "approval_request", request.ID, nil, auditApprovalFields(request))
+			return &pb.CloseAccountResponse{ApprovalRequest: convertApprovalRequest(request)}, nil
+		}
+	}
+
+	result, err := s.store.CloseAccountTx(ctx, db.CloseAccountTxParams{
+		AccountID:        req.GetId(),
+		SweepToAccountID: req.GetSweepToAccountId(),
+		ClosedBy:         payload.UserID,
+		Reason:           req.GetReason(),
+	})
+	if err != nil {
+		var notActive *db.AccountNotActiveError
+		var screeningErr *db.OwnerScreeningError
+		switch {
+		case err == pgx.ErrNoRows:
+			return nil, status.Errorf(codes.NotFound, "account not found: %s", err)
+		case errors.As(err, &notActive),
+			errors.As(err, &screeningErr),
+			errors.Is(err, db.ErrAccountHasBalance),
+			errors.Is(err, db.ErrCurrencyMismatch),
+			errors.Is(err, db.ErrSweepToOtherOwner):
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot close account: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to close account: %s", err)
+	}
+
+	auditTarget(ctx, "account", account.ID, auditAccountFields(account), auditAccountFields(result.Account))
+
+	rsp := &pb.CloseAccountResponse{Account: convertAccount(result.Account)}
+	if result.Sweep != nil {
+		rsp.SweepAccount = convertAccount(result.Sweep.ToAccount)
+		rsp.SweptAmount = result.Sweep.Transfer.Amount
+	}
+	return rsp, nil
+}
+
+func validateCloseAccountRequest(req *pb.CloseAccountRequest) []*errdetails.BadRequest_FieldViolation {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+
+	if err := validator.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	if req.SweepToAccountId != nil {
+		if err := validator.ValidateID(req.GetSweepToAccountId()); err != nil {
+			violations = append(violations, fieldViolation("sweep_to_account_id", err))
+		} else if req.GetSweepToAccountId() == req.GetId() {
+			violations = append(violations, fieldViolation("sweep_to_account_id", errors.New("must be a different account")))
+		}
+	}
+
+	if err := validator.ValidateString(req.GetReason(), 0, 200); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+
+	return violations
+}
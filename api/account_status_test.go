@@ -0,0 +1,720 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/token"
+	"github.com/valkyraycho/bank_project/utils"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFreezeAccount(t *testing.T) {
+	user, account := randomAccount(t)
+	banker, _ := randomUser(t)
+	banker.Role = utils.BankerRole
+
+	reason := "suspected card fraud"
+	frozenAccount := account
+	frozenAccount.Status = db.AccountStatusFrozen
+	frozenAccount.StatusReason = reason
+
+	testCases := []struct {
+		name          string
+		req           *pb.FreezeAccountRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.TokenMaker) context.Context
+		checkResponse func(t *testing.T, res *pb.FreezeAccountResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.FreezeAccountRequest{Id: account.ID, Reason: reason},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(account.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
+				store.EXPECT().
+					UpdateAccountStatus(gomock.Any(), gomock.Eq(db.UpdateAccountStatusParams{
+						ID:              account.ID,
+						CurrentStatus:   db.AccountStatusActive,
+						Status:          db.AccountStatusFrozen,
+						StatusReason:    reason,
+						StatusChangedBy: pgtype.Int4{Int32: banker.ID, Valid: true},
+					})).
+					Times(1).
+					Return(frozenAccount, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.FreezeAccountResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, pb.AccountStatus_ACCOUNT_STATUS_FROZEN, res.GetAccount().GetStatus())
+				require.Equal(t, reason, res.GetAccount().GetStatusReason())
+			},
+		},
+		{
+			name: "CustomerNotAllowed",
+			req:  &pb.FreezeAccountRequest{Id: account.ID, Reason: reason},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.FreezeAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name: "MissingReason",
+			req:  &pb.FreezeAccountRequest{Id: account.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.FreezeAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "NotFound",
+			req:  &pb.FreezeAccountRequest{Id: account.ID, Reason: reason},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(db.Account{}, pgx.ErrNoRows)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.FreezeAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name: "InternalAccount",
+			req:  &pb.FreezeAccountRequest{Id: account.ID, Reason: reason},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				internal := randomAccountProduct()
+				internal.Internal = true
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(account.ProductCode)).
+					Times(1).
+					Return(internal, nil)
+
+				store.EXPECT().
+					UpdateAccountStatus(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.FreezeAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "AlreadyFrozen",
+			req:  &pb.FreezeAccountRequest{Id: account.ID, Reason: reason},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(frozenAccount, nil)
+
+				store.EXPECT().
+					UpdateAccountStatus(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.FreezeAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "ChangedConcurrently",
+			req:  &pb.FreezeAccountRequest{Id: account.ID, Reason: reason},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(account.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
+				store.EXPECT().
+					UpdateAccountStatus(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Account{}, pgx.ErrNoRows)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.FreezeAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Aborted, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		res, err := server.FreezeAccount(testCase.buildContext(t, server.tokenMaker), testCase.req)
+		testCase.checkResponse(t, res, err)
+	}
+}
+
+func TestUnfreezeAccount(t *testing.T) {
+	_, account := randomAccount(t)
+	banker, _ := randomUser(t)
+	banker.Role = utils.BankerRole
+
+	reason := "customer identity confirmed"
+	frozenAccount := account
+	frozenAccount.Status = db.AccountStatusFrozen
+
+	testCases := []struct {
+		name          string
+		req           *pb.UnfreezeAccountRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.TokenMaker) context.Context
+		checkResponse func(t *testing.T, res *pb.UnfreezeAccountResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.UnfreezeAccountRequest{Id: account.ID, Reason: reason},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(frozenAccount, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(account.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
+				store.EXPECT().
+					UpdateAccountStatus(gomock.Any(), gomock.Eq(db.UpdateAccountStatusParams{
+						ID:              account.ID,
+						CurrentStatus:   db.AccountStatusFrozen,
+						Status:          db.AccountStatusActive,
+						StatusReason:    reason,
+						StatusChangedBy: pgtype.Int4{Int32: banker.ID, Valid: true},
+					})).
+					Times(1).
+					Return(account, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UnfreezeAccountResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, pb.AccountStatus_ACCOUNT_STATUS_ACTIVE, res.GetAccount().GetStatus())
+			},
+		},
+		{
+			name: "AboveThresholdNeedsApproval",
+			req:  &pb.UnfreezeAccountRequest{Id: account.ID, Reason: reason},
+			buildStubs: func(store *mockdb.MockStore) {
+				wealthy := frozenAccount
+				wealthy.Balance = 5000
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(wealthy, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(account.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
+				store.EXPECT().
+					UpdateAccountStatus(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					RequestApprovalTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, args db.RequestApprovalTxParams) (db.ApprovalRequest, error) {
+						require.Equal(t, db.ApprovalActionUnfreezeAccount, args.Action)
+						require.Equal(t, db.UnfreezeAccountApproval{AccountID: account.ID, Reason: reason}, args.Payload)
+						require.Equal(t, banker.ID, args.RequestedBy)
+						return db.ApprovalRequest{
+							ID:          1,
+							Action:      args.Action,
+							Payload:     []byte(`{}`),
+							Status:      db.ApprovalStatusPending,
+							RequestedBy: args.RequestedBy,
+							ExpiresAt:   args.ExpiresAt,
+						}, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UnfreezeAccountResponse, err error) {
+				require.NoError(t, err)
+				require.Nil(t, res.GetAccount())
+				require.Equal(t, pb.ApprovalStatus_APPROVAL_STATUS_PENDING, res.GetApprovalRequest().GetStatus())
+			},
+		},
+		{
+			name: "NotFrozen",
+			req:  &pb.UnfreezeAccountRequest{Id: account.ID, Reason: reason},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					UpdateAccountStatus(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UnfreezeAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		server.cfg.ApprovalThreshold = 1000
+		res, err := server.UnfreezeAccount(testCase.buildContext(t, server.tokenMaker), testCase.req)
+		testCase.checkResponse(t, res, err)
+	}
+}
+
+func TestCloseAccount(t *testing.T) {
+	user, account := randomAccount(t)
+	account.Balance = 100
+	_, sweepAccount := randomAccount(t)
+	sweepAccount.OwnerID = user.ID
+	_, otherAccount := randomAccount(t)
+	banker, _ := randomUser(t)
+	banker.Role = utils.BankerRole
+
+	sweepToID := sweepAccount.ID + 100
+	sameID := account.ID
+
+	closedAccount := account
+	closedAccount.Balance = 0
+	closedAccount.Status = db.AccountStatusClosed
+
+	frozenAccount := account
+	frozenAccount.Status = db.AccountStatusFrozen
+
+	testCases := []struct {
+		name          string
+		req           *pb.CloseAccountRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.TokenMaker) context.Context
+		checkResponse func(t *testing.T, res *pb.CloseAccountResponse, err error)
+	}{
+		{
+			name: "SweepBalance",
+			req:  &pb.CloseAccountRequest{Id: account.ID, SweepToAccountId: &sweepToID, Reason: "moving abroad"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(account.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
+				sweptAccount := sweepAccount
+				sweptAccount.ID = sweepToID
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(sweepToID)).
+					Times(1).
+					Return(sweptAccount, nil)
+
+				sweptAccount.Balance = account.Balance
+
+				store.EXPECT().
+					CloseAccountTx(gomock.Any(), gomock.Eq(db.CloseAccountTxParams{
+						AccountID:        account.ID,
+						SweepToAccountID: sweepToID,
+						ClosedBy:         user.ID,
+						Reason:           "moving abroad",
+					})).
+					Times(1).
+					Return(db.CloseAccountTxResult{
+						Account: closedAccount,
+						Sweep: &db.TransferTxResult{
+							Transfer:  db.Transfer{FromAccountID: account.ID, ToAccountID: sweepToID, Amount: account.Balance},
+							ToAccount: sweptAccount,
+						},
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, pb.AccountStatus_ACCOUNT_STATUS_CLOSED, res.GetAccount().GetStatus())
+				require.Equal(t, account.Balance, res.GetSweptAmount())
+				require.Equal(t, sweepToID, res.GetSweepAccount().GetId())
+			},
+		},
+		{
+			name: "SweepToOtherOwner",
+			req:  &pb.CloseAccountRequest{Id: account.ID, SweepToAccountId: &otherAccount.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(account.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(otherAccount.ID)).
+					Times(1).
+					Return(otherAccount, nil)
+
+				store.EXPECT().
+					CloseAccountTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "BankerSweepToOtherOwnerRequiresApproval",
+			req:  &pb.CloseAccountRequest{Id: account.ID, SweepToAccountId: &otherAccount.ID, Reason: "estate settled"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(account.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(otherAccount.ID)).
+					Times(1).
+					Return(otherAccount, nil)
+
+				store.EXPECT().
+					CloseAccountTx(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					RequestApprovalTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, args db.RequestApprovalTxParams) (db.ApprovalRequest, error) {
+						require.Equal(t, db.ApprovalActionCloseAccount, args.Action)
+						require.Equal(t, db.CloseAccountApproval{
+							AccountID:        account.ID,
+							SweepToAccountID: otherAccount.ID,
+							Reason:           "estate settled",
+						}, args.Payload)
+						require.Equal(t, banker.ID, args.RequestedBy)
+						return db.ApprovalRequest{
+							ID:          1,
+							Action:      args.Action,
+							Payload:     []byte(`{}`),
+							Status:      db.ApprovalStatusPending,
+							RequestedBy: args.RequestedBy,
+							ExpiresAt:   args.ExpiresAt,
+						}, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				require.NoError(t, err)
+				require.Nil(t, res.GetAccount())
+				require.Equal(t, pb.ApprovalStatus_APPROVAL_STATUS_PENDING, res.GetApprovalRequest().GetStatus())
+			},
+		},
+		{
+			name: "SweepBlockedByOwnerScreening",
+			req:  &pb.CloseAccountRequest{Id: account.ID, SweepToAccountId: &sweepAccount.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(account.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(sweepAccount.ID)).
+					Times(1).
+					Return(sweepAccount, nil)
+
+				store.EXPECT().
+					CloseAccountTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CloseAccountTxResult{}, &db.OwnerScreeningError{AccountID: account.ID, Status: db.ScreeningStatusPendingReview})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "BalanceNotSwept",
+			req:  &pb.CloseAccountRequest{Id: account.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(account.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
+				store.EXPECT().
+					CloseAccountTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CloseAccountTxResult{}, db.ErrAccountHasBalance)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "InternalAccount",
+			req:  &pb.CloseAccountRequest{Id: account.ID, SweepToAccountId: &sweepToID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				internal := randomAccountProduct()
+				internal.Internal = true
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(account.ProductCode)).
+					Times(1).
+					Return(internal, nil)
+
+				store.EXPECT().
+					CloseAccountTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "AlreadyClosed",
+			req:  &pb.CloseAccountRequest{Id: account.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(closedAccount, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(account.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
+				store.EXPECT().
+					CloseAccountTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CloseAccountTxResult{}, &db.AccountNotActiveError{AccountID: account.ID, Status: db.AccountStatusClosed})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "CustomerClosesFrozenAccount",
+			req:  &pb.CloseAccountRequest{Id: account.ID, SweepToAccountId: &sweepToID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(frozenAccount, nil)
+
+				store.EXPECT().
+					CloseAccountTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "PermissionDenied",
+			req:  &pb.CloseAccountRequest{Id: account.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					CloseAccountTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID+1, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "SweepToSameAccount",
+			req:  &pb.CloseAccountRequest{Id: account.ID, SweepToAccountId: &sameID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CloseAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		res, err := server.CloseAccount(testCase.buildContext(t, server.tokenMaker), testCase.req)
+		testCase.checkResponse(t, res, err)
+	}
+}
@@ -20,6 +20,7 @@ import (
 
 func TestCreateAccount(t *testing.T) {
 	user, account := randomAccount(t)
+	product := randomAccountProduct()
 
 	testCases := []struct {
 		name          string
@@ -31,23 +32,29 @@ func TestCreateAccount(t *testing.T) {
 		{
 			name: "OK",
 			req: &pb.CreateAccountRequest{
-				OwnerId:  user.ID,
-				Currency: account.Currency,
+				OwnerId:     user.ID,
+				Currency:    account.Currency,
+				ProductCode: product.Code,
 			},
 			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(product.Code)).
+					Times(1).
+					Return(product, nil)
+
 				store.EXPECT().
 					CreateAccount(gomock.Any(), gomock.Eq(db.CreateAccountParams{
-						OwnerID:  user.ID,
-						Currency: account.Currency,
-						Balance:  0,
+						OwnerID:     user.ID,
+						Currency:    account.Currency,
+						ProductCode: product.Code,
 					})).
 					Times(1).
 					Return(db.Account{
-						ID:        1,
-						OwnerID:   user.ID,
-						Balance:   0,
-						Currency:  account.Currency,
-						CreatedAt: time.Now(),
+						ID:          1,
+						OwnerID:     user.ID,
+						Currency:    account.Currency,
+						CreatedAt:   time.Now(),
+						ProductCode: product.Code,
 					}, nil)
 			},
 			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
@@ -61,15 +68,22 @@ func TestCreateAccount(t *testing.T) {
 				require.Equal(t, user.ID, createdAccount.OwnerId)
 				require.Equal(t, account.Balance, createdAccount.Balance)
 				require.Equal(t, account.Currency, createdAccount.Currency)
+				require.Equal(t, product.Code, createdAccount.ProductCode)
 			},
 		},
 		{
 			name: "InternalError",
 			req: &pb.CreateAccountRequest{
-				OwnerId:  user.ID,
-				Currency: account.Currency,
+				OwnerId:     user.ID,
+				Currency:    account.Currency,
+				ProductCode: product.Code,
 			},
 			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(product.Code)).
+					Times(1).
+					Return(product, nil)
+
 				store.EXPECT().
 					CreateAccount(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -88,8 +102,9 @@ func TestCreateAccount(t *testing.T) {
 		{
 			name: "ExpiredToken",
 			req: &pb.CreateAccountRequest{
-				OwnerId:  user.ID,
-				Currency: account.Currency,
+				OwnerId:     user.ID,
+				Currency:    account.Currency,
+				ProductCode: product.Code,
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -109,8 +124,9 @@ func TestCreateAccount(t *testing.T) {
 		{
 			name: "MissingAuthorization",
 			req: &pb.CreateAccountRequest{
-				OwnerId:  user.ID,
-				Currency: account.Currency,
+				OwnerId:     user.ID,
+				Currency:    account.Currency,
+				ProductCode: product.Code,
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -130,8 +146,9 @@ func TestCreateAccount(t *testing.T) {
 		{
 			name: "PermissionDenied",
 			req: &pb.CreateAccountRequest{
-				OwnerId:  user.ID,
-				Currency: account.Currency,
+				OwnerId:     user.ID,
+				Currency:    account.Currency,
+				ProductCode: product.Code,
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -151,10 +168,16 @@ func TestCreateAccount(t *testing.T) {
 		{
 			name: "UserNotFound",
 			req: &pb.CreateAccountRequest{
-				OwnerId:  user.ID,
-				Currency: account.Currency,
+				OwnerId:     user.ID,
+				Currency:    account.Currency,
+				ProductCode: product.Code,
 			},
 			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(product.Code)).
+					Times(1).
+					Return(product, nil)
+
 				store.EXPECT().
 					CreateAccount(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -173,10 +196,16 @@ func TestCreateAccount(t *testing.T) {
 		{
 			name: "AccountAlreadyExists",
 			req: &pb.CreateAccountRequest{
-				OwnerId:  user.ID,
-				Currency: account.Currency,
+				OwnerId:     user.ID,
+				Currency:    account.Currency,
+				ProductCode: product.Code,
 			},
 			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(product.Code)).
+					Times(1).
+					Return(product, nil)
+
 				store.EXPECT().
 					CreateAccount(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -195,8 +224,9 @@ func TestCreateAccount(t *testing.T) {
 		{
 			name: "InvalidID",
 			req: &pb.CreateAccountRequest{
-				OwnerId:  -1,
-				Currency: account.Currency,
+				OwnerId:     -1,
+				Currency:    account.Currency,
+				ProductCode: product.Code,
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -216,8 +246,9 @@ func TestCreateAccount(t *testing.T) {
 		{
 			name: "InvalidCurrency",
 			req: &pb.CreateAccountRequest{
-				OwnerId:  user.ID,
-				Currency: "invalid",
+				OwnerId:     user.ID,
+				Currency:    "invalid",
+				ProductCode: product.Code,
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -234,6 +265,84 @@ func TestCreateAccount(t *testing.T) {
 				require.Equal(t, codes.InvalidArgument, st.Code())
 			},
 		},
+		{
+			name: "UnknownProduct",
+			req: &pb.CreateAccountRequest{
+				OwnerId:     user.ID,
+				Currency:    account.Currency,
+				ProductCode: "gold",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq("gold")).
+					Times(1).
+					Return(db.AccountProduct{}, pgx.ErrNoRows)
+
+				store.EXPECT().
+					CreateAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "CurrencyNotOffered",
+			req: &pb.CreateAccountRequest{
+				OwnerId:     user.ID,
+				Currency:    account.Currency,
+				ProductCode: product.Code,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				usdOnly := product
+				usdOnly.AllowedCurrencies = []string{utils.USD}
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(product.Code)).
+					Times(1).
+					Return(usdOnly, nil)
+
+				store.EXPECT().
+					CreateAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "MissingProductCode",
+			req: &pb.CreateAccountRequest{
+				OwnerId:  user.ID,
+				Currency: account.Currency,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateAccountResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -416,6 +525,7 @@ func TestGetAccounts(t *testing.T) {
 
 	invalidLimit := int32(-1)
 	invalidOffset := int32(-1)
+	invalidOwnerID := int32(0)
 
 	testCases := []struct {
 		name          string
@@ -514,16 +624,74 @@ func TestGetAccounts(t *testing.T) {
 			},
 		},
 		{
-			name: "PermissionDenied",
+			name: "NoAccounts",
 			req:  &pb.GetAccountsRequest{Limit: &defaultLimit, Offset: &defaultOffset},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
 					ListAccount(gomock.Any(), gomock.Any()).
 					Times(1).
+					Return([]db.Account{}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetAccountsResponse, err error) {
+				require.NoError(t, err)
+				require.Empty(t, res.GetAccounts())
+			},
+		},
+		{
+			name: "BankerListsOtherOwner",
+			req:  &pb.GetAccountsRequest{OwnerId: &user.ID, Limit: &defaultLimit, Offset: &defaultOffset},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAccount(gomock.Any(), gomock.Eq(db.ListAccountParams{
+						OwnerID: user.ID,
+						Limit:   defaultLimit,
+						Offset:  defaultOffset,
+					})).
+					Times(1).
+					Return(accounts, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID+1, utils.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetAccountsResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetAccounts(), len(accounts))
+			},
+		},
+		{
+			name: "CustomerListsOwnOwnerID",
+			req:  &pb.GetAccountsRequest{OwnerId: &user.ID, Limit: &defaultLimit, Offset: &defaultOffset},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAccount(gomock.Any(), gomock.Eq(db.ListAccountParams{
+						OwnerID: user.ID,
+						Limit:   defaultLimit,
+						Offset:  defaultOffset,
+					})).
+					Times(1).
 					Return(accounts, nil)
 			},
 			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
-				return newContextWithBearerToken(t, tokenMaker, -1, user.Role, time.Minute)
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetAccountsResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetAccounts(), len(accounts))
+			},
+		},
+		{
+			name: "PermissionDenied",
+			req:  &pb.GetAccountsRequest{OwnerId: &user.ID, Limit: &defaultLimit, Offset: &defaultOffset},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID+1, user.Role, time.Minute)
 			},
 			checkResponse: func(t *testing.T, res *pb.GetAccountsResponse, err error) {
 				require.Error(t, err)
@@ -532,6 +700,24 @@ func TestGetAccounts(t *testing.T) {
 				require.Equal(t, codes.PermissionDenied, st.Code())
 			},
 		},
+		{
+			name: "InvalidOwnerID",
+			req:  &pb.GetAccountsRequest{OwnerId: &invalidOwnerID, Limit: &defaultLimit, Offset: &defaultOffset},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, utils.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetAccountsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
 		{
 			name: "InvalidLimit",
 			req:  &pb.GetAccountsRequest{Limit: &invalidLimit, Offset: &defaultOffset},
@@ -586,10 +772,21 @@ func TestGetAccounts(t *testing.T) {
 func randomAccount(t *testing.T) (db.User, db.Account) {
 	user, _ := randomUser(t)
 	return user, db.Account{
-		ID:       utils.RandomInt(1, 100),
-		OwnerID:  user.ID,
-		Balance:  0,
-		Currency: utils.CAD,
+		ID:          utils.RandomInt(1, 100),
+		OwnerID:     user.ID,
+		Balance:     0,
+		Currency:    utils.CAD,
+		Status:      db.AccountStatusActive,
+		ProductCode: "checking",
+	}
+}
+
+func randomAccountProduct() db.AccountProduct {
+	return db.AccountProduct{
+		Code:              "checking",
+		Name:              "Everyday Checking",
+		Type:              db.AccountTypeChecking,
+		AllowedCurrencies: utils.SupportedCurrencies,
 	}
 }
 
@@ -602,6 +799,7 @@ func randomAccountsFromUser(userID int32) []db.Account {
 			OwnerID:  userID,
 			Currency: currency,
 			Balance:  0,
+			Status:   db.AccountStatusActive,
 		})
 	}
 
@@ -0,0 +1,260 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/utils"
+	"github.com/valkyraycho/bank_project/validator"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var requestApprovalStatuses = map[pb.ApprovalStatus]db.ApprovalStatus{
+	pb.ApprovalStatus_APPROVAL_STATUS_PENDING:  db.ApprovalStatusPending,
+	pb.ApprovalStatus_APPROVAL_STATUS_APPROVED: db.ApprovalStatusApproved,
+	pb.ApprovalStatus_APPROVAL_STATUS_REJECTED: db.ApprovalStatusRejected,
+	pb.ApprovalStatus_APPROVAL_STATUS_EXPIRED:  db.ApprovalStatusExpired,
+}
+
+var approvalStatuses = map[db.ApprovalStatus]pb.ApprovalStatus{
+	db.ApprovalStatusPending:  pb.ApprovalStatus_APPROVAL_STATUS_PENDING,
+	db.ApprovalStatusApproved: pb.ApprovalStatus_APPROVAL_STATUS_APPROVED,
+	db.ApprovalStatusRejected: pb.ApprovalStatus_APPROVAL_STATUS_REJECTED,
+	db.ApprovalStatusExpired:  pb.ApprovalStatus_APPROVAL_STATUS_EXPIRED,
+}
+
+var approvalEventKinds = map[db.ApprovalEventKind]pb.ApprovalEventKind{
+	db.ApprovalEventKindRequested: pb.ApprovalEventKind_APPROVAL_EVENT_KIND_REQUESTED,
+	db.ApprovalEventKindApproved:  pb.ApprovalEventKind_APPROVAL_EVENT_KIND_APPROVED,
+	db.ApprovalEventKindRejected:  pb.ApprovalEventKind_APPROVAL_EVENT_KIND_REJECTED,
+	db.ApprovalEventKindExpired:   pb.ApprovalEventKind_APPROVAL_EVENT_KIND_EXPIRED,
+}
+
+func (s *Server) ListApprovalRequests(ctx context.Context, req *pb.ListApprovalRequestsRequest) (*pb.ListApprovalRequestsResponse, error) {
+	_, err := s.authorizeUser(ctx, []string{utils.BankerRole})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	violations := validateListApprovalRequestsRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentsError(violations)
+	}
+
+	args := db.ListApprovalRequestsParams{
+		Limit:  req.GetLimit(),
+		Offset: req.GetOffset(),
+	}
+	if req.Status != nil {
+		args.Status = db.NullApprovalStatus{ApprovalStatus: requestApprovalStatuses[req.GetStatus()], Valid: true}
+	}
+
+	requests, err := s.store.ListApprovalRequests(ctx, args)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list approval requests: %s", err)
+	}
+
+	rsp := &pb.ListApprovalRequestsResponse{ApprovalRequests: []*pb.ApprovalRequest{}}
+	for _, request := range requests {
+		rsp.ApprovalRequests = append(rsp.ApprovalRequests, convertApprovalRequest(request))
+	}
+	return rsp, nil
+}
+
+func validateListApprovalRequestsRequest(req *pb.ListApprovalRequestsRequest) []*errdetails.BadRequest_FieldViolation {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+
+	defaultLimit := int32(10)
+	defaultOffset := int32(0)
+
+	if req.Status != nil {
+		if _, ok := requestApprovalStatuses[req.GetStatus()]; !ok {
+			violations = append(violations, fieldViolation("status", errors.New("unknown approval status")))
+		}
+	}
+
+	if req.Limit != nil {
+		if err := validator.ValidateLimit(req.GetLimit()); err != nil {
+			violations = append(violations, fieldViolation("limit", err))
+		}
+	} else {
+		req.Limit = &defaultLimit
+	}
+
+	if req.Offset != nil {
+		if err := validator.ValidateOffset(req.GetOffset()); err != nil {
+			violations = append(violations, fieldViolation("offset", err))
+		}
+	} else {
+		req.Offset = &defaultOffset
+	}
+
+	return violations
+}
+
+func (s *Server) GetApprovalRequest(ctx context.Context, req *pb.GetApprovalRequestRequest) (*pb.GetApprovalRequestResponse, error) {
+	_, err := s.authorizeUser(ctx, []string{utils.BankerRole})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	if req.GetId() <= 0 {
+		return nil, invalidArgumentsError([]*errdetails.BadRequest_FieldViolation{
+			fieldViolation("id", errors.New("must be a positive integer")),
+		})
+	}
+
+	request, err := s.store.GetApprovalRequest(ctx, req.GetId())
+	if err != nil {
+		return nil, approvalError(err)
+	}
+
+	events, err := s.store.ListApprovalEvents(ctx, request.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list approval events: %s", err)
+	}
+
+	rsp := &pb.GetApprovalRequestResponse{
+		ApprovalRequest: convertApprovalRequest(request),
+		Events:          []*pb.ApprovalEvent{},
+	}
+	for _, event := range events {
+		rsp.Events = append(rsp.Events, convertApprovalEvent(event))
+	}
+	return rsp, nil
+}
+
+func (s *Server) ApproveAction(ctx context.Context, req *pb.ApproveActionRequest) (*pb.ApproveActionResponse, error) {
+	payload, err := s.authorizeUser(ctx, []string{utils.BankerRole})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	violations := validateApprovalDecision(req.GetId(), req.GetReason(), 0)
+	if len(violations) > 0 {
+		return nil, invalidArgumentsError(violations)
+	}
+
+	request, err := s.store.DecideApprovalTx(ctx, db.DecideApprovalTxParams{
+		ID:        req.GetId(),
+		DecidedBy: payload.UserID,
+		Approve:   true,
+		Reason:    req.GetReason(),
+	})
+	if err != nil {
+		return nil, approvalError(err)
+	}
+
+	auditTarget(ctx, "approval_request", request.ID, map[string]any{"status": db.ApprovalStatusPending}, auditApprovalFields(request))
+	return &pb.ApproveActionResponse{ApprovalRequest: convertApprovalRequest(request)}, nil
+}
+
+func (s *Server) RejectAction(ctx context.Context, req *pb.RejectActionRequest) (*pb.RejectActionResponse, error) {
+	payload, err := s.authorizeUser(ctx, []string{utils.BankerRole})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	violations := validateApprovalDecision(req.GetId(), req.GetReason(), 1)
+	if len(violations) > 0 {
+		return nil, invalidArgumentsError(violations)
+	}
+
+	request, err := s.store.DecideApprovalTx(ctx, db.DecideApprovalTxParams{
+		ID:        req.GetId(),
+		DecidedBy: payload.UserID,
+		Reason:    req.GetReason(),
+	})
+	if err != nil {
+		return nil, approvalError(err)
+	}
+
+	auditTarget(ctx, "approval_request", request.ID, map[string]any{"status": db.ApprovalStatusPending}, auditApprovalFields(request))
+	return &pb.RejectActionResponse{ApprovalRequest: convertApprovalRequest(request)}, nil
+}
+
+func validateApprovalDecision(id int64, reason string, minReasonLength int) []*errdetails.BadRequest_FieldViolation {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+
+	if id <= 0 {
+		violations = append(violations, fieldViolation("id", errors.New("must be a positive integer")))
+	}
+
+	if err := validator.ValidateString(reason, minReasonLength, 200); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+
+	return violations
+}
+
+func approvalError(err error) error {
+	var notActive *db.AccountNotActiveError
+	var ruleErr *db.ProductRuleError
+	var limitErr *db.TransferLimitError
+	var screeningErr *db.OwnerScreeningError
+	switch {
+	case err == pgx.ErrNoRows:
+		return status.Errorf(codes.NotFound, "approval request not found: %s", err)
+	case errors.Is(err, db.ErrSelfApproval):
+		return status.Errorf(codes.PermissionDenied, "%s", err)
+	case errors.Is(err, db.ErrApprovalNotPending),
+		errors.Is(err, db.ErrApprovalExpired),
+		errors.Is(err, db.ErrApprovalStale),
+		errors.Is(err, db.ErrCashMovementNotPending),
+		errors.Is(err, db.ErrAccountHasBalance),
+		errors.Is(err, db.ErrCurrencyMismatch),
+		errors.As(err, &notActive),
+		errors.As(err, &ruleErr),
+		errors.As(err, &limitErr),
+		errors.As(err, &screeningErr):
+		return status.Errorf(codes.FailedPrecondition, "%s", err)
+	}
+	return status.Errorf(codes.Internal, "failed to decide approval request: %s", err)
+}
+
+func convertApprovalRequest(request db.ApprovalRequest) *pb.ApprovalRequest {
+	rsp := &pb.ApprovalRequest{
+		Id:             request.ID,
+		Action:         request.Action,
+		Summary:        request.Summary,
+		Status:         approvalStatuses[request.Status],
+		RequestedBy:    request.RequestedBy,
+		DecisionReason: request.DecisionReason,
+		ExpiresAt:      timestamppb.New(request.ExpiresAt),
+		CreatedAt:      timestamppb.New(request.CreatedAt),
+	}
+
+	var payload map[string]any
+	if err := json.Unmarshal(request.Payload, &payload); err == nil {
+		rsp.Payload, _ = structpb.NewStruct(payload)
+	}
+
+	if request.DecidedBy.Valid {
+		rsp.DecidedBy = &request.DecidedBy.Int32
+	}
+	if request.DecidedAt.Valid {
+		rsp.DecidedAt = timestamppb.New(request.DecidedAt.Time)
+	}
+	return rsp
+}
+
+func convertApprovalEvent(event db.ApprovalEvent) *pb.ApprovalEvent {
+	rsp := &pb.ApprovalEvent{
+		Id:        event.ID,
+		Event:     approvalEventKinds[event.Event],
+		Reason:    event.Reason,
+		CreatedAt: timestamppb.New(event.CreatedAt),
+	}
+	if event.ActorID.Valid {
+		rsp.ActorId = &event.ActorID.Int32
+	}
+	return rsp
+}
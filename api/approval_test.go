@@ -0,0 +1,406 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/token"
+	"github.com/valkyraycho/bank_project/utils"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func randomApprovalRequest(requestedBy int32) db.ApprovalRequest {
+	return db.ApprovalRequest{
+		ID:          int64(utils.RandomInt(1, 1000)),
+		Action:      db.ApprovalActionUnfreezeAccount,
+		Payload:     []byte(`{"account_id": 7, "reason": "identity confirmed"}`),
+		Summary:     "unfreeze account 7",
+		Status:      db.ApprovalStatusPending,
+		RequestedBy: requestedBy,
+		ExpiresAt:   time.Now().Add(time.Hour),
+		CreatedAt:   time.Now(),
+	}
+}
+
+func TestListApprovalRequests(t *testing.T) {
+	user, _ := randomUser(t)
+	banker, _ := randomUser(t)
+	banker.Role = utils.BankerRole
+
+	requests := []db.ApprovalRequest{randomApprovalRequest(banker.ID), randomApprovalRequest(banker.ID)}
+	pending := pb.ApprovalStatus_APPROVAL_STATUS_PENDING
+
+	testCases := []struct {
+		name          string
+		req           *pb.ListApprovalRequestsRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.TokenMaker) context.Context
+		checkResponse func(t *testing.T, res *pb.ListApprovalRequestsResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.ListApprovalRequestsRequest{Status: &pending},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListApprovalRequests(gomock.Any(), gomock.Eq(db.ListApprovalRequestsParams{
+						Limit:  10,
+						Offset: 0,
+						Status: db.NullApprovalStatus{ApprovalStatus: db.ApprovalStatusPending, Valid: true},
+					})).
+					Times(1).
+					Return(requests, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListApprovalRequestsResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetApprovalRequests(), len(requests))
+				for i, request := range res.GetApprovalRequests() {
+					require.Equal(t, requests[i].ID, request.GetId())
+					require.Equal(t, pb.ApprovalStatus_APPROVAL_STATUS_PENDING, request.GetStatus())
+					require.Equal(t, "identity confirmed", request.GetPayload().AsMap()["reason"])
+				}
+			},
+		},
+		{
+			name: "CustomerNotAllowed",
+			req:  &pb.ListApprovalRequestsRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListApprovalRequests(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListApprovalRequestsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name: "InvalidStatus",
+			req:  &pb.ListApprovalRequestsRequest{Status: pb.ApprovalStatus_APPROVAL_STATUS_UNSPECIFIED.Enum()},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListApprovalRequests(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListApprovalRequestsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		res, err := server.ListApprovalRequests(testCase.buildContext(t, server.tokenMaker), testCase.req)
+		testCase.checkResponse(t, res, err)
+	}
+}
+
+func TestGetApprovalRequest(t *testing.T) {
+	maker, _ := randomUser(t)
+	checker, _ := randomUser(t)
+	checker.Role = utils.BankerRole
+
+	request := randomApprovalRequest(maker.ID)
+	request.Status = db.ApprovalStatusExpired
+	events := []db.ApprovalEvent{
+		{ID: 1, RequestID: request.ID, Event: db.ApprovalEventKindRequested, ActorID: pgtype.Int4{Int32: maker.ID, Valid: true}},
+		{ID: 2, RequestID: request.ID, Event: db.ApprovalEventKindExpired, Reason: "approval request expired"},
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.GetApprovalRequestResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetApprovalRequest(gomock.Any(), gomock.Eq(request.ID)).
+					Times(1).
+					Return(request, nil)
+
+				store.EXPECT().
+					ListApprovalEvents(gomock.Any(), gomock.Eq(request.ID)).
+					Times(1).
+					Return(events, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetApprovalRequestResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, pb.ApprovalStatus_APPROVAL_STATUS_EXPIRED, res.GetApprovalRequest().GetStatus())
+				require.Len(t, res.GetEvents(), 2)
+				require.Equal(t, maker.ID, res.GetEvents()[0].GetActorId())
+				require.Equal(t, pb.ApprovalEventKind_APPROVAL_EVENT_KIND_EXPIRED, res.GetEvents()[1].GetEvent())
+				require.Nil(t, res.GetEvents()[1].ActorId)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetApprovalRequest(gomock.Any(), gomock.Eq(request.ID)).
+					Times(1).
+					Return(db.ApprovalRequest{}, pgx.ErrNoRows)
+
+				store.EXPECT().
+					ListApprovalEvents(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetApprovalRequestResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		ctx := newContextWithBearerToken(t, server.tokenMaker, checker.ID, checker.Role, time.Minute)
+		res, err := server.GetApprovalRequest(ctx, &pb.GetApprovalRequestRequest{Id: request.ID})
+		testCase.checkResponse(t, res, err)
+	}
+}
+
+func TestApproveAction(t *testing.T) {
+	maker, _ := randomUser(t)
+	checker, _ := randomUser(t)
+	checker.Role = utils.BankerRole
+
+	request := randomApprovalRequest(maker.ID)
+	approved := request
+	approved.Status = db.ApprovalStatusApproved
+	approved.DecidedBy = pgtype.Int4{Int32: checker.ID, Valid: true}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.ApproveActionResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DecideApprovalTx(gomock.Any(), gomock.Eq(db.DecideApprovalTxParams{
+						ID:        request.ID,
+						DecidedBy: checker.ID,
+						Approve:   true,
+					})).
+					Times(1).
+					Return(approved, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ApproveActionResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, pb.ApprovalStatus_APPROVAL_STATUS_APPROVED, res.GetApprovalRequest().GetStatus())
+				require.Equal(t, checker.ID, res.GetApprovalRequest().GetDecidedBy())
+			},
+		},
+		{
+			name: "SelfApproval",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DecideApprovalTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ApprovalRequest{}, db.ErrSelfApproval)
+			},
+			checkResponse: func(t *testing.T, res *pb.ApproveActionResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "Expired",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DecideApprovalTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ApprovalRequest{}, db.ErrApprovalExpired)
+			},
+			checkResponse: func(t *testing.T, res *pb.ApproveActionResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "ActionRefused",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DecideApprovalTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ApprovalRequest{}, &db.ProductRuleError{ProductCode: "checking", Rule: db.ErrInsufficientFunds})
+			},
+			checkResponse: func(t *testing.T, res *pb.ApproveActionResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DecideApprovalTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ApprovalRequest{}, pgx.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, res *pb.ApproveActionResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DecideApprovalTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ApprovalRequest{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, res *pb.ApproveActionResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		ctx := newContextWithBearerToken(t, server.tokenMaker, checker.ID, checker.Role, time.Minute)
+		res, err := server.ApproveAction(ctx, &pb.ApproveActionRequest{Id: request.ID})
+		testCase.checkResponse(t, res, err)
+	}
+}
+
+func TestRejectAction(t *testing.T) {
+	maker, _ := randomUser(t)
+	checker, _ := randomUser(t)
+	checker.Role = utils.BankerRole
+
+	request := randomApprovalRequest(maker.ID)
+	rejected := request
+	rejected.Status = db.ApprovalStatusRejected
+	rejected.DecidedBy = pgtype.Int4{Int32: checker.ID, Valid: true}
+	rejected.DecisionReason = "no supporting documents"
+
+	testCases := []struct {
+		name          string
+		req           *pb.RejectActionRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.RejectActionResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.RejectActionRequest{Id: request.ID, Reason: "no supporting documents"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DecideApprovalTx(gomock.Any(), gomock.Eq(db.DecideApprovalTxParams{
+						ID:        request.ID,
+						DecidedBy: checker.ID,
+						Reason:    "no supporting documents",
+					})).
+					Times(1).
+					Return(rejected, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.RejectActionResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, pb.ApprovalStatus_APPROVAL_STATUS_REJECTED, res.GetApprovalRequest().GetStatus())
+				require.Equal(t, "no supporting documents", res.GetApprovalRequest().GetDecisionReason())
+			},
+		},
+		{
+			name: "MissingReason",
+			req:  &pb.RejectActionRequest{Id: request.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DecideApprovalTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.RejectActionResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "NotPending",
+			req:  &pb.RejectActionRequest{Id: request.ID, Reason: "too late"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DecideApprovalTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ApprovalRequest{}, db.ErrApprovalNotPending)
+			},
+			checkResponse: func(t *testing.T, res *pb.RejectActionResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		ctx := newContextWithBearerToken(t, server.tokenMaker, checker.ID, checker.Role, time.Minute)
+		res, err := server.RejectAction(ctx, testCase.req)
+		testCase.checkResponse(t, res, err)
+	}
+}
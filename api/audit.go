@@ -0,0 +1,356 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"reflect"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/metrics"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/telemetry"
+	"github.com/valkyraycho/bank_project/utils"
+	"github.com/valkyraycho/bank_project/validator"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// readOnlyMethods are the BankService methods left out of the audit log.
+// Every other method is audited, so a new RPC is recorded unless it is added
+// here.
+var readOnlyMethods = map[string]bool{
+	"ListAccountProducts":  true,
+	"GetAccount":           true,
+	"GetAccounts":          true,
+	"SearchAccounts":       true,
+	"SearchUsers":          true,
+	"PreviewTransferFee":   true,
+	"ListApprovalRequests": true,
+	"GetApprovalRequest":   true,
+	"ListAuditLog":         true,
+	"ListAccountHistory":   true,
+	"ListCategoryRules":    true,
+	"GetTransferLimits":    true,
+	"ListFraudAlerts":      true,
+	"GetFraudAlert":        true,
+	"ListScreeningMatches": true,
+}
+
+func auditedMethod(fullMethod string) bool {
+	service, method := path.Split(fullMethod)
+	return service == "/"+pb.BankService_ServiceDesc.ServiceName+"/" && !readOnlyMethods[method]
+}
+
+// auditRecord collects what a handler did while it runs. The interceptor
+// writes it to the audit log once the handler returns.
+type auditRecord struct {
+	actorID    pgtype.Int4
+	actorRole  string
+	targetType string
+	targetID   string
+	before     map[string]any
+	after      map[string]any
+	code       codes.Code
+}
+
+type auditRecordKey struct{}
+
+func auditRecordFromContext(ctx context.Context) *auditRecord {
+	record, _ := ctx.Value(auditRecordKey{}).(*auditRecord)
+	return record
+}
+
+// auditActor records who made the call. authorizeUser calls it for every
+// authenticated call.
+func auditActor(ctx context.Context, userID int32, role string) {
+	if record := auditRecordFromContext(ctx); record != nil {
+		record.actorID = pgtype.Int4{Int32: userID, Valid: true}
+		record.actorRole = role
+	}
+}
+
+// auditTarget records the entity a call acted on and how it changed. Fields
+// with the same value before and after are dropped; before is nil for
+// entities the call created.
+func auditTarget(ctx context.Context, targetType string, targetID any, before, after map[string]any) {
+	record := auditRecordFromContext(ctx)
+	if record == nil {
+		return
+	}
+
+	record.targetType = targetType
+	record.targetID = fmt.Sprint(targetID)
+	record.before = map[string]any{}
+	record.after = map[string]any{}
+	for key, value := range after {
+		if old, ok := before[key]; !ok || !reflect.DeepEqual(old, value) {
+			record.after[key] = value
+		}
+	}
+	for key, value := range before {
+		_, changed := record.after[key]
+		_, kept := after[key]
+		if changed || !kept {
+			record.before[key] = value
+		}
+	}
+	if before == nil {
+		record.before = nil
+	}
+	if after == nil {
+		record.after = nil
+	}
+}
+
+func auditUserFields(user db.User) map[string]any {
+	return map[string]any{
+		"username":            user.Username,
+		"full_name":           user.FullName,
+		"email":               user.Email,
+		"role":                user.Role,
+		"screening_status":    user.ScreeningStatus,
+		"password_changed_at": user.PasswordChangedAt.UTC().Format(time.RFC3339Nano),
+	}
+}
+
+func auditAccountFields(account db.Account) map[string]any {
+	return map[string]any{
+		"owner_id":      account.OwnerID,
+		"currency":      account.Currency,
+		"product_code":  account.ProductCode,
+		"balance":       account.Balance,
+		"status":        account.Status,
+		"status_reason": account.StatusReason,
+	}
+}
+
+func auditApprovalFields(request db.ApprovalRequest) map[string]any {
+	return map[string]any{
+		"action":          request.Action,
+		"summary":         request.Summary,
+		"status":          request.Status,
+		"decision_reason": request.DecisionReason,
+	}
+}
+
+// GRPCAudit writes an audit log entry for every mutating BankService call
+// once its handler returns, failed calls included. The entry is appended
+// after the handler's own transaction committed, so recording is best effort:
+// if the append fails the call still returns the handler's result, and the
+// failure is logged and counted in bank_audit_log_write_failures_total.
+// verify-audit-log only checks the chain and can't tell an entry is missing,
+// so that metric must be alerted on.
+func (s *Server) GRPCAudit(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	if !auditedMethod(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	record := &auditRecord{}
+	resp, err = handler(context.WithValue(ctx, auditRecordKey{}, record), req)
+	record.code = status.Code(err)
+
+	s.writeAuditLog(ctx, info.FullMethod, record, s.extractMetadata(ctx))
+	return resp, err
+}
+
+// gatewayAudit does for the REST gateway what GRPCAudit does for gRPC, with
+// the same best-effort write. The status code is filled in by
+// gatewayErrorHandler when the call fails.
+func (s *Server) gatewayAudit(next runtime.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		method := gatewayMethod(r, pathParams)
+		if !auditedMethod(method) {
+			next(w, r, pathParams)
+			return
+		}
+
+		record := &auditRecord{}
+		next(w, r.WithContext(context.WithValue(r.Context(), auditRecordKey{}, record)), pathParams)
+
+		s.writeAuditLog(r.Context(), method, record, &Metadata{
+			UserAgent: r.UserAgent(),
+			ClientIP:  s.resolveClientIP(r.RemoteAddr, r.Header.Values(xForwardedForHeader), r.Header.Values(forwardedHeader)),
+		})
+	}
+}
+
+// writeAuditLog appends the record to the audit log. The call has already
+// committed, so failing it now would tell the client a completed change did
+// not happen and invite a retry; a failed append is only logged and counted.
+func (s *Server) writeAuditLog(ctx context.Context, method string, record *auditRecord, mtdt *Metadata) {
+	args := db.AppendAuditLogTxParams{
+		ActorID:    record.actorID,
+		ActorRole:  record.actorRole,
+		Method:     method,
+		TargetType: record.targetType,
+		TargetID:   record.targetID,
+		ClientIP:   mtdt.ClientIP,
+		UserAgent:  mtdt.UserAgent,
+		StatusCode: record.code.String(),
+	}
+	if record.before != nil {
+		args.Before = record.before
+	}
+	if record.after != nil {
+		args.After = record.after
+	}
+
+	if _, err := s.store.AppendAuditLogTx(context.WithoutCancel(ctx), args); err != nil {
+		metrics.AuditLogWriteFailures.WithLabelValues(method).Inc()
+		telemetry.Logger(ctx).Error().Err(err).Str("method", method).Msg("failed to write audit log")
+	}
+}
+
+type auditPageCursor struct {
+	ID int64 `json:"i"`
+}
+
+func (s *Server) ListAuditLog(ctx context.Context, req *pb.ListAuditLogRequest) (*pb.ListAuditLogResponse, error) {
+	_, err := s.authorizeUser(ctx, []string{utils.BankerRole, utils.AuditorRole})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	violations := validateListAuditLogRequest(req)
+
+	cursor, err := decodeAuditPageToken(req.GetPageToken())
+	if err != nil {
+		violations = append(violations, fieldViolation("page_token", err))
+	}
+
+	if len(violations) > 0 {
+		return nil, invalidArgumentsError(violations)
+	}
+
+	arg := db.ListAuditLogParams{
+		ActorID:       optionalInt4(req.ActorId),
+		Method:        optionalText(req.Method),
+		TargetType:    optionalText(req.TargetType),
+		TargetID:      optionalText(req.TargetId),
+		CreatedAfter:  optionalTimestamptz(req.GetCreatedAfter()),
+		CreatedBefore: optionalTimestamptz(req.GetCreatedBefore()),
+		PageSize:      req.GetPageSize() + 1,
+	}
+	if cursor != nil {
+		arg.BeforeID = pgtype.Int8{Int64: cursor.ID, Valid: true}
+	}
+
+	entries, err := s.store.ListAuditLog(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list audit log: %s", err)
+	}
+
+	rsp := &pb.ListAuditLogResponse{Entries: []*pb.AuditLogEntry{}}
+	if len(entries) > int(req.GetPageSize()) {
+		entries = entries[:req.GetPageSize()]
+		rsp.NextPageToken = encodeAuditPageToken(auditPageCursor{ID: entries[len(entries)-1].ID})
+	}
+
+	for _, entry := range entries {
+		rsp.Entries = append(rsp.Entries, convertAuditLogEntry(entry))
+	}
+	return rsp, nil
+}
+
+func validateListAuditLogRequest(req *pb.ListAuditLogRequest) []*errdetails.BadRequest_FieldViolation {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+
+	if req.ActorId != nil {
+		if err := validator.ValidateID(req.GetActorId()); err != nil {
+			violations = append(violations, fieldViolation("actor_id", err))
+		}
+	}
+
+	if req.Method != nil {
+		if err := validator.ValidateString(req.GetMethod(), 1, 200); err != nil {
+			violations = append(violations, fieldViolation("method", err))
+		}
+	}
+
+	if req.TargetType != nil {
+		if err := validator.ValidateString(req.GetTargetType(), 1, 50); err != nil {
+			violations = append(violations, fieldViolation("target_type", err))
+		}
+	}
+
+	if req.TargetId != nil {
+		if err := validator.ValidateString(req.GetTargetId(), 1, 100); err != nil {
+			violations = append(violations, fieldViolation("target_id", err))
+		}
+	}
+
+	violations = append(violations, validateCreatedAtRange(req.GetCreatedAfter(), req.GetCreatedBefore())...)
+	violations = append(violations, validatePageSize(&req.PageSize)...)
+
+	return violations
+}
+
+func encodeAuditPageToken(cursor auditPageCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeAuditPageToken(token string) (*auditPageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.New("malformed page token")
+	}
+
+	cursor := &auditPageCursor{}
+	if err := json.Unmarshal(data, cursor); err != nil || cursor.ID <= 0 {
+		return nil, errors.New("malformed page token")
+	}
+	return cursor, nil
+}
+
+func convertAuditLogEntry(entry db.AuditLog) *pb.AuditLogEntry {
+	rsp := &pb.AuditLogEntry{
+		Id:         entry.ID,
+		ActorRole:  entry.ActorRole,
+		Method:     entry.Method,
+		TargetType: entry.TargetType,
+		TargetId:   entry.TargetID,
+		Before:     jsonStruct(entry.Before),
+		After:      jsonStruct(entry.After),
+		ClientIp:   entry.ClientIp,
+		UserAgent:  entry.UserAgent,
+		StatusCode: entry.StatusCode,
+		PrevHash:   entry.PrevHash,
+		Hash:       entry.Hash,
+		CreatedAt:  timestamppb.New(entry.CreatedAt),
+	}
+	if entry.ActorID.Valid {
+		rsp.ActorId = &entry.ActorID.Int32
+	}
+	return rsp
+}
+
+// jsonStruct converts a JSON object column to a Struct. It returns nil for
+// NULL and for anything that isn't an object.
+func jsonStruct(data []byte) *structpb.Struct {
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil || fields == nil {
+		return nil
+	}
+	value, err := structpb.NewStruct(fields)
+	if err != nil {
+		return nil
+	}
+	return value
+}
@@ -0,0 +1,309 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/metrics"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/token"
+	"github.com/valkyraycho/bank_project/utils"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func randomAuditLogEntry(id int64) db.AuditLog {
+	return db.AuditLog{
+		ID:         id,
+		ActorID:    pgtype.Int4{Int32: utils.RandomInt(1, 1000), Valid: true},
+		ActorRole:  utils.BankerRole,
+		Method:     "/pb.BankService/FreezeAccount",
+		TargetType: "account",
+		TargetID:   "7",
+		Before:     []byte(`{"status": "active"}`),
+		After:      []byte(`{"status": "frozen"}`),
+		StatusCode: codes.OK.String(),
+		PrevHash:   db.AuditLogGenesisHash,
+		Hash:       utils.RandomString(64),
+		CreatedAt:  time.Now(),
+	}
+}
+
+func TestGRPCAudit(t *testing.T) {
+	banker, _ := randomUser(t)
+	banker.Role = utils.BankerRole
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		AppendAuditLogTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, args db.AppendAuditLogTxParams) (db.AuditLog, error) {
+			require.Equal(t, pgtype.Int4{Int32: banker.ID, Valid: true}, args.ActorID)
+			require.Equal(t, utils.BankerRole, args.ActorRole)
+			require.Equal(t, "/pb.BankService/FreezeAccount", args.Method)
+			require.Equal(t, "account", args.TargetType)
+			require.Equal(t, "7", args.TargetID)
+			require.Equal(t, map[string]any{"status": "active"}, args.Before)
+			require.Equal(t, map[string]any{"status": "frozen"}, args.After)
+			require.Equal(t, codes.FailedPrecondition.String(), args.StatusCode)
+			return db.AuditLog{}, nil
+		})
+
+	server := NewTestServer(t, store)
+	handler := func(ctx context.Context, req any) (any, error) {
+		_, err := server.authorizeUser(ctx, []string{utils.BankerRole})
+		require.NoError(t, err)
+		auditTarget(ctx, "account", int32(7),
+			map[string]any{"status": "active", "currency": "USD"},
+			map[string]any{"status": "frozen", "currency": "USD"},
+		)
+		return nil, status.Error(codes.FailedPrecondition, "account is closed")
+	}
+
+	ctx := newContextWithBearerToken(t, server.tokenMaker, banker.ID, banker.Role, time.Minute)
+	_, err := server.GRPCAudit(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/pb.BankService/FreezeAccount"}, handler)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestGRPCAuditCountsWriteFailures(t *testing.T) {
+	banker, _ := randomUser(t)
+	banker.Role = utils.BankerRole
+	method := "/pb.BankService/UnfreezeAccount"
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		AppendAuditLogTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(db.AuditLog{}, sql.ErrConnDone)
+
+	server := NewTestServer(t, store)
+	handler := func(ctx context.Context, req any) (any, error) {
+		return "done", nil
+	}
+
+	failures := testutil.ToFloat64(metrics.AuditLogWriteFailures.WithLabelValues(method))
+	ctx := newContextWithBearerToken(t, server.tokenMaker, banker.ID, banker.Role, time.Minute)
+	resp, err := server.GRPCAudit(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	require.NoError(t, err)
+	require.Equal(t, "done", resp)
+	require.Equal(t, failures+1, testutil.ToFloat64(metrics.AuditLogWriteFailures.WithLabelValues(method)))
+}
+
+func TestGatewayAuditCountsWriteFailures(t *testing.T) {
+	method := "/pb.BankService/FreezeAccount"
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		AppendAuditLogTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(db.AuditLog{}, sql.ErrConnDone)
+
+	server := NewTestServer(t, store)
+	handler := server.gatewayAudit(func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	failures := testutil.ToFloat64(metrics.AuditLogWriteFailures.WithLabelValues(method))
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/v1/accounts/7/freeze", nil), map[string]string{"id": "7"})
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, failures+1, testutil.ToFloat64(metrics.AuditLogWriteFailures.WithLabelValues(method)))
+}
+
+func TestGRPCAuditSkipsReadOnlyMethods(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		AppendAuditLogTx(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	server := NewTestServer(t, store)
+	handler := func(ctx context.Context, req any) (any, error) {
+		require.Nil(t, auditRecordFromContext(ctx))
+		return nil, nil
+	}
+
+	for _, method := range []string{"/pb.BankService/GetAccount", "/grpc.health.v1.Health/Check"} {
+		_, err := server.GRPCAudit(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		require.NoError(t, err)
+	}
+}
+
+func TestAuditTarget(t *testing.T) {
+	record := &auditRecord{}
+	ctx := context.WithValue(context.Background(), auditRecordKey{}, record)
+
+	auditTarget(ctx, "user", int32(3),
+		map[string]any{"email": "old@example.com", "full_name": "Jane", "nickname": "jj"},
+		map[string]any{"email": "new@example.com", "full_name": "Jane", "role": "banker"},
+	)
+	require.Equal(t, "user", record.targetType)
+	require.Equal(t, "3", record.targetID)
+	require.Equal(t, map[string]any{"email": "old@example.com", "nickname": "jj"}, record.before)
+	require.Equal(t, map[string]any{"email": "new@example.com", "role": "banker"}, record.after)
+
+	auditTarget(ctx, "account", int32(5), nil, map[string]any{"currency": "USD"})
+	require.Nil(t, record.before)
+	require.Equal(t, map[string]any{"currency": "USD"}, record.after)
+
+	// Outside an audited call there is nothing to record.
+	auditTarget(context.Background(), "account", int32(5), nil, nil)
+}
+
+func TestListAuditLog(t *testing.T) {
+	user, _ := randomUser(t)
+	auditor, _ := randomUser(t)
+	auditor.Role = utils.AuditorRole
+
+	entries := []db.AuditLog{randomAuditLogEntry(30), randomAuditLogEntry(29), randomAuditLogEntry(28)}
+	actorID := entries[0].ActorID.Int32
+	targetType := "account"
+	pageSize := int32(2)
+
+	testCases := []struct {
+		name          string
+		req           *pb.ListAuditLogRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.TokenMaker) context.Context
+		checkResponse func(t *testing.T, res *pb.ListAuditLogResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.ListAuditLogRequest{ActorId: &actorID, TargetType: &targetType, PageSize: &pageSize},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAuditLog(gomock.Any(), gomock.Eq(db.ListAuditLogParams{
+						ActorID:    pgtype.Int4{Int32: actorID, Valid: true},
+						TargetType: pgtype.Text{String: targetType, Valid: true},
+						PageSize:   pageSize + 1,
+					})).
+					Times(1).
+					Return(entries, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, auditor.ID, auditor.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAuditLogResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetEntries(), 2)
+				require.Equal(t, entries[0].ID, res.GetEntries()[0].GetId())
+				require.Equal(t, actorID, res.GetEntries()[0].GetActorId())
+				require.Equal(t, "frozen", res.GetEntries()[0].GetAfter().AsMap()["status"])
+
+				cursor, err := decodeAuditPageToken(res.GetNextPageToken())
+				require.NoError(t, err)
+				require.Equal(t, entries[1].ID, cursor.ID)
+			},
+		},
+		{
+			name: "NextPage",
+			req:  &pb.ListAuditLogRequest{PageToken: encodeAuditPageToken(auditPageCursor{ID: 29})},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAuditLog(gomock.Any(), gomock.Eq(db.ListAuditLogParams{
+						BeforeID: pgtype.Int8{Int64: 29, Valid: true},
+						PageSize: 21,
+					})).
+					Times(1).
+					Return(entries[2:], nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, auditor.ID, auditor.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAuditLogResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetEntries(), 1)
+				require.Empty(t, res.GetNextPageToken())
+			},
+		},
+		{
+			name: "CustomerNotAllowed",
+			req:  &pb.ListAuditLogRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAuditLog(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAuditLogResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name: "InvalidPageToken",
+			req:  &pb.ListAuditLogRequest{PageToken: "not-a-token"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAuditLog(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, auditor.ID, auditor.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAuditLogResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "InternalError",
+			req:  &pb.ListAuditLogRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAuditLog(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, auditor.ID, auditor.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAuditLogResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		res, err := server.ListAuditLog(testCase.buildContext(t, server.tokenMaker), testCase.req)
+		testCase.checkResponse(t, res, err)
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/rs/zerolog"
+	"github.com/valkyraycho/bank_project/telemetry"
 	"github.com/valkyraycho/bank_project/token"
 	"google.golang.org/grpc/metadata"
 )
@@ -26,7 +28,24 @@ func (s *Server) authorizeUser(ctx context.Context, accessibleRoles []string) (*
 		return nil, fmt.Errorf("missing authorization header")
 	}
 
-	fields := strings.Fields(auths[0])
+	payload, err := s.verifyAuthorizationHeader(auths[0])
+	if err != nil {
+		return nil, err
+	}
+
+	telemetry.UpdateLogger(ctx, func(c zerolog.Context) zerolog.Context {
+		return c.Int32("user_id", payload.UserID).Str("role", payload.Role)
+	})
+	auditActor(ctx, payload.UserID, payload.Role)
+
+	if !slices.Contains(accessibleRoles, payload.Role) {
+		return nil, fmt.Errorf("permission denied")
+	}
+	return payload, err
+}
+
+func (s *Server) verifyAuthorizationHeader(authHeader string) (*token.Payload, error) {
+	fields := strings.Fields(authHeader)
 	if len(fields) < 2 {
 		return nil, fmt.Errorf("invalid authorization header")
 	}
@@ -40,9 +59,5 @@ func (s *Server) authorizeUser(ctx context.Context, accessibleRoles []string) (*
 	if err != nil {
 		return nil, fmt.Errorf("invalid access token: %s", err)
 	}
-
-	if !slices.Contains(accessibleRoles, payload.Role) {
-		return nil, fmt.Errorf("permission denied")
-	}
-	return payload, err
+	return payload, nil
 }
@@ -0,0 +1,226 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/token"
+	"github.com/valkyraycho/bank_project/utils"
+	"github.com/valkyraycho/bank_project/validator"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var requestCashMovementChannels = map[pb.CashMovementChannel]db.CashMovementChannel{
+	pb.CashMovementChannel_CASH_MOVEMENT_CHANNEL_CASH_DESK: db.CashMovementChannelCashDesk,
+	pb.CashMovementChannel_CASH_MOVEMENT_CHANNEL_WIRE:      db.CashMovementChannelWire,
+}
+
+var cashMovementChannels = map[db.CashMovementChannel]pb.CashMovementChannel{
+	db.CashMovementChannelCashDesk: pb.CashMovementChannel_CASH_MOVEMENT_CHANNEL_CASH_DESK,
+	db.CashMovementChannelWire:     pb.CashMovementChannel_CASH_MOVEMENT_CHANNEL_WIRE,
+}
+
+var cashMovementDirections = map[db.CashMovementDirection]pb.CashMovementDirection{
+	db.CashMovementDirectionDeposit:    pb.CashMovementDirection_CASH_MOVEMENT_DIRECTION_DEPOSIT,
+	db.CashMovementDirectionWithdrawal: pb.CashMovementDirection_CASH_MOVEMENT_DIRECTION_WITHDRAWAL,
+}
+
+var cashMovementStatuses = map[db.CashMovementStatus]pb.CashMovementStatus{
+	db.CashMovementStatusPendingApproval: pb.CashMovementStatus_CASH_MOVEMENT_STATUS_PENDING_APPROVAL,
+	db.CashMovementStatusCompleted:       pb.CashMovementStatus_CASH_MOVEMENT_STATUS_COMPLETED,
+	db.CashMovementStatusRejected:        pb.CashMovementStatus_CASH_MOVEMENT_STATUS_REJECTED,
+}
+
+// cashMovementRequest holds the fields DepositRequest and WithdrawRequest
+// share.
+type cashMovementRequest interface {
+	GetAccountId() int32
+	GetAmount() int32
+	GetCurrency() string
+	GetChannel() pb.CashMovementChannel
+	GetExternalReference() string
+	GetMemo() string
+}
+
+func (s *Server) Deposit(ctx context.Context, req *pb.DepositRequest) (*pb.DepositResponse, error) {
+	payload, err := s.authorizeUser(ctx, []string{utils.BankerRole})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	result, err := s.createCashMovement(ctx, payload, db.CashMovementDirectionDeposit, req)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.DepositResponse{CashMovement: convertCashMovement(result.CashMovement)}
+	if result.Account != nil {
+		rsp.Account = convertAccount(*result.Account)
+	}
+	if result.ApprovalRequest != nil {
+		rsp.ApprovalRequest = convertApprovalRequest(*result.ApprovalRequest)
+	}
+	return rsp, nil
+}
+
+func (s *Server) Withdraw(ctx context.Context, req *pb.WithdrawRequest) (*pb.WithdrawResponse, error) {
+	payload, err := s.authorizeUser(ctx, []string{utils.BankerRole})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	result, err := s.createCashMovement(ctx, payload, db.CashMovementDirectionWithdrawal, req)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.WithdrawResponse{CashMovement: convertCashMovement(result.CashMovement)}
+	if result.Account != nil {
+		rsp.Account = convertAccount(*result.Account)
+	}
+	if result.ApprovalRequest != nil {
+		rsp.ApprovalRequest = convertApprovalRequest(*result.ApprovalRequest)
+	}
+	return rsp, nil
+}
+
+// createCashMovement settles a deposit or withdrawal right away when it is
+// within the approval threshold and puts it behind an approval request
+// otherwise.
+func (s *Server) createCashMovement(ctx context.Context, payload *token.Payload, direction db.CashMovementDirection, req cashMovementRequest) (db.CashMovementTxResult, error) {
+	violations := validateCashMovementRequest(req)
+	if len(violations) > 0 {
+		return db.CashMovementTxResult{}, invalidArgumentsError(violations)
+	}
+
+	account, err := s.store.GetAccount(ctx, req.GetAccountId())
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return db.CashMovementTxResult{}, status.Errorf(codes.NotFound, "account not found: %s", err)
+		}
+		return db.CashMovementTxResult{}, status.Errorf(codes.Internal, "failed to retrieve account: %s", err)
+	}
+
+	if account.Status != db.AccountStatusActive {
+		return db.CashMovementTxResult{}, status.Errorf(codes.FailedPrecondition, "account %d is %s", account.ID, account.Status)
+	}
+
+	if account.Currency != req.GetCurrency() {
+		return db.CashMovementTxResult{}, status.Errorf(codes.FailedPrecondition, "account currency must match %s", req.GetCurrency())
+	}
+
+	product, err := s.store.GetAccountProduct(ctx, account.ProductCode)
+	if err != nil {
+		return db.CashMovementTxResult{}, status.Errorf(codes.Internal, "failed to retrieve account product: %s", err)
+	}
+	if product.Internal {
+		return db.CashMovementTxResult{}, status.Errorf(codes.FailedPrecondition, "cash movements are not allowed on internal account %d", account.ID)
+	}
+
+	args := db.CashMovementTxParams{
+		Direction:         direction,
+		Channel:           requestCashMovementChannels[req.GetChannel()],
+		AccountID:         account.ID,
+		Amount:            req.GetAmount(),
+		ExternalReference: req.GetExternalReference(),
+		Memo:              req.GetMemo(),
+		RequestedBy:       payload.UserID,
+	}
+	if req.GetAmount() > s.cfg.ApprovalThreshold {
+		args.RequiresApproval = true
+		args.ApprovalExpiresAt = time.Now().Add(s.cfg.ApprovalTTL)
+	}
+
+	result, err := s.store.CashMovementTx(ctx, args)
+	if err != nil {
+		return result, cashMovementError(err)
+	}
+
+	auditTarget(ctx, "cash_movement", result.CashMovement.ID, nil, map[string]any{
+		"direction":          result.CashMovement.Direction,
+		"channel":            result.CashMovement.Channel,
+		"account_id":         result.CashMovement.AccountID,
+		"amount":             result.CashMovement.Amount,
+		"currency":           account.Currency,
+		"external_reference": result.CashMovement.ExternalReference,
+		"status":             result.CashMovement.Status,
+	})
+	return result, nil
+}
+
+func cashMovementError(err error) error {
+	var notActive *db.AccountNotActiveError
+	var ruleErr *db.ProductRuleError
+	var screeningErr *db.OwnerScreeningError
+	var pgErr *pgconn.PgError
+	switch {
+	case err == pgx.ErrNoRows:
+		return status.Errorf(codes.NotFound, "cash movement not found: %s", err)
+	case errors.As(err, &pgErr) && pgErr.Code == db.UniqueViolation:
+		return status.Errorf(codes.AlreadyExists, "external reference already used: %s", err)
+	case errors.As(err, &notActive), errors.As(err, &ruleErr), errors.As(err, &screeningErr), errors.Is(err, db.ErrCashMovementNotPending):
+		return status.Errorf(codes.FailedPrecondition, "%s", err)
+	}
+	return status.Errorf(codes.Internal, "failed to settle cash movement: %s", err)
+}
+
+func validateCashMovementRequest(req cashMovementRequest) []*errdetails.BadRequest_FieldViolation {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+
+	if err := validator.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if req.GetAmount() <= 0 {
+		violations = append(violations, fieldViolation("amount", errors.New("must be a positive integer")))
+	}
+
+	if err := validator.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	if _, ok := requestCashMovementChannels[req.GetChannel()]; !ok {
+		violations = append(violations, fieldViolation("channel", errors.New("must be cash desk or wire")))
+	}
+
+	if err := validator.ValidateString(req.GetExternalReference(), 1, 100); err != nil {
+		violations = append(violations, fieldViolation("external_reference", err))
+	}
+
+	if err := validator.ValidateString(req.GetMemo(), 0, 200); err != nil {
+		violations = append(violations, fieldViolation("memo", err))
+	}
+
+	return violations
+}
+
+func convertCashMovement(movement db.CashMovement) *pb.CashMovement {
+	rsp := &pb.CashMovement{
+		Id:                movement.ID,
+		Direction:         cashMovementDirections[movement.Direction],
+		Channel:           cashMovementChannels[movement.Channel],
+		AccountId:         movement.AccountID,
+		Amount:            movement.Amount,
+		ExternalReference: movement.ExternalReference,
+		Memo:              movement.Memo,
+		Status:            cashMovementStatuses[movement.Status],
+		RequestedBy:       movement.RequestedBy,
+		DecisionReason:    movement.DecisionReason,
+		CreatedAt:         timestamppb.New(movement.CreatedAt),
+	}
+	if movement.DecidedBy.Valid {
+		rsp.DecidedBy = &movement.DecidedBy.Int32
+	}
+	if movement.DecidedAt.Valid {
+		rsp.DecidedAt = timestamppb.New(movement.DecidedAt.Time)
+	}
+	return rsp
+}
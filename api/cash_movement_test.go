@@ -0,0 +1,455 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/token"
+	"github.com/valkyraycho/bank_project/utils"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDeposit(t *testing.T) {
+	user, account := randomAccount(t)
+	banker, _ := randomUser(t)
+	banker.Role = utils.BankerRole
+
+	threshold := int32(1000)
+	approvalTTL := time.Hour
+
+	newRequest := func(amount int32) *pb.DepositRequest {
+		return &pb.DepositRequest{
+			AccountId:         account.ID,
+			Amount:            amount,
+			Currency:          account.Currency,
+			Channel:           pb.CashMovementChannel_CASH_MOVEMENT_CHANNEL_CASH_DESK,
+			ExternalReference: "teller-0042",
+			Memo:              "cash deposit",
+		}
+	}
+
+	credited := account
+	credited.Balance += 500
+
+	testCases := []struct {
+		name          string
+		req           *pb.DepositRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.TokenMaker) context.Context
+		checkResponse func(t *testing.T, res *pb.DepositResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  newRequest(500),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(account.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
+				store.EXPECT().
+					CashMovementTx(gomock.Any(), gomock.Eq(db.CashMovementTxParams{
+						Direction:         db.CashMovementDirectionDeposit,
+						Channel:           db.CashMovementChannelCashDesk,
+						AccountID:         account.ID,
+						Amount:            500,
+						ExternalReference: "teller-0042",
+						Memo:              "cash deposit",
+						RequestedBy:       banker.ID,
+						RequiresApproval:  false,
+					})).
+					Times(1).
+					Return(db.CashMovementTxResult{
+						CashMovement: db.CashMovement{
+							ID:        1,
+							Direction: db.CashMovementDirectionDeposit,
+							Channel:   db.CashMovementChannelCashDesk,
+							AccountID: account.ID,
+							Amount:    500,
+							Status:    db.CashMovementStatusCompleted,
+						},
+						Account: &credited,
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DepositResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, pb.CashMovementStatus_CASH_MOVEMENT_STATUS_COMPLETED, res.GetCashMovement().GetStatus())
+				require.Equal(t, pb.CashMovementChannel_CASH_MOVEMENT_CHANNEL_CASH_DESK, res.GetCashMovement().GetChannel())
+				require.Equal(t, credited.Balance, res.GetAccount().GetBalance())
+			},
+		},
+		{
+			name: "AboveThresholdNeedsApproval",
+			req:  newRequest(threshold + 1),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(account.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
+				store.EXPECT().
+					CashMovementTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, args db.CashMovementTxParams) (db.CashMovementTxResult, error) {
+						require.True(t, args.RequiresApproval)
+						require.WithinDuration(t, time.Now().Add(approvalTTL), args.ApprovalExpiresAt, time.Second)
+						return db.CashMovementTxResult{
+							CashMovement: db.CashMovement{ID: 1, Status: db.CashMovementStatusPendingApproval},
+							ApprovalRequest: &db.ApprovalRequest{
+								ID:          3,
+								Action:      db.ApprovalActionCashMovement,
+								Payload:     []byte(`{"cash_movement_id": 1}`),
+								Status:      db.ApprovalStatusPending,
+								RequestedBy: banker.ID,
+							},
+						}, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DepositResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, pb.CashMovementStatus_CASH_MOVEMENT_STATUS_PENDING_APPROVAL, res.GetCashMovement().GetStatus())
+				require.Nil(t, res.GetAccount())
+				require.Equal(t, int64(3), res.GetApprovalRequest().GetId())
+				require.Equal(t, pb.ApprovalStatus_APPROVAL_STATUS_PENDING, res.GetApprovalRequest().GetStatus())
+				require.Equal(t, float64(1), res.GetApprovalRequest().GetPayload().AsMap()["cash_movement_id"])
+			},
+		},
+		{
+			name: "CustomerNotAllowed",
+			req:  newRequest(500),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CashMovementTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DepositResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name: "InvalidArguments",
+			req: &pb.DepositRequest{
+				AccountId: account.ID,
+				Amount:    0,
+				Currency:  account.Currency,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DepositResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "CurrencyMismatch",
+			req: &pb.DepositRequest{
+				AccountId:         account.ID,
+				Amount:            500,
+				Currency:          utils.EUR,
+				Channel:           pb.CashMovementChannel_CASH_MOVEMENT_CHANNEL_WIRE,
+				ExternalReference: "WIRE-1",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					CashMovementTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DepositResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "InternalAccount",
+			req:  newRequest(500),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				internal := randomAccountProduct()
+				internal.Internal = true
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(account.ProductCode)).
+					Times(1).
+					Return(internal, nil)
+
+				store.EXPECT().
+					CashMovementTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DepositResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "DuplicateExternalReference",
+			req:  newRequest(500),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(account.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
+				store.EXPECT().
+					CashMovementTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CashMovementTxResult{}, db.ErrUniqueViolation)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DepositResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.AlreadyExists, st.Code())
+			},
+		},
+		{
+			name: "OwnerNotCleared",
+			req:  newRequest(500),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(account.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
+				store.EXPECT().
+					CashMovementTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CashMovementTxResult{}, &db.OwnerScreeningError{AccountID: account.ID, Status: db.ScreeningStatusPendingReview})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DepositResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "InternalError",
+			req:  newRequest(500),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(account.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
+				store.EXPECT().
+					CashMovementTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CashMovementTxResult{}, sql.ErrConnDone)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DepositResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		server.cfg.ApprovalThreshold = threshold
+		server.cfg.ApprovalTTL = approvalTTL
+		res, err := server.Deposit(testCase.buildContext(t, server.tokenMaker), testCase.req)
+		testCase.checkResponse(t, res, err)
+	}
+}
+
+func TestWithdraw(t *testing.T) {
+	_, account := randomAccount(t)
+	banker, _ := randomUser(t)
+	banker.Role = utils.BankerRole
+
+	req := &pb.WithdrawRequest{
+		AccountId:         account.ID,
+		Amount:            500,
+		Currency:          account.Currency,
+		Channel:           pb.CashMovementChannel_CASH_MOVEMENT_CHANNEL_WIRE,
+		ExternalReference: "WIRE-20240101-17",
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.WithdrawResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(account.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
+				store.EXPECT().
+					CashMovementTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, args db.CashMovementTxParams) (db.CashMovementTxResult, error) {
+						require.Equal(t, db.CashMovementDirectionWithdrawal, args.Direction)
+						require.Equal(t, db.CashMovementChannelWire, args.Channel)
+						return db.CashMovementTxResult{
+							CashMovement: db.CashMovement{ID: 1, Direction: args.Direction, Status: db.CashMovementStatusCompleted},
+							Account:      &account,
+						}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, res *pb.WithdrawResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, pb.CashMovementDirection_CASH_MOVEMENT_DIRECTION_WITHDRAWAL, res.GetCashMovement().GetDirection())
+				require.NotNil(t, res.GetAccount())
+			},
+		},
+		{
+			name: "InsufficientFunds",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(account.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
+				store.EXPECT().
+					CashMovementTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CashMovementTxResult{}, &db.ProductRuleError{ProductCode: account.ProductCode, Rule: db.ErrInsufficientFunds})
+			},
+			checkResponse: func(t *testing.T, res *pb.WithdrawResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "FrozenAccount",
+			buildStubs: func(store *mockdb.MockStore) {
+				frozen := account
+				frozen.Status = db.AccountStatusFrozen
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(frozen, nil)
+
+				store.EXPECT().
+					CashMovementTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.WithdrawResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		server.cfg.ApprovalThreshold = 1000
+		ctx := newContextWithBearerToken(t, server.tokenMaker, banker.ID, banker.Role, time.Minute)
+		res, err := server.Withdraw(ctx, req)
+		testCase.checkResponse(t, res, err)
+	}
+}
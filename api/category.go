@@ -0,0 +1,203 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/utils"
+	"github.com/valkyraycho/bank_project/validator"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) UpdateEntryCategory(ctx context.Context, req *pb.UpdateEntryCategoryRequest) (*pb.UpdateEntryCategoryResponse, error) {
+	payload, err := s.authorizeUser(ctx, []string{utils.CustomerRole})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	violations := validateUpdateEntryCategoryRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentsError(violations)
+	}
+
+	entry, err := s.store.GetEntry(ctx, req.GetId())
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "entry not found: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to retrieve entry: %s", err)
+	}
+
+	account, err := s.store.GetAccount(ctx, entry.AccountID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve account: %s", err)
+	}
+
+	if payload.UserID != account.OwnerID {
+		return nil, status.Error(codes.PermissionDenied, "no permission to categorize an entry of an account that does not belong to you")
+	}
+
+	updated, err := s.store.SetEntryCategory(ctx, db.SetEntryCategoryParams{
+		ID:       entry.ID,
+		Category: pgtype.Text{String: req.GetCategory(), Valid: req.GetCategory() != ""},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to categorize entry: %s", err)
+	}
+
+	auditTarget(ctx, "entry", entry.ID,
+		map[string]any{"category": entry.Category.String},
+		map[string]any{"category": updated.Category.String},
+	)
+	return &pb.UpdateEntryCategoryResponse{Entry: convertEntry(updated)}, nil
+}
+
+func validateUpdateEntryCategoryRequest(req *pb.UpdateEntryCategoryRequest) []*errdetails.BadRequest_FieldViolation {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+
+	if err := validator.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	if req.GetCategory() != "" {
+		if err := validator.ValidateCategory(req.GetCategory()); err != nil {
+			violations = append(violations, fieldViolation("category", err))
+		}
+	}
+
+	return violations
+}
+
+func (s *Server) CreateCategoryRule(ctx context.Context, req *pb.CreateCategoryRuleRequest) (*pb.CreateCategoryRuleResponse, error) {
+	payload, err := s.authorizeUser(ctx, []string{utils.CustomerRole})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	violations := validateCreateCategoryRuleRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentsError(violations)
+	}
+
+	if req.CounterpartyAccountId != nil {
+		if _, err := s.store.GetAccount(ctx, req.GetCounterpartyAccountId()); err != nil {
+			if err == pgx.ErrNoRows {
+				return nil, status.Errorf(codes.NotFound, "counterparty account not found: %s", err)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to retrieve account: %s", err)
+		}
+	}
+
+	rule, err := s.store.CreateCategoryRule(ctx, db.CreateCategoryRuleParams{
+		UserID:                payload.UserID,
+		Category:              req.GetCategory(),
+		MemoContains:          optionalText(req.MemoContains),
+		CounterpartyAccountID: optionalInt4(req.CounterpartyAccountId),
+		Priority:              req.GetPriority(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create category rule: %s", err)
+	}
+
+	auditTarget(ctx, "category_rule", rule.ID, nil, map[string]any{
+		"category":                rule.Category,
+		"memo_contains":           rule.MemoContains.String,
+		"counterparty_account_id": rule.CounterpartyAccountID.Int32,
+		"priority":                rule.Priority,
+	})
+	return &pb.CreateCategoryRuleResponse{Rule: convertCategoryRule(rule)}, nil
+}
+
+func validateCreateCategoryRuleRequest(req *pb.CreateCategoryRuleRequest) []*errdetails.BadRequest_FieldViolation {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+
+	if err := validator.ValidateCategory(req.GetCategory()); err != nil {
+		violations = append(violations, fieldViolation("category", err))
+	}
+
+	if req.MemoContains == nil && req.CounterpartyAccountId == nil {
+		violations = append(violations, fieldViolation("memo_contains", errors.New("memo_contains or counterparty_account_id is required")))
+	}
+
+	if req.MemoContains != nil {
+		if err := validator.ValidateString(req.GetMemoContains(), 1, 140); err != nil {
+			violations = append(violations, fieldViolation("memo_contains", err))
+		}
+	}
+
+	if req.CounterpartyAccountId != nil {
+		if err := validator.ValidateID(req.GetCounterpartyAccountId()); err != nil {
+			violations = append(violations, fieldViolation("counterparty_account_id", err))
+		}
+	}
+
+	return violations
+}
+
+func (s *Server) ListCategoryRules(ctx context.Context, req *pb.ListCategoryRulesRequest) (*pb.ListCategoryRulesResponse, error) {
+	payload, err := s.authorizeUser(ctx, []string{utils.CustomerRole})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	rules, err := s.store.ListCategoryRules(ctx, payload.UserID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list category rules: %s", err)
+	}
+
+	rsp := &pb.ListCategoryRulesResponse{Rules: []*pb.CategoryRule{}}
+	for _, rule := range rules {
+		rsp.Rules = append(rsp.Rules, convertCategoryRule(rule))
+	}
+	return rsp, nil
+}
+
+func (s *Server) DeleteCategoryRule(ctx context.Context, req *pb.DeleteCategoryRuleRequest) (*pb.DeleteCategoryRuleResponse, error) {
+	payload, err := s.authorizeUser(ctx, []string{utils.CustomerRole})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	if err := validator.ValidateID(req.GetId()); err != nil {
+		return nil, invalidArgumentsError([]*errdetails.BadRequest_FieldViolation{fieldViolation("id", err)})
+	}
+
+	// Rules of other users are reported as not found rather than forbidden,
+	// so rule ids can't be probed.
+	rule, err := s.store.DeleteCategoryRule(ctx, db.DeleteCategoryRuleParams{
+		ID:     req.GetId(),
+		UserID: payload.UserID,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "category rule not found: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete category rule: %s", err)
+	}
+
+	auditTarget(ctx, "category_rule", rule.ID, map[string]any{"category": rule.Category}, nil)
+	return &pb.DeleteCategoryRuleResponse{}, nil
+}
+
+func convertCategoryRule(rule db.CategoryRule) *pb.CategoryRule {
+	rsp := &pb.CategoryRule{
+		Id:        rule.ID,
+		Category:  rule.Category,
+		Priority:  rule.Priority,
+		CreatedAt: timestamppb.New(rule.CreatedAt),
+	}
+	if rule.MemoContains.Valid {
+		rsp.MemoContains = &rule.MemoContains.String
+	}
+	if rule.CounterpartyAccountID.Valid {
+		rsp.CounterpartyAccountId = &rule.CounterpartyAccountID.Int32
+	}
+	return rsp
+}
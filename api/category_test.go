@@ -0,0 +1,316 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/token"
+	"github.com/valkyraycho/bank_project/utils"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUpdateEntryCategory(t *testing.T) {
+	user, account := randomAccount(t)
+	other, _ := randomUser(t)
+	other.ID = user.ID + 1
+
+	entry := db.Entry{
+		ID:        utils.RandomInt(1, 100),
+		AccountID: account.ID,
+		Amount:    -500,
+		CreatedAt: time.Now(),
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.UpdateEntryCategoryRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.TokenMaker) context.Context
+		checkResponse func(t *testing.T, res *pb.UpdateEntryCategoryResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.UpdateEntryCategoryRequest{Id: entry.ID, Category: "groceries"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEntry(gomock.Any(), gomock.Eq(entry.ID)).
+					Times(1).
+					Return(entry, nil)
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				categorized := entry
+				categorized.Category = pgtype.Text{String: "groceries", Valid: true}
+				store.EXPECT().
+					SetEntryCategory(gomock.Any(), gomock.Eq(db.SetEntryCategoryParams{
+						ID:       entry.ID,
+						Category: pgtype.Text{String: "groceries", Valid: true},
+					})).
+					Times(1).
+					Return(categorized, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateEntryCategoryResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "groceries", res.GetEntry().GetCategory())
+			},
+		},
+		{
+			name: "ClearCategory",
+			req:  &pb.UpdateEntryCategoryRequest{Id: entry.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEntry(gomock.Any(), gomock.Eq(entry.ID)).
+					Times(1).
+					Return(entry, nil)
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+				store.EXPECT().
+					SetEntryCategory(gomock.Any(), gomock.Eq(db.SetEntryCategoryParams{ID: entry.ID})).
+					Times(1).
+					Return(entry, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateEntryCategoryResponse, err error) {
+				require.NoError(t, err)
+				require.Empty(t, res.GetEntry().GetCategory())
+			},
+		},
+		{
+			name: "PermissionDenied",
+			req:  &pb.UpdateEntryCategoryRequest{Id: entry.ID, Category: "groceries"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEntry(gomock.Any(), gomock.Eq(entry.ID)).
+					Times(1).
+					Return(entry, nil)
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+				store.EXPECT().
+					SetEntryCategory(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, other.ID, other.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateEntryCategoryResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "EntryNotFound",
+			req:  &pb.UpdateEntryCategoryRequest{Id: entry.ID, Category: "groceries"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEntry(gomock.Any(), gomock.Eq(entry.ID)).
+					Times(1).
+					Return(db.Entry{}, pgx.ErrNoRows)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateEntryCategoryResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name: "InvalidCategory",
+			req:  &pb.UpdateEntryCategoryRequest{Id: entry.ID, Category: "Groceries!"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEntry(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateEntryCategoryResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		res, err := server.UpdateEntryCategory(testCase.buildContext(t, server.tokenMaker), testCase.req)
+		testCase.checkResponse(t, res, err)
+	}
+}
+
+func TestCreateCategoryRule(t *testing.T) {
+	user, account := randomAccount(t)
+	memo := "rent"
+
+	testCases := []struct {
+		name          string
+		req           *pb.CreateCategoryRuleRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.CreateCategoryRuleResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.CreateCategoryRuleRequest{Category: "housing", MemoContains: &memo, CounterpartyAccountId: &account.ID, Priority: 2},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+				store.EXPECT().
+					CreateCategoryRule(gomock.Any(), gomock.Eq(db.CreateCategoryRuleParams{
+						UserID:                user.ID,
+						Category:              "housing",
+						MemoContains:          pgtype.Text{String: memo, Valid: true},
+						CounterpartyAccountID: pgtype.Int4{Int32: account.ID, Valid: true},
+						Priority:              2,
+					})).
+					Times(1).
+					Return(db.CategoryRule{
+						ID:                    1,
+						UserID:                user.ID,
+						Category:              "housing",
+						MemoContains:          pgtype.Text{String: memo, Valid: true},
+						CounterpartyAccountID: pgtype.Int4{Int32: account.ID, Valid: true},
+						Priority:              2,
+					}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateCategoryRuleResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "housing", res.GetRule().GetCategory())
+				require.Equal(t, memo, res.GetRule().GetMemoContains())
+				require.Equal(t, account.ID, res.GetRule().GetCounterpartyAccountId())
+			},
+		},
+		{
+			name: "CounterpartyNotFound",
+			req:  &pb.CreateCategoryRuleRequest{Category: "housing", CounterpartyAccountId: &account.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(db.Account{}, pgx.ErrNoRows)
+				store.EXPECT().
+					CreateCategoryRule(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateCategoryRuleResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name: "NoCondition",
+			req:  &pb.CreateCategoryRuleRequest{Category: "housing"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateCategoryRule(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateCategoryRuleResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		ctx := newContextWithBearerToken(t, server.tokenMaker, user.ID, user.Role, time.Minute)
+		res, err := server.CreateCategoryRule(ctx, testCase.req)
+		testCase.checkResponse(t, res, err)
+	}
+}
+
+func TestDeleteCategoryRule(t *testing.T) {
+	user, _ := randomUser(t)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.DeleteCategoryRuleResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteCategoryRule(gomock.Any(), gomock.Eq(db.DeleteCategoryRuleParams{ID: 5, UserID: user.ID})).
+					Times(1).
+					Return(db.CategoryRule{ID: 5, UserID: user.ID, Category: "housing"}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.DeleteCategoryRuleResponse, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteCategoryRule(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CategoryRule{}, pgx.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, res *pb.DeleteCategoryRuleResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		ctx := newContextWithBearerToken(t, server.tokenMaker, user.ID, user.Role, time.Minute)
+		res, err := server.DeleteCategoryRule(ctx, &pb.DeleteCategoryRuleRequest{Id: 5})
+		testCase.checkResponse(t, res, err)
+	}
+}
@@ -0,0 +1,121 @@
+package api
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+)
+
+const forwardedHeader = "forwarded"
+
+// parseTrustedProxies parses a comma-separated list of CIDRs. Bare addresses
+// are treated as single-host prefixes.
+func parseTrustedProxies(value string) ([]netip.Prefix, error) {
+	var proxies []netip.Prefix
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		if strings.Contains(entry, "/") {
+			prefix, err := netip.ParsePrefix(entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: %w", entry, err)
+			}
+			proxies = append(proxies, prefix.Masked())
+			continue
+		}
+
+		addr, err := netip.ParseAddr(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", entry, err)
+		}
+		addr = addr.Unmap()
+		proxies = append(proxies, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return proxies, nil
+}
+
+func (s *Server) isTrustedProxy(addr netip.Addr) bool {
+	for _, prefix := range s.trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveClientIP returns the address of the client that sent the request.
+// Forwarding headers are only honoured while the hop that added them is a
+// trusted proxy: the chain is walked right to left starting at remoteAddr and
+// the first untrusted address wins. The Forwarded header takes precedence
+// over X-Forwarded-For when both are present, so trusted proxies that only
+// set X-Forwarded-For must strip an incoming Forwarded header.
+func (s *Server) resolveClientIP(remoteAddr string, forwardedFor, forwarded []string) string {
+	remote, ok := parseIP(remoteAddr)
+	if !ok {
+		return ""
+	}
+
+	chain := parseForwarded(forwarded)
+	if len(forwarded) == 0 {
+		chain = parseForwardedFor(forwardedFor)
+	}
+
+	client := remote
+	for i := len(chain) - 1; i >= 0 && s.isTrustedProxy(client); i-- {
+		addr, ok := parseIP(chain[i])
+		if !ok {
+			// Whatever sits left of a malformed entry cannot be trusted.
+			break
+		}
+		client = addr
+	}
+	return client.String()
+}
+
+func parseForwardedFor(values []string) []string {
+	var chain []string
+	for _, value := range values {
+		chain = append(chain, strings.Split(value, ",")...)
+	}
+	return chain
+}
+
+// parseForwarded extracts the for= node of every element of RFC 7239
+// Forwarded headers. Elements without one yield an empty entry.
+func parseForwarded(values []string) []string {
+	var chain []string
+	for _, value := range values {
+		for _, element := range strings.Split(value, ",") {
+			node := ""
+			for _, pair := range strings.Split(element, ";") {
+				key, val, ok := strings.Cut(strings.TrimSpace(pair), "=")
+				if ok && strings.EqualFold(key, "for") {
+					node = strings.Trim(val, `"`)
+				}
+			}
+			chain = append(chain, node)
+		}
+	}
+	return chain
+}
+
+// parseIP normalizes an address that may carry a port, brackets or a zone,
+// and maps IPv4-mapped IPv6 addresses back to IPv4. Obfuscated identifiers
+// such as "unknown" or "_hidden" are rejected.
+func parseIP(value string) (netip.Addr, bool) {
+	value = strings.TrimSpace(value)
+	if host, _, err := net.SplitHostPort(value); err == nil {
+		value = host
+	}
+	value = strings.TrimSuffix(strings.TrimPrefix(value, "["), "]")
+
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.WithZone("").Unmap(), true
+}
@@ -0,0 +1,183 @@
+package api
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/utils"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestResolveClientIP(t *testing.T) {
+	server, err := NewServer(utils.Config{
+		TokenSymmetricKey: utils.RandomString(32),
+		TrustedProxies:    "10.0.0.0/8, 2001:db8::/32,192.168.1.1",
+	}, nil)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor []string
+		forwarded    []string
+		expected     string
+	}{
+		{
+			name:       "DirectClient",
+			remoteAddr: "203.0.113.7:51234",
+			expected:   "203.0.113.7",
+		},
+		{
+			name:         "UntrustedPeerCannotSpoof",
+			remoteAddr:   "203.0.113.7:51234",
+			forwardedFor: []string{"198.51.100.1"},
+			expected:     "203.0.113.7",
+		},
+		{
+			name:         "TrustedProxy",
+			remoteAddr:   "10.0.0.5:443",
+			forwardedFor: []string{"198.51.100.1"},
+			expected:     "198.51.100.1",
+		},
+		{
+			name:         "RightToLeft",
+			remoteAddr:   "10.0.0.5:443",
+			forwardedFor: []string{"1.1.1.1, 198.51.100.1", "192.168.1.1"},
+			expected:     "198.51.100.1",
+		},
+		{
+			name:         "AllTrusted",
+			remoteAddr:   "10.0.0.5:443",
+			forwardedFor: []string{"10.1.1.1, 10.2.2.2"},
+			expected:     "10.1.1.1",
+		},
+		{
+			name:         "MalformedEntry",
+			remoteAddr:   "10.0.0.5:443",
+			forwardedFor: []string{"198.51.100.1, garbage"},
+			expected:     "10.0.0.5",
+		},
+		{
+			name:       "Forwarded",
+			remoteAddr: "[2001:db8::1]:443",
+			forwarded:  []string{`for=198.51.100.1;proto=https, for="[2001:db8:cafe::17]:4711"`},
+			expected:   "198.51.100.1",
+		},
+		{
+			name:         "ForwardedTakesPrecedence",
+			remoteAddr:   "10.0.0.5:443",
+			forwardedFor: []string{"1.1.1.1"},
+			forwarded:    []string{"for=198.51.100.1"},
+			expected:     "198.51.100.1",
+		},
+		{
+			name:       "ForwardedObfuscated",
+			remoteAddr: "10.0.0.5:443",
+			forwarded:  []string{"for=unknown"},
+			expected:   "10.0.0.5",
+		},
+		{
+			name:         "IPv4MappedIPv6",
+			remoteAddr:   "[::ffff:10.0.0.5]:443",
+			forwardedFor: []string{"::ffff:198.51.100.1"},
+			expected:     "198.51.100.1",
+		},
+		{
+			name:       "InvalidRemoteAddr",
+			remoteAddr: "pipe",
+			expected:   "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		clientIP := server.resolveClientIP(testCase.remoteAddr, testCase.forwardedFor, testCase.forwarded)
+		require.Equal(t, testCase.expected, clientIP, testCase.name)
+	}
+}
+
+func TestExtractMetadataClientIP(t *testing.T) {
+	server, err := NewServer(utils.Config{
+		TokenSymmetricKey: utils.RandomString(32),
+		TrustedProxies:    "127.0.0.1",
+	}, nil)
+	require.NoError(t, err)
+
+	// gRPC clients cannot fake the gateway address, the peer always wins.
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		xForwardedForHeader, "198.51.100.1",
+	))
+	ctx = context.WithValue(ctx, gatewayClientKey{}, gatewayClient{remoteAddr: "127.0.0.1:1234"})
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("203.0.113.7"), Port: 5000}})
+	require.Equal(t, "203.0.113.7", server.extractMetadata(ctx).ClientIP)
+
+	// Gateway requests resolve from the HTTP connection, not the metadata.
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		xForwardedForHeader, "192.0.2.99",
+	))
+	ctx = context.WithValue(ctx, gatewayClientKey{}, gatewayClient{
+		remoteAddr:   "127.0.0.1:1234",
+		forwardedFor: []string{"198.51.100.1"},
+	})
+	require.Equal(t, "198.51.100.1", server.extractMetadata(ctx).ClientIP)
+
+	require.Empty(t, server.extractMetadata(context.Background()).ClientIP)
+}
+
+func TestGatewayClientIPSpoofedMetadata(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	user, password := randomUser(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+	store.EXPECT().
+		CreateSession(gomock.Any(), gomock.Cond(func(arg db.CreateSessionParams) bool {
+			return arg.ClientIp == "192.0.2.1"
+		})).
+		Times(1).
+		Return(db.Session{UserID: user.ID}, nil)
+	store.EXPECT().AppendAuditLogTx(gomock.Any(), gomock.Any()).AnyTimes()
+
+	server, err := NewServer(utils.Config{
+		TokenSymmetricKey:    utils.RandomString(32),
+		AccessTokenDuration:  time.Minute,
+		RefreshTokenDuration: time.Hour,
+		TrustedProxies:       "8.8.8.8",
+	}, store)
+	require.NoError(t, err)
+
+	mux, err := server.NewGatewayMux(context.Background())
+	require.NoError(t, err)
+
+	body := strings.NewReader(`{"username":"` + user.Username + `","password":"` + password + `"}`)
+	request := httptest.NewRequest(http.MethodPost, "/v1/users/login", body)
+	request.Header.Set("Grpc-Metadata-X-Gateway-Remote-Addr", "8.8.8.8:1")
+	request.Header.Set("Grpc-Metadata-X-Forwarded-For", "198.51.100.1")
+	request.Header.Set("Grpc-Metadata-Forwarded", "for=198.51.100.1")
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	proxies, err := parseTrustedProxies("10.0.0.1/8, ::1,")
+	require.NoError(t, err)
+	require.Len(t, proxies, 2)
+	require.Equal(t, "10.0.0.0/8", proxies[0].String())
+	require.Equal(t, "::1/128", proxies[1].String())
+
+	_, err = parseTrustedProxies("not-a-cidr")
+	require.Error(t, err)
+}
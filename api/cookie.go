@@ -0,0 +1,131 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/valkyraycho/bank_project/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	refreshTokenCookie = "refresh_token"
+	refreshTokenPath   = "/v1/tokens"
+	csrfCookie         = "csrf_token"
+	csrfHeader         = "X-CSRF-Token"
+	gatewayCookieKey   = "grpcgateway-cookie"
+)
+
+// cookieAuthenticatedMethods accept the refresh token cookie in place of a
+// token in the request, so the gateway requires a CSRF token for them.
+var cookieAuthenticatedMethods = map[string]bool{
+	pb.BankService_RenewAccessToken_FullMethodName: true,
+}
+
+func parseSameSite(value string) (http.SameSite, error) {
+	switch strings.ToLower(value) {
+	case "", "strict":
+		return http.SameSiteStrictMode, nil
+	case "lax":
+		return http.SameSiteLaxMode, nil
+	case "none":
+		return http.SameSiteNoneMode, nil
+	}
+	return 0, fmt.Errorf("invalid SameSite mode: %s", value)
+}
+
+// gatewayRefreshTokenCookie moves the refresh token of a login response into
+// an HttpOnly cookie when cookie mode is enabled, together with the CSRF
+// token the browser has to echo back in the X-CSRF-Token header.
+func (s *Server) gatewayRefreshTokenCookie(ctx context.Context, w http.ResponseWriter, msg proto.Message) error {
+	if !s.cfg.RefreshTokenCookie {
+		return nil
+	}
+	res, ok := msg.(*pb.LoginUserResponse)
+	if !ok {
+		return nil
+	}
+
+	csrfToken, err := newCSRFToken()
+	if err != nil {
+		return err
+	}
+	expiresAt := res.GetRefreshTokenExpiresAt().AsTime()
+
+	http.SetCookie(w, s.newCookie(refreshTokenCookie, res.GetRefreshToken(), refreshTokenPath, expiresAt, true))
+	http.SetCookie(w, s.newCookie(csrfCookie, csrfToken, "/", expiresAt, false))
+	res.RefreshToken = ""
+	return nil
+}
+
+func (s *Server) newCookie(name, value, path string, expiresAt time.Time, httpOnly bool) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     path,
+		Expires:  expiresAt,
+		MaxAge:   int(time.Until(expiresAt).Seconds()),
+		HttpOnly: httpOnly,
+		Secure:   s.cfg.RefreshTokenCookieSecure,
+		SameSite: s.refreshTokenSameSite,
+	}
+}
+
+// gatewayCSRF rejects cookie authenticated requests whose X-CSRF-Token
+// header does not match the CSRF cookie set at login (double-submit).
+func (s *Server) gatewayCSRF(mux *runtime.ServeMux, next runtime.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		if s.cfg.RefreshTokenCookie && cookieAuthenticatedMethods[gatewayMethod(r, pathParams)] {
+			if _, err := r.Cookie(refreshTokenCookie); err == nil && !validCSRFToken(r) {
+				gatewayHTTPError(mux, w, r, status.Errorf(codes.PermissionDenied, "missing or invalid CSRF token"))
+				return
+			}
+		}
+		next(w, r, pathParams)
+	}
+}
+
+func validCSRFToken(r *http.Request) bool {
+	cookie, err := r.Cookie(csrfCookie)
+	if err != nil || cookie.Value == "" {
+		return false
+	}
+	header := r.Header.Get(csrfHeader)
+	return subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(header)) == 1
+}
+
+func newCSRFToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate CSRF token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// refreshTokenFromCookie reads the refresh token cookie the gateway forwards
+// as metadata.
+func refreshTokenFromCookie(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, line := range md.Get(gatewayCookieKey) {
+		cookies, err := http.ParseCookie(line)
+		if err != nil {
+			continue
+		}
+		for _, cookie := range cookies {
+			if cookie.Name == refreshTokenCookie {
+				return cookie.Value
+			}
+		}
+	}
+	return ""
+}
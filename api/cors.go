@@ -0,0 +1,158 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/valkyraycho/bank_project/utils"
+)
+
+const defaultContentSecurityPolicy = "default-src 'none'; frame-ancestors 'none'"
+
+var (
+	defaultCORSMethods = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+	defaultCORSHeaders = []string{"Authorization", "Content-Type", httpRequestIDHeader, csrfHeader, "X-Grpc-Web", "X-User-Agent", "Grpc-Timeout"}
+	corsExposedHeaders = append([]string{httpRequestIDHeader, "Retry-After"}, grpcWebExposedHeaders...)
+)
+
+type corsPolicy struct {
+	allowAnyOrigin   bool
+	allowedOrigins   map[string]bool
+	allowedMethods   []string
+	allowedHeaders   []string
+	allowCredentials bool
+	maxAge           time.Duration
+}
+
+func newCORSPolicy(cfg utils.Config) (corsPolicy, error) {
+	policy := corsPolicy{
+		allowedOrigins:   map[string]bool{},
+		allowedMethods:   splitList(cfg.CORSAllowedMethods, strings.ToUpper),
+		allowedHeaders:   splitList(cfg.CORSAllowedHeaders, strings.TrimSpace),
+		allowCredentials: cfg.CORSAllowCredentials,
+		maxAge:           cfg.CORSMaxAge,
+	}
+
+	for _, origin := range splitList(cfg.CORSAllowedOrigins, strings.ToLower) {
+		if origin == "*" {
+			policy.allowAnyOrigin = true
+			continue
+		}
+		policy.allowedOrigins[origin] = true
+	}
+	if policy.allowAnyOrigin && policy.allowCredentials {
+		return corsPolicy{}, fmt.Errorf("credentials cannot be allowed for any origin")
+	}
+
+	if len(policy.allowedMethods) == 0 {
+		policy.allowedMethods = defaultCORSMethods
+	}
+	if len(policy.allowedHeaders) == 0 {
+		policy.allowedHeaders = defaultCORSHeaders
+	}
+	return policy, nil
+}
+
+func (policy corsPolicy) allowsOrigin(origin string) bool {
+	return policy.allowAnyOrigin || policy.allowedOrigins[strings.ToLower(origin)]
+}
+
+func (policy corsPolicy) allowsHeaders(requested string) bool {
+	for _, header := range splitList(requested, strings.TrimSpace) {
+		allowed := slices.ContainsFunc(policy.allowedHeaders, func(allowed string) bool {
+			return strings.EqualFold(allowed, header)
+		})
+		if !allowed {
+			return false
+		}
+	}
+	return true
+}
+
+// HTTPCORS answers preflight requests and adds CORS headers to responses for
+// allowed origins. Requests from other origins are served without CORS
+// headers, so browsers will not expose the response to the calling page.
+func (s *Server) HTTPCORS(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Add("Vary", "Origin")
+		preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+
+		if !s.cors.allowsOrigin(origin) {
+			if preflight {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		if s.cors.allowAnyOrigin {
+			w.Header().Set("Access-Control-Allow-Origin", "*")
+		} else {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+		}
+		if s.cors.allowCredentials {
+			w.Header().Set("Access-Control-Allow-Credentials", "true")
+		}
+
+		if !preflight {
+			w.Header().Set("Access-Control-Expose-Headers", strings.Join(corsExposedHeaders, ", "))
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Add("Vary", "Access-Control-Request-Method")
+		w.Header().Add("Vary", "Access-Control-Request-Headers")
+
+		method := strings.ToUpper(r.Header.Get("Access-Control-Request-Method"))
+		if !slices.Contains(s.cors.allowedMethods, method) || !s.cors.allowsHeaders(r.Header.Get("Access-Control-Request-Headers")) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		w.Header().Set("Access-Control-Allow-Methods", strings.Join(s.cors.allowedMethods, ", "))
+		w.Header().Set("Access-Control-Allow-Headers", strings.Join(s.cors.allowedHeaders, ", "))
+		if s.cors.maxAge > 0 {
+			w.Header().Set("Access-Control-Max-Age", strconv.Itoa(int(s.cors.maxAge.Seconds())))
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HTTPSecurityHeaders sets headers that keep browsers from sniffing, framing
+// or downgrading API responses.
+func (s *Server) HTTPSecurityHeaders(handler http.Handler) http.Handler {
+	csp := s.cfg.ContentSecurityPolicy
+	if csp == "" {
+		csp = defaultContentSecurityPolicy
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Header().Set("Content-Security-Policy", csp)
+		if s.cfg.HSTSMaxAge > 0 {
+			w.Header().Set("Strict-Transport-Security", fmt.Sprintf("max-age=%d; includeSubDomains", int(s.cfg.HSTSMaxAge.Seconds())))
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+func splitList(value string, normalize func(string) string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, normalize(item))
+		}
+	}
+	return items
+}
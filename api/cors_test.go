@@ -0,0 +1,207 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/utils"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHTTPCORS(t *testing.T) {
+	server, err := NewServer(utils.Config{
+		TokenSymmetricKey:    utils.RandomString(32),
+		CORSAllowedOrigins:   "https://app.example.com",
+		CORSAllowCredentials: true,
+		CORSMaxAge:           10 * time.Minute,
+	}, nil)
+	require.NoError(t, err)
+
+	handler := server.HTTPCORS(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	testCases := []struct {
+		name          string
+		method        string
+		headers       map[string]string
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "Preflight",
+			method: http.MethodOptions,
+			headers: map[string]string{
+				"Origin":                         "https://app.example.com",
+				"Access-Control-Request-Method":  http.MethodPost,
+				"Access-Control-Request-Headers": "content-type, x-csrf-token",
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+				require.Equal(t, "https://app.example.com", recorder.Header().Get("Access-Control-Allow-Origin"))
+				require.Equal(t, "true", recorder.Header().Get("Access-Control-Allow-Credentials"))
+				require.Contains(t, recorder.Header().Get("Access-Control-Allow-Methods"), http.MethodPost)
+				require.Equal(t, "600", recorder.Header().Get("Access-Control-Max-Age"))
+			},
+		},
+		{
+			name:   "PreflightDisallowedOrigin",
+			method: http.MethodOptions,
+			headers: map[string]string{
+				"Origin":                        "https://evil.example.com",
+				"Access-Control-Request-Method": http.MethodPost,
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+				require.Empty(t, recorder.Header().Get("Access-Control-Allow-Origin"))
+			},
+		},
+		{
+			name:   "PreflightDisallowedHeader",
+			method: http.MethodOptions,
+			headers: map[string]string{
+				"Origin":                         "https://app.example.com",
+				"Access-Control-Request-Method":  http.MethodPost,
+				"Access-Control-Request-Headers": "x-custom",
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:    "SimpleRequest",
+			method:  http.MethodGet,
+			headers: map[string]string{"Origin": "https://app.example.com"},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "https://app.example.com", recorder.Header().Get("Access-Control-Allow-Origin"))
+				require.Contains(t, recorder.Header().Get("Access-Control-Expose-Headers"), httpRequestIDHeader)
+				require.Contains(t, recorder.Header().Values("Vary"), "Origin")
+			},
+		},
+		{
+			name:    "DisallowedOrigin",
+			method:  http.MethodGet,
+			headers: map[string]string{"Origin": "https://evil.example.com"},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Empty(t, recorder.Header().Get("Access-Control-Allow-Origin"))
+			},
+		},
+		{
+			name:   "SameOrigin",
+			method: http.MethodGet,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Empty(t, recorder.Header().Get("Vary"))
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(testCase.method, "/v1/accounts", nil)
+		for key, value := range testCase.headers {
+			request.Header.Set(key, value)
+		}
+		handler.ServeHTTP(recorder, request)
+		testCase.checkResponse(t, recorder)
+	}
+}
+
+func TestNewCORSPolicyRejectsWildcardWithCredentials(t *testing.T) {
+	_, err := newCORSPolicy(utils.Config{CORSAllowedOrigins: "*", CORSAllowCredentials: true})
+	require.Error(t, err)
+}
+
+func TestHTTPSecurityHeaders(t *testing.T) {
+	server, err := NewServer(utils.Config{
+		TokenSymmetricKey: utils.RandomString(32),
+		HSTSMaxAge:        24 * time.Hour,
+	}, nil)
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	server.HTTPSecurityHeaders(http.NotFoundHandler()).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, "nosniff", recorder.Header().Get("X-Content-Type-Options"))
+	require.Equal(t, defaultContentSecurityPolicy, recorder.Header().Get("Content-Security-Policy"))
+	require.Equal(t, "max-age=86400; includeSubDomains", recorder.Header().Get("Strict-Transport-Security"))
+}
+
+func TestRefreshTokenCookieMode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	user, password := randomUser(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	var session db.Session
+	store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+	store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(func(_ context.Context, arg db.CreateSessionParams) (db.Session, error) {
+			session = db.Session{ID: arg.ID, UserID: arg.UserID, RefreshToken: arg.RefreshToken, ExpiresAt: arg.ExpiresAt}
+			return session, nil
+		})
+	store.EXPECT().GetSession(gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(func(context.Context, any) (db.Session, error) { return session, nil })
+	store.EXPECT().AppendAuditLogTx(gomock.Any(), gomock.Any()).AnyTimes()
+
+	server, err := NewServer(utils.Config{
+		TokenSymmetricKey:        utils.RandomString(32),
+		AccessTokenDuration:      time.Minute,
+		RefreshTokenDuration:     time.Hour,
+		RefreshTokenCookie:       true,
+		RefreshTokenCookieSecure: true,
+	}, store)
+	require.NoError(t, err)
+
+	mux, err := server.NewGatewayMux(context.Background())
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	body := strings.NewReader(`{"username":"` + user.Username + `","password":"` + password + `"}`)
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/v1/users/login", body))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var loginResponse map[string]any
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&loginResponse))
+	require.Empty(t, loginResponse["refresh_token"])
+
+	cookies := map[string]*http.Cookie{}
+	for _, cookie := range recorder.Result().Cookies() {
+		cookies[cookie.Name] = cookie
+	}
+	require.Equal(t, session.RefreshToken, cookies[refreshTokenCookie].Value)
+	require.True(t, cookies[refreshTokenCookie].HttpOnly)
+	require.True(t, cookies[refreshTokenCookie].Secure)
+	require.Equal(t, http.SameSiteStrictMode, cookies[refreshTokenCookie].SameSite)
+	require.Equal(t, refreshTokenPath, cookies[refreshTokenCookie].Path)
+	require.NotEmpty(t, cookies[csrfCookie].Value)
+	require.False(t, cookies[csrfCookie].HttpOnly)
+
+	renew := func(csrfToken string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodPost, "/v1/tokens/renew_access", strings.NewReader(`{}`))
+		request.AddCookie(cookies[refreshTokenCookie])
+		request.AddCookie(cookies[csrfCookie])
+		if csrfToken != "" {
+			request.Header.Set(csrfHeader, csrfToken)
+		}
+		mux.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	require.Equal(t, http.StatusForbidden, renew("").Code)
+	require.Equal(t, http.StatusForbidden, renew("forged").Code)
+
+	recorder = renew(cookies[csrfCookie].Value)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Contains(t, recorder.Body.String(), "access_token")
+}
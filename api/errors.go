@@ -1,6 +1,16 @@
 package api
 
 import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/telemetry"
+	"google.golang.org/genproto/googleapis/rpc/code"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -23,3 +33,72 @@ func invalidArgumentsError(violations []*errdetails.BadRequest_FieldViolation) e
 	}
 	return statusDetails.Err()
 }
+
+// httpStatusFromCode follows runtime.HTTPStatusFromCode except that a failed
+// precondition, such as a currency mismatch, is reported as 422 rather than
+// 400 so clients can tell it apart from malformed requests.
+func httpStatusFromCode(c codes.Code) int {
+	if c == codes.FailedPrecondition {
+		return http.StatusUnprocessableEntity
+	}
+	return runtime.HTTPStatusFromCode(c)
+}
+
+func newErrorResponse(ctx context.Context, st *status.Status) *pb.ErrorResponse {
+	res := &pb.ErrorResponse{
+		Code:      code.Code(st.Code()).String(),
+		Message:   st.Message(),
+		RequestId: telemetry.RequestID(ctx),
+	}
+
+	for _, detail := range st.Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		if res.FieldErrors == nil {
+			res.FieldErrors = map[string]string{}
+		}
+		for _, violation := range badRequest.GetFieldViolations() {
+			if existing, ok := res.FieldErrors[violation.GetField()]; ok {
+				res.FieldErrors[violation.GetField()] = strings.Join([]string{existing, violation.GetDescription()}, "; ")
+				continue
+			}
+			res.FieldErrors[violation.GetField()] = violation.GetDescription()
+		}
+	}
+	return res
+}
+
+// gatewayErrorHandler writes errors as pb.ErrorResponse, the error shape
+// documented in the OpenAPI spec.
+func gatewayErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	st := status.Convert(err)
+	if record := auditRecordFromContext(ctx); record != nil {
+		record.code = st.Code()
+	}
+
+	for _, detail := range st.Details() {
+		if retryInfo, ok := detail.(*errdetails.RetryInfo); ok {
+			seconds := math.Ceil(retryInfo.GetRetryDelay().AsDuration().Seconds())
+			w.Header().Set("Retry-After", strconv.Itoa(int(seconds)))
+		}
+	}
+	if st.Code() == codes.Unauthenticated {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+	}
+
+	res := newErrorResponse(r.Context(), st)
+	body, marshalErr := marshaler.Marshal(res)
+	if marshalErr != nil {
+		telemetry.Logger(ctx).Error().Err(marshalErr).Msg("failed to marshal error response")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Del("Trailer")
+	w.Header().Del("Transfer-Encoding")
+	w.Header().Set("Content-Type", marshaler.ContentType(res))
+	w.WriteHeader(httpStatusFromCode(st.Code()))
+	w.Write(body)
+}
@@ -0,0 +1,142 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type errorBody struct {
+	Code        string            `json:"code"`
+	Message     string            `json:"message"`
+	RequestID   string            `json:"request_id"`
+	FieldErrors map[string]string `json:"field_errors"`
+}
+
+func TestGatewayErrorHandler(t *testing.T) {
+	rateLimited, err := status.New(codes.ResourceExhausted, "rate limit exceeded").
+		WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(1500 * time.Millisecond)})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		err           error
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder, body errorBody)
+	}{
+		{
+			name: "InvalidArgument",
+			err: invalidArgumentsError([]*errdetails.BadRequest_FieldViolation{
+				fieldViolation("username", fmt.Errorf("too short")),
+				fieldViolation("email", fmt.Errorf("invalid email")),
+				fieldViolation("username", fmt.Errorf("invalid characters")),
+			}),
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, body errorBody) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Equal(t, "INVALID_ARGUMENT", body.Code)
+				require.Equal(t, "invalid parameters", body.Message)
+				require.Equal(t, map[string]string{
+					"username": "too short; invalid characters",
+					"email":    "invalid email",
+				}, body.FieldErrors)
+			},
+		},
+		{
+			name: "FailedPrecondition",
+			err:  status.Errorf(codes.FailedPrecondition, "account currency must match transfer currency USD"),
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, body errorBody) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+				require.Equal(t, "FAILED_PRECONDITION", body.Code)
+				require.Empty(t, body.FieldErrors)
+			},
+		},
+		{
+			name: "AlreadyExists",
+			err:  status.Errorf(codes.AlreadyExists, "already exists"),
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, body errorBody) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+				require.Equal(t, "ALREADY_EXISTS", body.Code)
+			},
+		},
+		{
+			name: "Unauthenticated",
+			err:  status.Errorf(codes.Unauthenticated, "missing authorization header"),
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, body errorBody) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				require.Equal(t, "Bearer", recorder.Header().Get("WWW-Authenticate"))
+			},
+		},
+		{
+			name: "ResourceExhausted",
+			err:  rateLimited.Err(),
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, body errorBody) {
+				require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+				require.Equal(t, "2", recorder.Header().Get("Retry-After"))
+				require.Equal(t, "RESOURCE_EXHAUSTED", body.Code)
+			},
+		},
+		{
+			name: "NonStatusError",
+			err:  fmt.Errorf("boom"),
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, body errorBody) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				require.Equal(t, "UNKNOWN", body.Code)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		mux := runtime.NewServeMux(runtime.WithErrorHandler(gatewayErrorHandler))
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodGet, "/v1/accounts", nil)
+
+		HTTPRequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			runtime.HTTPError(r.Context(), mux, gatewayMarshaler, w, r, testCase.err)
+		})).ServeHTTP(recorder, request)
+
+		var body errorBody
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(&body))
+		require.NotEmpty(t, body.RequestID)
+		require.Equal(t, recorder.Header().Get(httpRequestIDHeader), body.RequestID)
+		testCase.checkResponse(t, recorder, body)
+	}
+}
+
+func TestGatewayValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().AppendAuditLogTx(gomock.Any(), gomock.Any()).AnyTimes()
+
+	server := NewTestServer(t, store)
+	mux, err := server.NewGatewayMux(context.Background())
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/v1/users", strings.NewReader(`{"username":"A!","password":"1","full_name":"x","email":"bad"}`))
+	request.Header.Set(httpRequestIDHeader, "validation-request")
+	HTTPRequestID(mux).ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	var body errorBody
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&body))
+	require.Equal(t, "INVALID_ARGUMENT", body.Code)
+	require.Equal(t, "validation-request", body.RequestID)
+	require.Contains(t, body.FieldErrors, "username")
+	require.Contains(t, body.FieldErrors, "password")
+	require.Contains(t, body.FieldErrors, "email")
+}
@@ -0,0 +1,229 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/fraud"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/utils"
+	"github.com/valkyraycho/bank_project/validator"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var requestFraudAlertStatuses = map[pb.FraudAlertStatus]db.FraudAlertStatus{
+	pb.FraudAlertStatus_FRAUD_ALERT_STATUS_OPEN:      db.FraudAlertStatusOpen,
+	pb.FraudAlertStatus_FRAUD_ALERT_STATUS_DISMISSED: db.FraudAlertStatusDismissed,
+	pb.FraudAlertStatus_FRAUD_ALERT_STATUS_CONFIRMED: db.FraudAlertStatusConfirmed,
+}
+
+var fraudAlertStatuses = map[db.FraudAlertStatus]pb.FraudAlertStatus{
+	db.FraudAlertStatusOpen:      pb.FraudAlertStatus_FRAUD_ALERT_STATUS_OPEN,
+	db.FraudAlertStatusDismissed: pb.FraudAlertStatus_FRAUD_ALERT_STATUS_DISMISSED,
+	db.FraudAlertStatusConfirmed: pb.FraudAlertStatus_FRAUD_ALERT_STATUS_CONFIRMED,
+}
+
+var fraudDecisions = map[db.FraudDecision]pb.FraudDecision{
+	db.FraudDecisionAllow: pb.FraudDecision_FRAUD_DECISION_ALLOW,
+	db.FraudDecisionHold:  pb.FraudDecision_FRAUD_DECISION_HOLD,
+	db.FraudDecisionBlock: pb.FraudDecision_FRAUD_DECISION_BLOCK,
+}
+
+func (s *Server) ListFraudAlerts(ctx context.Context, req *pb.ListFraudAlertsRequest) (*pb.ListFraudAlertsResponse, error) {
+	_, err := s.authorizeUser(ctx, []string{utils.BankerRole})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	violations := validateListFraudAlertsRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentsError(violations)
+	}
+
+	args := db.ListFraudAlertsParams{
+		Limit:  req.GetLimit(),
+		Offset: req.GetOffset(),
+	}
+	if req.Status != nil {
+		args.Status = db.NullFraudAlertStatus{FraudAlertStatus: requestFraudAlertStatuses[req.GetStatus()], Valid: true}
+	}
+
+	alerts, err := s.store.ListFraudAlerts(ctx, args)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list fraud alerts: %s", err)
+	}
+
+	rsp := &pb.ListFraudAlertsResponse{FraudAlerts: []*pb.FraudAlert{}}
+	for _, alert := range alerts {
+		rsp.FraudAlerts = append(rsp.FraudAlerts, convertFraudAlert(alert))
+	}
+	return rsp, nil
+}
+
+func validateListFraudAlertsRequest(req *pb.ListFraudAlertsRequest) []*errdetails.BadRequest_FieldViolation {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+
+	defaultLimit := int32(10)
+	defaultOffset := int32(0)
+
+	if req.Status != nil {
+		if _, ok := requestFraudAlertStatuses[req.GetStatus()]; !ok {
+			violations = append(violations, fieldViolation("status", errors.New("unknown fraud alert status")))
+		}
+	}
+
+	if req.Limit != nil {
+		if err := validator.ValidateLimit(req.GetLimit()); err != nil {
+			violations = append(violations, fieldViolation("limit", err))
+		}
+	} else {
+		req.Limit = &defaultLimit
+	}
+
+	if req.Offset != nil {
+		if err := validator.ValidateOffset(req.GetOffset()); err != nil {
+			violations = append(violations, fieldViolation("offset", err))
+		}
+	} else {
+		req.Offset = &defaultOffset
+	}
+
+	return violations
+}
+
+func (s *Server) GetFraudAlert(ctx context.Context, req *pb.GetFraudAlertRequest) (*pb.GetFraudAlertResponse, error) {
+	_, err := s.authorizeUser(ctx, []string{utils.BankerRole})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	if req.GetId() <= 0 {
+		return nil, invalidArgumentsError([]*errdetails.BadRequest_FieldViolation{
+			fieldViolation("id", errors.New("must be a positive integer")),
+		})
+	}
+
+	alert, err := s.store.GetFraudAlert(ctx, req.GetId())
+	if err != nil {
+		return nil, fraudAlertError(err)
+	}
+
+	transfer, err := s.store.GetTransfer(ctx, alert.TransferID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve transfer: %s", err)
+	}
+
+	return &pb.GetFraudAlertResponse{
+		FraudAlert: convertFraudAlert(alert),
+		Transfer:   convertTransfer(transfer),
+	}, nil
+}
+
+func (s *Server) DismissFraudAlert(ctx context.Context, req *pb.DismissFraudAlertRequest) (*pb.DismissFraudAlertResponse, error) {
+	result, err := s.resolveFraudAlert(ctx, req.GetId(), req.GetReason(), false)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DismissFraudAlertResponse{
+		FraudAlert: convertFraudAlert(result.Alert),
+		Transfer:   convertTransfer(result.Transfer),
+	}, nil
+}
+
+func (s *Server) ConfirmFraudAlert(ctx context.Context, req *pb.ConfirmFraudAlertRequest) (*pb.ConfirmFraudAlertResponse, error) {
+	result, err := s.resolveFraudAlert(ctx, req.GetId(), req.GetReason(), true)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ConfirmFraudAlertResponse{
+		FraudAlert: convertFraudAlert(result.Alert),
+		Transfer:   convertTransfer(result.Transfer),
+	}, nil
+}
+
+func (s *Server) resolveFraudAlert(ctx context.Context, id int64, reason string, confirmed bool) (db.ResolveFraudAlertTxResult, error) {
+	payload, err := s.authorizeUser(ctx, []string{utils.BankerRole})
+	if err != nil {
+		return db.ResolveFraudAlertTxResult{}, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	violations := validateApprovalDecision(id, reason, 1)
+	if len(violations) > 0 {
+		return db.ResolveFraudAlertTxResult{}, invalidArgumentsError(violations)
+	}
+
+	result, err := s.store.ResolveFraudAlertTx(ctx, db.ResolveFraudAlertTxParams{
+		ID:         id,
+		Confirmed:  confirmed,
+		ResolvedBy: payload.UserID,
+		Reason:     reason,
+	})
+	if err != nil {
+		return db.ResolveFraudAlertTxResult{}, fraudAlertError(err)
+	}
+
+	auditTarget(ctx, "fraud_alert", result.Alert.ID,
+		map[string]any{"status": db.FraudAlertStatusOpen},
+		map[string]any{
+			"status":          result.Alert.Status,
+			"reason":          result.Alert.ResolutionReason,
+			"transfer_id":     result.Transfer.ID,
+			"transfer_status": result.Transfer.Status,
+		},
+	)
+	return result, nil
+}
+
+func fraudAlertError(err error) error {
+	var notActive *db.AccountNotActiveError
+	var ruleErr *db.ProductRuleError
+	var limitErr *db.TransferLimitError
+	var screeningErr *db.OwnerScreeningError
+	switch {
+	case err == pgx.ErrNoRows:
+		return status.Errorf(codes.NotFound, "fraud alert not found: %s", err)
+	case errors.Is(err, db.ErrFraudAlertNotOpen),
+		errors.As(err, &notActive),
+		errors.As(err, &ruleErr),
+		errors.As(err, &limitErr),
+		errors.As(err, &screeningErr):
+		return status.Errorf(codes.FailedPrecondition, "%s", err)
+	}
+	return status.Errorf(codes.Internal, "failed to resolve fraud alert: %s", err)
+}
+
+func convertFraudAlert(alert db.FraudAlert) *pb.FraudAlert {
+	rsp := &pb.FraudAlert{
+		Id:               alert.ID,
+		TransferId:       alert.TransferID,
+		AccountId:        alert.AccountID,
+		Score:            alert.Score,
+		Decision:         fraudDecisions[alert.Decision],
+		Hits:             []*pb.FraudRuleHit{},
+		Source:           alert.Source,
+		Status:           fraudAlertStatuses[alert.Status],
+		ResolutionReason: alert.ResolutionReason,
+		CreatedAt:        timestamppb.New(alert.CreatedAt),
+	}
+
+	var hits []fraud.Hit
+	if err := json.Unmarshal(alert.Hits, &hits); err == nil {
+		for _, hit := range hits {
+			rsp.Hits = append(rsp.Hits, &pb.FraudRuleHit{Rule: hit.Rule, Score: hit.Score, Reason: hit.Reason})
+		}
+	}
+
+	if alert.ResolvedBy.Valid {
+		rsp.ResolvedBy = &alert.ResolvedBy.Int32
+	}
+	if alert.ResolvedAt.Valid {
+		rsp.ResolvedAt = timestamppb.New(alert.ResolvedAt.Time)
+	}
+	return rsp
+}
@@ -0,0 +1,450 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/token"
+	"github.com/valkyraycho/bank_project/utils"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func randomFraudAlert(transfer db.Transfer) db.FraudAlert {
+	return db.FraudAlert{
+		ID:         int64(utils.RandomInt(1, 1000)),
+		TransferID: transfer.ID,
+		AccountID:  transfer.FromAccountID,
+		Score:      60,
+		Decision:   db.FraudDecisionHold,
+		Hits:       []byte(`[{"rule": "burst", "score": 60, "reason": "6 transfers within 10m0s, above 5"}]`),
+		Source:     db.FraudAlertSourceRealtime,
+		Status:     db.FraudAlertStatusOpen,
+		CreatedAt:  time.Now(),
+	}
+}
+
+func randomHeldTransfer() db.Transfer {
+	return db.Transfer{
+		ID:            utils.RandomInt(1, 1000),
+		FromAccountID: utils.RandomInt(1, 1000),
+		ToAccountID:   utils.RandomInt(1, 1000),
+		Amount:        utils.RandomInt(1, 1000),
+		Status:        db.TransferStatusHeld,
+		CreatedAt:     time.Now(),
+	}
+}
+
+func TestListFraudAlerts(t *testing.T) {
+	user, _ := randomUser(t)
+	banker, _ := randomUser(t)
+	banker.Role = utils.BankerRole
+
+	transfer := randomHeldTransfer()
+	alerts := []db.FraudAlert{randomFraudAlert(transfer), randomFraudAlert(transfer)}
+	open := pb.FraudAlertStatus_FRAUD_ALERT_STATUS_OPEN
+
+	testCases := []struct {
+		name          string
+		req           *pb.ListFraudAlertsRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.TokenMaker) context.Context
+		checkResponse func(t *testing.T, res *pb.ListFraudAlertsResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.ListFraudAlertsRequest{Status: &open},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListFraudAlerts(gomock.Any(), gomock.Eq(db.ListFraudAlertsParams{
+						Limit:  10,
+						Offset: 0,
+						Status: db.NullFraudAlertStatus{FraudAlertStatus: db.FraudAlertStatusOpen, Valid: true},
+					})).
+					Times(1).
+					Return(alerts, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListFraudAlertsResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetFraudAlerts(), len(alerts))
+				for i, alert := range res.GetFraudAlerts() {
+					require.Equal(t, alerts[i].ID, alert.GetId())
+					require.Equal(t, pb.FraudAlertStatus_FRAUD_ALERT_STATUS_OPEN, alert.GetStatus())
+					require.Equal(t, pb.FraudDecision_FRAUD_DECISION_HOLD, alert.GetDecision())
+					require.Len(t, alert.GetHits(), 1)
+					require.Equal(t, "burst", alert.GetHits()[0].GetRule())
+					require.Nil(t, alert.ResolvedBy)
+				}
+			},
+		},
+		{
+			name: "CustomerNotAllowed",
+			req:  &pb.ListFraudAlertsRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListFraudAlerts(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListFraudAlertsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name: "InvalidStatus",
+			req:  &pb.ListFraudAlertsRequest{Status: pb.FraudAlertStatus_FRAUD_ALERT_STATUS_UNSPECIFIED.Enum()},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListFraudAlerts(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListFraudAlertsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "InternalError",
+			req:  &pb.ListFraudAlertsRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListFraudAlerts(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListFraudAlertsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		res, err := server.ListFraudAlerts(testCase.buildContext(t, server.tokenMaker), testCase.req)
+		testCase.checkResponse(t, res, err)
+	}
+}
+
+func TestGetFraudAlert(t *testing.T) {
+	banker, _ := randomUser(t)
+	banker.Role = utils.BankerRole
+
+	transfer := randomHeldTransfer()
+	alert := randomFraudAlert(transfer)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.GetFraudAlertResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetFraudAlert(gomock.Any(), gomock.Eq(alert.ID)).
+					Times(1).
+					Return(alert, nil)
+
+				store.EXPECT().
+					GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).
+					Times(1).
+					Return(transfer, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetFraudAlertResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, alert.ID, res.GetFraudAlert().GetId())
+				require.Equal(t, transfer.ID, res.GetTransfer().GetId())
+				require.Equal(t, pb.TransferStatus_TRANSFER_STATUS_HELD, res.GetTransfer().GetStatus())
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetFraudAlert(gomock.Any(), gomock.Eq(alert.ID)).
+					Times(1).
+					Return(db.FraudAlert{}, pgx.ErrNoRows)
+
+				store.EXPECT().
+					GetTransfer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetFraudAlertResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		ctx := newContextWithBearerToken(t, server.tokenMaker, banker.ID, banker.Role, time.Minute)
+		res, err := server.GetFraudAlert(ctx, &pb.GetFraudAlertRequest{Id: alert.ID})
+		testCase.checkResponse(t, res, err)
+	}
+}
+
+func TestDismissFraudAlert(t *testing.T) {
+	user, _ := randomUser(t)
+	banker, _ := randomUser(t)
+	banker.Role = utils.BankerRole
+
+	transfer := randomHeldTransfer()
+	alert := randomFraudAlert(transfer)
+
+	released := transfer
+	released.Status = db.TransferStatusCompleted
+	dismissed := alert
+	dismissed.Status = db.FraudAlertStatusDismissed
+	dismissed.ResolvedBy = pgtype.Int4{Int32: banker.ID, Valid: true}
+	dismissed.ResolutionReason = "customer confirmed by phone"
+	dismissed.ResolvedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+	testCases := []struct {
+		name          string
+		req           *pb.DismissFraudAlertRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.TokenMaker) context.Context
+		checkResponse func(t *testing.T, res *pb.DismissFraudAlertResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.DismissFraudAlertRequest{Id: alert.ID, Reason: "customer confirmed by phone"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ResolveFraudAlertTx(gomock.Any(), gomock.Eq(db.ResolveFraudAlertTxParams{
+						ID:         alert.ID,
+						ResolvedBy: banker.ID,
+						Reason:     "customer confirmed by phone",
+					})).
+					Times(1).
+					Return(db.ResolveFraudAlertTxResult{Alert: dismissed, Transfer: released}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DismissFraudAlertResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, pb.FraudAlertStatus_FRAUD_ALERT_STATUS_DISMISSED, res.GetFraudAlert().GetStatus())
+				require.Equal(t, banker.ID, res.GetFraudAlert().GetResolvedBy())
+				require.Equal(t, pb.TransferStatus_TRANSFER_STATUS_COMPLETED, res.GetTransfer().GetStatus())
+			},
+		},
+		{
+			name: "CustomerNotAllowed",
+			req:  &pb.DismissFraudAlertRequest{Id: alert.ID, Reason: "it was me"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ResolveFraudAlertTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DismissFraudAlertResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name: "ReasonRequired",
+			req:  &pb.DismissFraudAlertRequest{Id: alert.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ResolveFraudAlertTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DismissFraudAlertResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "NotOpen",
+			req:  &pb.DismissFraudAlertRequest{Id: alert.ID, Reason: "customer confirmed by phone"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ResolveFraudAlertTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ResolveFraudAlertTxResult{}, db.ErrFraudAlertNotOpen)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DismissFraudAlertResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "InsufficientFundsOnRelease",
+			req:  &pb.DismissFraudAlertRequest{Id: alert.ID, Reason: "customer confirmed by phone"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ResolveFraudAlertTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ResolveFraudAlertTxResult{}, &db.ProductRuleError{ProductCode: "checking", Rule: db.ErrInsufficientFunds})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DismissFraudAlertResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "InternalError",
+			req:  &pb.DismissFraudAlertRequest{Id: alert.ID, Reason: "customer confirmed by phone"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ResolveFraudAlertTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ResolveFraudAlertTxResult{}, sql.ErrConnDone)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DismissFraudAlertResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		res, err := server.DismissFraudAlert(testCase.buildContext(t, server.tokenMaker), testCase.req)
+		testCase.checkResponse(t, res, err)
+	}
+}
+
+func TestConfirmFraudAlert(t *testing.T) {
+	banker, _ := randomUser(t)
+	banker.Role = utils.BankerRole
+
+	transfer := randomHeldTransfer()
+	alert := randomFraudAlert(transfer)
+
+	rejected := transfer
+	rejected.Status = db.TransferStatusRejected
+	confirmed := alert
+	confirmed.Status = db.FraudAlertStatusConfirmed
+	confirmed.ResolvedBy = pgtype.Int4{Int32: banker.ID, Valid: true}
+	confirmed.ResolutionReason = "account taken over"
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.ConfirmFraudAlertResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ResolveFraudAlertTx(gomock.Any(), gomock.Eq(db.ResolveFraudAlertTxParams{
+						ID:         alert.ID,
+						Confirmed:  true,
+						ResolvedBy: banker.ID,
+						Reason:     "account taken over",
+					})).
+					Times(1).
+					Return(db.ResolveFraudAlertTxResult{Alert: confirmed, Transfer: rejected}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ConfirmFraudAlertResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, pb.FraudAlertStatus_FRAUD_ALERT_STATUS_CONFIRMED, res.GetFraudAlert().GetStatus())
+				require.Equal(t, pb.TransferStatus_TRANSFER_STATUS_REJECTED, res.GetTransfer().GetStatus())
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ResolveFraudAlertTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ResolveFraudAlertTxResult{}, pgx.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, res *pb.ConfirmFraudAlertResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		ctx := newContextWithBearerToken(t, server.tokenMaker, banker.ID, banker.Role, time.Minute)
+		res, err := server.ConfirmFraudAlert(ctx, &pb.ConfirmFraudAlertRequest{Id: alert.ID, Reason: "account taken over"})
+		testCase.checkResponse(t, res, err)
+	}
+}
@@ -0,0 +1,132 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/valkyraycho/bank_project/pb"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// gatewayMethods maps "VERB /path/{param}" templates taken from the
+// google.api.http annotations to full gRPC method names. The in-process
+// gateway calls the handlers directly and skips the gRPC interceptors, so
+// gateway middlewares use it to find out which RPC a request is for.
+var gatewayMethods = loadGatewayMethods(pb.File_service_proto.Services().ByName("BankService"))
+
+func loadGatewayMethods(service protoreflect.ServiceDescriptor) map[string]string {
+	methods := map[string]string{}
+
+	for i := 0; i < service.Methods().Len(); i++ {
+		method := service.Methods().Get(i)
+		rule, ok := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+		if !ok || rule == nil {
+			continue
+		}
+
+		fullMethod := fmt.Sprintf("/%s/%s", service.FullName(), method.Name())
+		for _, binding := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+			if verb, path := httpRulePattern(binding); verb != "" {
+				methods[verb+" "+path] = fullMethod
+			}
+		}
+	}
+	return methods
+}
+
+func httpRulePattern(rule *annotations.HttpRule) (string, string) {
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		return http.MethodGet, pattern.Get
+	case *annotations.HttpRule_Post:
+		return http.MethodPost, pattern.Post
+	case *annotations.HttpRule_Put:
+		return http.MethodPut, pattern.Put
+	case *annotations.HttpRule_Patch:
+		return http.MethodPatch, pattern.Patch
+	case *annotations.HttpRule_Delete:
+		return http.MethodDelete, pattern.Delete
+	case *annotations.HttpRule_Custom:
+		return pattern.Custom.GetKind(), pattern.Custom.GetPath()
+	}
+	return "", ""
+}
+
+func gatewayMethod(r *http.Request, pathParams map[string]string) string {
+	return gatewayMethods[r.Method+" "+routeTemplate(r.URL.Path, pathParams)]
+}
+
+var gatewayMarshaler = &runtime.JSONPb{
+	MarshalOptions: protojson.MarshalOptions{
+		EmitUnpopulated: true,
+		UseProtoNames:   true,
+	},
+	UnmarshalOptions: protojson.UnmarshalOptions{
+		DiscardUnknown: true,
+	},
+}
+
+// NewGatewayMux builds the grpc-gateway mux serving the REST API in-process
+// on top of the server.
+func (s *Server) NewGatewayMux(ctx context.Context) (*runtime.ServeMux, error) {
+	var mux *runtime.ServeMux
+	mux = runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, gatewayMarshaler),
+		runtime.WithErrorHandler(gatewayErrorHandler),
+		runtime.WithForwardResponseOption(s.gatewayRefreshTokenCookie),
+		runtime.WithMiddlewares(
+			gatewayClientAddr,
+			GatewayTracing,
+			GatewayMetrics,
+			func(next runtime.HandlerFunc) runtime.HandlerFunc {
+				return s.gatewayRateLimit(mux, next)
+			},
+			func(next runtime.HandlerFunc) runtime.HandlerFunc {
+				return s.gatewayCSRF(mux, next)
+			},
+			s.gatewayAudit,
+		),
+	)
+
+	if err := pb.RegisterBankServiceHandlerServer(ctx, mux, s); err != nil {
+		return nil, fmt.Errorf("failed to register http handler server: %w", err)
+	}
+	return mux, nil
+}
+
+type gatewayClientKey struct{}
+
+// gatewayClient is the connection address and forwarding headers of a REST
+// request. It travels in the context rather than in the gRPC metadata, which
+// clients can fill through Grpc-Metadata-* headers.
+type gatewayClient struct {
+	remoteAddr   string
+	forwardedFor []string
+	forwarded    []string
+}
+
+func withGatewayClient(ctx context.Context, r *http.Request) context.Context {
+	return context.WithValue(ctx, gatewayClientKey{}, gatewayClient{
+		remoteAddr:   r.RemoteAddr,
+		forwardedFor: r.Header.Values(xForwardedForHeader),
+		forwarded:    r.Header.Values(forwardedHeader),
+	})
+}
+
+func gatewayClientAddr(next runtime.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		next(w, r.WithContext(withGatewayClient(r.Context(), r)), pathParams)
+	}
+}
+
+// gatewayHTTPError writes err the same way the gateway writes errors returned
+// by handlers.
+func gatewayHTTPError(mux *runtime.ServeMux, w http.ResponseWriter, r *http.Request, err error) {
+	_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+	runtime.HTTPError(r.Context(), mux, outboundMarshaler, w, r, err)
+}
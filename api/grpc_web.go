@@ -0,0 +1,24 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"google.golang.org/grpc"
+)
+
+var grpcWebExposedHeaders = []string{"Grpc-Status", "Grpc-Message", "Grpc-Status-Details-Bin"}
+
+// GRPCWebHandler serves gRPC-Web requests with grpcServer, so they go through
+// the same interceptors as native gRPC calls, and everything else with next.
+// CORS is left to HTTPCORS.
+func GRPCWebHandler(grpcServer *grpc.Server, next http.Handler) http.Handler {
+	wrapped := grpcweb.WrapServer(grpcServer)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if wrapped.IsGrpcWebRequest(r) {
+			wrapped.HandleGrpcWebRequest(w, r)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestGRPCWebHandler(t *testing.T) {
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(GRPCRequestID))
+	healthpb.RegisterHealthServer(grpcServer, health.NewServer())
+
+	handler := GRPCWebHandler(grpcServer, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+
+	message, err := proto.Marshal(&healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	body := new(bytes.Buffer)
+	body.WriteByte(0)
+	binary.Write(body, binary.BigEndian, uint32(len(message)))
+	body.Write(message)
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/grpc.health.v1.Health/Check", body)
+	request.Header.Set("Content-Type", "application/grpc-web+proto")
+	request.Header.Set("X-Grpc-Web", "1")
+	request.RemoteAddr = net.JoinHostPort("203.0.113.7", "5000")
+	handler.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "application/grpc-web+proto", recorder.Header().Get("Content-Type"))
+	require.NotEmpty(t, recorder.Header().Get(httpRequestIDHeader))
+
+	frames := recorder.Body.Bytes()
+	require.Equal(t, byte(0), frames[0])
+	length := binary.BigEndian.Uint32(frames[1:5])
+	var res healthpb.HealthCheckResponse
+	require.NoError(t, proto.Unmarshal(frames[5:5+length], &res))
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, res.GetStatus())
+
+	trailer := frames[5+length:]
+	require.Equal(t, byte(0x80), trailer[0])
+	require.Contains(t, string(trailer[5:]), "grpc-status: 0")
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/v1/accounts", nil))
+	require.Equal(t, http.StatusTeapot, recorder.Code)
+}
@@ -0,0 +1,159 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/pb"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const readinessTimeout = 2 * time.Second
+
+type HealthChecker struct {
+	store            db.Store
+	migrationVersion uint
+	grpcHealth       *health.Server
+
+	mu           sync.RWMutex
+	workers      map[string]bool
+	shuttingDown bool
+}
+
+type healthResponse struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+func NewHealthChecker(store db.Store, migrationVersion uint) *HealthChecker {
+	grpcHealth := health.NewServer()
+	grpcHealth.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	grpcHealth.SetServingStatus(pb.BankService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+
+	return &HealthChecker{
+		store:            store,
+		migrationVersion: migrationVersion,
+		grpcHealth:       grpcHealth,
+		workers:          make(map[string]bool),
+	}
+}
+
+func (h *HealthChecker) GRPCHealthServer() *health.Server {
+	return h.grpcHealth
+}
+
+// RegisterWorker declares a worker that must be running for the service to be
+// ready. Workers start out as not running.
+func (h *HealthChecker) RegisterWorker(name string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.workers[name]; !ok {
+		h.workers[name] = false
+	}
+}
+
+func (h *HealthChecker) SetWorkerRunning(name string, running bool) {
+	h.mu.Lock()
+	h.workers[name] = running
+	h.mu.Unlock()
+	h.updateServingStatus()
+}
+
+// Shutdown marks the service as NOT_SERVING for good so load balancers drain
+// traffic before the listeners are closed.
+func (h *HealthChecker) Shutdown() {
+	h.mu.Lock()
+	h.shuttingDown = true
+	h.mu.Unlock()
+	h.grpcHealth.Shutdown()
+}
+
+func (h *HealthChecker) updateServingStatus() {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if h.shuttingDown {
+		return
+	}
+
+	servingStatus := healthpb.HealthCheckResponse_SERVING
+	for _, running := range h.workers {
+		if !running {
+			servingStatus = healthpb.HealthCheckResponse_NOT_SERVING
+			break
+		}
+	}
+	h.grpcHealth.SetServingStatus("", servingStatus)
+	h.grpcHealth.SetServingStatus(pb.BankService_ServiceDesc.ServiceName, servingStatus)
+}
+
+func (h *HealthChecker) checkReadiness(ctx context.Context) map[string]error {
+	checks := map[string]error{}
+
+	h.mu.RLock()
+	if h.shuttingDown {
+		checks["shutdown"] = fmt.Errorf("server is shutting down")
+	}
+	for name, running := range h.workers {
+		if !running {
+			checks["worker:"+name] = fmt.Errorf("not running")
+		} else {
+			checks["worker:"+name] = nil
+		}
+	}
+	h.mu.RUnlock()
+
+	if err := h.store.Ping(ctx); err != nil {
+		checks["database"] = err
+	} else {
+		checks["database"] = nil
+	}
+
+	version, dirty, err := h.store.MigrationVersion(ctx)
+	switch {
+	case err != nil:
+		checks["migration"] = err
+	case dirty:
+		checks["migration"] = fmt.Errorf("migration version %d is dirty", version)
+	case version != h.migrationVersion:
+		checks["migration"] = fmt.Errorf("migration version %d does not match expected version %d", version, h.migrationVersion)
+	default:
+		checks["migration"] = nil
+	}
+	return checks
+}
+
+func (h *HealthChecker) LivenessHandler(w http.ResponseWriter, r *http.Request) {
+	writeHealthResponse(w, http.StatusOK, healthResponse{Status: "ok"})
+}
+
+func (h *HealthChecker) ReadinessHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), readinessTimeout)
+	defer cancel()
+
+	res := healthResponse{Status: "ok", Checks: map[string]string{}}
+	statusCode := http.StatusOK
+
+	for name, err := range h.checkReadiness(ctx) {
+		if err != nil {
+			res.Checks[name] = err.Error()
+			res.Status = "unavailable"
+			statusCode = http.StatusServiceUnavailable
+			continue
+		}
+		res.Checks[name] = "ok"
+	}
+	writeHealthResponse(w, statusCode, res)
+}
+
+func writeHealthResponse(w http.ResponseWriter, statusCode int, res healthResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(res)
+}
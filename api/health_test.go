@@ -0,0 +1,178 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	"github.com/valkyraycho/bank_project/pb"
+	"go.uber.org/mock/gomock"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestReadinessHandler(t *testing.T) {
+	migrationVersion := uint(2)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		setup         func(h *HealthChecker)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().Ping(gomock.Any()).Times(1).Return(nil)
+				store.EXPECT().MigrationVersion(gomock.Any()).Times(1).Return(migrationVersion, false, nil)
+			},
+			setup: func(h *HealthChecker) {
+				h.RegisterWorker("worker")
+				h.SetWorkerRunning("worker", true)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				res := decodeHealthResponse(t, recorder)
+				require.Equal(t, "ok", res.Status)
+				require.Equal(t, "ok", res.Checks["database"])
+				require.Equal(t, "ok", res.Checks["migration"])
+				require.Equal(t, "ok", res.Checks["worker:worker"])
+			},
+		},
+		{
+			name: "DatabaseUnavailable",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().Ping(gomock.Any()).Times(1).Return(context.DeadlineExceeded)
+				store.EXPECT().MigrationVersion(gomock.Any()).Times(1).Return(uint(0), false, context.DeadlineExceeded)
+			},
+			setup: func(h *HealthChecker) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+				res := decodeHealthResponse(t, recorder)
+				require.Equal(t, "unavailable", res.Status)
+				require.NotEqual(t, "ok", res.Checks["database"])
+			},
+		},
+		{
+			name: "MigrationVersionMismatch",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().Ping(gomock.Any()).Times(1).Return(nil)
+				store.EXPECT().MigrationVersion(gomock.Any()).Times(1).Return(migrationVersion-1, false, nil)
+			},
+			setup: func(h *HealthChecker) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+				res := decodeHealthResponse(t, recorder)
+				require.Equal(t, "ok", res.Checks["database"])
+				require.NotEqual(t, "ok", res.Checks["migration"])
+			},
+		},
+		{
+			name: "DirtyMigration",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().Ping(gomock.Any()).Times(1).Return(nil)
+				store.EXPECT().MigrationVersion(gomock.Any()).Times(1).Return(migrationVersion, true, nil)
+			},
+			setup: func(h *HealthChecker) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+			},
+		},
+		{
+			name: "WorkerNotRunning",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().Ping(gomock.Any()).Times(1).Return(nil)
+				store.EXPECT().MigrationVersion(gomock.Any()).Times(1).Return(migrationVersion, false, nil)
+			},
+			setup: func(h *HealthChecker) {
+				h.RegisterWorker("worker")
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+				res := decodeHealthResponse(t, recorder)
+				require.NotEqual(t, "ok", res.Checks["worker:worker"])
+			},
+		},
+		{
+			name: "ShuttingDown",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().Ping(gomock.Any()).Times(1).Return(nil)
+				store.EXPECT().MigrationVersion(gomock.Any()).Times(1).Return(migrationVersion, false, nil)
+			},
+			setup: func(h *HealthChecker) {
+				h.Shutdown()
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+				res := decodeHealthResponse(t, recorder)
+				require.NotEmpty(t, res.Checks["shutdown"])
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		healthChecker := NewHealthChecker(store, migrationVersion)
+		testCase.setup(healthChecker)
+
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+		healthChecker.ReadinessHandler(recorder, request)
+		testCase.checkResponse(t, recorder)
+	}
+}
+
+func TestLivenessHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	healthChecker := NewHealthChecker(mockdb.NewMockStore(ctrl), 1)
+	healthChecker.Shutdown()
+
+	recorder := httptest.NewRecorder()
+	healthChecker.LivenessHandler(recorder, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "ok", decodeHealthResponse(t, recorder).Status)
+}
+
+func TestGRPCHealthServingStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	healthChecker := NewHealthChecker(mockdb.NewMockStore(ctrl), 1)
+	healthChecker.RegisterWorker("worker")
+
+	checkStatus := func(expected healthpb.HealthCheckResponse_ServingStatus) {
+		for _, service := range []string{"", pb.BankService_ServiceDesc.ServiceName} {
+			res, err := healthChecker.GRPCHealthServer().Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+			require.NoError(t, err, status.Convert(err).Message())
+			require.Equal(t, expected, res.GetStatus())
+		}
+	}
+
+	checkStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+
+	healthChecker.SetWorkerRunning("worker", true)
+	checkStatus(healthpb.HealthCheckResponse_SERVING)
+
+	healthChecker.Shutdown()
+	checkStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+
+	healthChecker.SetWorkerRunning("worker", true)
+	checkStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+}
+
+func decodeHealthResponse(t *testing.T, recorder *httptest.ResponseRecorder) healthResponse {
+	var res healthResponse
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+	return res
+}
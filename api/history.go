@@ -0,0 +1,112 @@
+package api
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/utils"
+	"github.com/valkyraycho/bank_project/validator"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) ListAccountHistory(ctx context.Context, req *pb.ListAccountHistoryRequest) (*pb.ListAccountHistoryResponse, error) {
+	payload, err := s.authorizeUser(ctx, utils.SelfAndBanker)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	violations := validateListAccountHistoryRequest(req)
+
+	cursor, err := decodePageToken(req.GetPageToken(), sortByID, true)
+	if err != nil {
+		violations = append(violations, fieldViolation("page_token", err))
+	}
+
+	if len(violations) > 0 {
+		return nil, invalidArgumentsError(violations)
+	}
+
+	account, err := s.store.GetAccount(ctx, req.GetAccountId())
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "account not found: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to retrieve account: %s", err)
+	}
+
+	if payload.Role != utils.BankerRole && payload.UserID != account.OwnerID {
+		return nil, status.Error(codes.PermissionDenied, "no permission to list the history of an account that does not belong to you")
+	}
+
+	arg := db.ListAccountHistoryParams{
+		AccountID: account.ID,
+		Category:  optionalText(req.Category),
+		PageSize:  req.GetPageSize() + 1,
+	}
+	if cursor != nil {
+		arg.BeforeID = pgtype.Int4{Int32: cursor.ID, Valid: true}
+	}
+
+	rows, err := s.store.ListAccountHistory(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list account history: %s", err)
+	}
+
+	rsp := &pb.ListAccountHistoryResponse{Entries: []*pb.HistoryEntry{}}
+	if len(rows) > int(req.GetPageSize()) {
+		rows = rows[:req.GetPageSize()]
+		rsp.NextPageToken = encodePageToken(pageCursor{SortBy: sortByID, Descending: true, ID: rows[len(rows)-1].ID})
+	}
+
+	for _, row := range rows {
+		rsp.Entries = append(rsp.Entries, convertHistoryEntry(row))
+	}
+	return rsp, nil
+}
+
+func validateListAccountHistoryRequest(req *pb.ListAccountHistoryRequest) []*errdetails.BadRequest_FieldViolation {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+
+	if err := validator.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if req.Category != nil {
+		if err := validator.ValidateCategory(req.GetCategory()); err != nil {
+			violations = append(violations, fieldViolation("category", err))
+		}
+	}
+
+	violations = append(violations, validatePageSize(&req.PageSize)...)
+
+	return violations
+}
+
+func convertHistoryEntry(row db.ListAccountHistoryRow) *pb.HistoryEntry {
+	entry := &pb.HistoryEntry{
+		Id:        row.ID,
+		AccountId: row.AccountID,
+		Amount:    row.Amount,
+		Kind:      row.Kind,
+		Category:  row.Category.String,
+		Memo:      row.Memo.String,
+		Reference: row.Reference.String,
+		CreatedAt: timestamppb.New(row.CreatedAt),
+	}
+
+	if row.TransferID.Valid {
+		entry.TransferId = &row.TransferID.Int32
+		counterparty := row.FromAccountID.Int32
+		if counterparty == row.AccountID {
+			counterparty = row.ToAccountID.Int32
+		}
+		entry.CounterpartyAccountId = &counterparty
+	}
+	return entry
+}
@@ -0,0 +1,209 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/token"
+	"github.com/valkyraycho/bank_project/utils"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListAccountHistory(t *testing.T) {
+	user, account := randomAccount(t)
+	other, _ := randomUser(t)
+	other.ID = user.ID + 1
+
+	rows := []db.ListAccountHistoryRow{
+		{
+			ID:            30,
+			AccountID:     account.ID,
+			Amount:        -500,
+			Category:      pgtype.Text{String: "housing", Valid: true},
+			Kind:          db.JournalKindTransfer,
+			TransferID:    pgtype.Int4{Int32: 12, Valid: true},
+			FromAccountID: pgtype.Int4{Int32: account.ID, Valid: true},
+			ToAccountID:   pgtype.Int4{Int32: account.ID + 1, Valid: true},
+			Memo:          pgtype.Text{String: "March rent", Valid: true},
+			Reference:     pgtype.Text{String: "RENT-03", Valid: true},
+			CreatedAt:     time.Now(),
+		},
+		{ID: 29, AccountID: account.ID, Amount: -25, Kind: db.JournalKindFee, CreatedAt: time.Now()},
+	}
+	category := "housing"
+	pageSize := int32(1)
+
+	testCases := []struct {
+		name          string
+		req           *pb.ListAccountHistoryRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.TokenMaker) context.Context
+		checkResponse func(t *testing.T, res *pb.ListAccountHistoryResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.ListAccountHistoryRequest{AccountId: account.ID, Category: &category, PageSize: &pageSize},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+				store.EXPECT().
+					ListAccountHistory(gomock.Any(), gomock.Eq(db.ListAccountHistoryParams{
+						AccountID: account.ID,
+						Category:  pgtype.Text{String: category, Valid: true},
+						PageSize:  pageSize + 1,
+					})).
+					Times(1).
+					Return(rows, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAccountHistoryResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetEntries(), 1)
+
+				entry := res.GetEntries()[0]
+				require.Equal(t, "housing", entry.GetCategory())
+				require.Equal(t, "March rent", entry.GetMemo())
+				require.Equal(t, "RENT-03", entry.GetReference())
+				require.Equal(t, int32(12), entry.GetTransferId())
+				require.Equal(t, account.ID+1, entry.GetCounterpartyAccountId())
+
+				cursor, err := decodePageToken(res.GetNextPageToken(), sortByID, true)
+				require.NoError(t, err)
+				require.Equal(t, int32(30), cursor.ID)
+			},
+		},
+		{
+			name: "NextPage",
+			req:  &pb.ListAccountHistoryRequest{AccountId: account.ID, PageToken: encodePageToken(pageCursor{SortBy: sortByID, Descending: true, ID: 30})},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+				store.EXPECT().
+					ListAccountHistory(gomock.Any(), gomock.Eq(db.ListAccountHistoryParams{
+						AccountID: account.ID,
+						BeforeID:  pgtype.Int4{Int32: 30, Valid: true},
+						PageSize:  defaultSearchPageSize + 1,
+					})).
+					Times(1).
+					Return(rows[1:], nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAccountHistoryResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetEntries(), 1)
+				require.Nil(t, res.GetEntries()[0].TransferId)
+				require.Nil(t, res.GetEntries()[0].CounterpartyAccountId)
+				require.Empty(t, res.GetNextPageToken())
+			},
+		},
+		{
+			name: "PermissionDenied",
+			req:  &pb.ListAccountHistoryRequest{AccountId: account.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+				store.EXPECT().
+					ListAccountHistory(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, other.ID, other.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAccountHistoryResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "BankerAllowed",
+			req:  &pb.ListAccountHistoryRequest{AccountId: account.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+				store.EXPECT().
+					ListAccountHistory(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(rows, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, other.ID, utils.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAccountHistoryResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetEntries(), 2)
+			},
+		},
+		{
+			name: "AccountNotFound",
+			req:  &pb.ListAccountHistoryRequest{AccountId: account.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(db.Account{}, pgx.ErrNoRows)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAccountHistoryResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name: "InvalidCategory",
+			req:  &pb.ListAccountHistoryRequest{AccountId: account.ID, Category: new(string)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAccountHistoryResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		res, err := server.ListAccountHistory(testCase.buildContext(t, server.tokenMaker), testCase.req)
+		testCase.checkResponse(t, res, err)
+	}
+}
@@ -1,11 +1,15 @@
 package api
 
 import (
+	"bufio"
 	"context"
+	"fmt"
+	"net"
 	"net/http"
+	"sync/atomic"
 	"time"
 
-	"github.com/rs/zerolog/log"
+	"github.com/valkyraycho/bank_project/telemetry"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -21,12 +25,14 @@ func GRPCLogger(ctx context.Context, req any, info *grpc.UnaryServerInfo, handle
 		statusCode = st.Code()
 	}
 
-	logger := log.Info()
+	contextLogger := telemetry.Logger(ctx)
+	logger := contextLogger.Info()
 	if err != nil {
-		logger = log.Error().Err(err)
+		logger = contextLogger.Error().Err(err)
 	}
 
-	logger.Str("protocol", "GRPC").
+	logger.Ctx(ctx).
+		Str("protocol", "GRPC").
 		Str("method", info.FullMethod).
 		Int("status_code", int(statusCode)).
 		Str("status", statusCode.String()).
@@ -36,6 +42,70 @@ func GRPCLogger(ctx context.Context, req any, info *grpc.UnaryServerInfo, handle
 	return res, err
 }
 
+type wrappedServerStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	sent     atomic.Int64
+	received atomic.Int64
+}
+
+func wrapServerStream(stream grpc.ServerStream) *wrappedServerStream {
+	return &wrappedServerStream{ServerStream: stream, ctx: stream.Context()}
+}
+
+func (stream *wrappedServerStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *wrappedServerStream) SendMsg(m any) error {
+	err := stream.ServerStream.SendMsg(m)
+	if err == nil {
+		stream.sent.Add(1)
+	}
+	return err
+}
+
+func (stream *wrappedServerStream) RecvMsg(m any) error {
+	err := stream.ServerStream.RecvMsg(m)
+	if err == nil {
+		stream.received.Add(1)
+	}
+	return err
+}
+
+func GRPCStreamLogger(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	startTime := time.Now()
+	wrapped := wrapServerStream(stream)
+	err := handler(srv, wrapped)
+	duration := time.Since(startTime)
+
+	statusCode := codes.Unknown
+	if st, ok := status.FromError(err); ok {
+		statusCode = st.Code()
+	}
+
+	ctx := wrapped.Context()
+	contextLogger := telemetry.Logger(ctx)
+	logger := contextLogger.Info()
+	if err != nil {
+		logger = contextLogger.Error().Err(err)
+	}
+
+	logger.Ctx(ctx).
+		Str("protocol", "GRPC").
+		Str("method", info.FullMethod).
+		Bool("client_stream", info.IsClientStream).
+		Bool("server_stream", info.IsServerStream).
+		Int64("messages_sent", wrapped.sent.Load()).
+		Int64("messages_received", wrapped.received.Load()).
+		Int("status_code", int(statusCode)).
+		Str("status", statusCode.String()).
+		Dur("duration", duration).
+		Msg("received a GRPC stream")
+
+	return err
+}
+
 type ResponseRecorder struct {
 	http.ResponseWriter
 	StatusCode int
@@ -52,6 +122,25 @@ func (recorder *ResponseRecorder) Write(body []byte) (int, error) {
 	return recorder.ResponseWriter.Write(body)
 }
 
+func (recorder *ResponseRecorder) Flush() {
+	if flusher, ok := recorder.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack lets WebSocket upgrades through the logger.
+func (recorder *ResponseRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := recorder.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("response writer does not support hijacking")
+	}
+	return hijacker.Hijack()
+}
+
+func (recorder *ResponseRecorder) Unwrap() http.ResponseWriter {
+	return recorder.ResponseWriter
+}
+
 func HTTPLogger(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		startTime := time.Now()
@@ -62,12 +151,14 @@ func HTTPLogger(handler http.Handler) http.Handler {
 		handler.ServeHTTP(recorder, r)
 		duration := time.Since(startTime)
 
-		logger := log.Info()
+		contextLogger := telemetry.Logger(r.Context())
+		logger := contextLogger.Info()
 		if recorder.StatusCode >= 400 {
-			logger = log.Error().Bytes("body", recorder.Body)
+			logger = contextLogger.Error().Bytes("body", recorder.Body)
 		}
 
-		logger.Str("protocol", "HTTP").
+		logger.Ctx(r.Context()).
+			Str("protocol", "HTTP").
 			Str("method", r.Method).
 			Str("path", r.RequestURI).
 			Int("status_code", recorder.StatusCode).
@@ -21,20 +21,20 @@ const (
 func (s *Server) extractMetadata(ctx context.Context) *Metadata {
 	mtdt := &Metadata{}
 
-	if md, ok := metadata.FromIncomingContext(ctx); ok {
-		if userAgents := md.Get(grpcGatewayUserAgentHeader); len(userAgents) > 0 {
-			mtdt.UserAgent = userAgents[0]
-		}
-		if userAgents := md.Get(userAgentHeader); len(userAgents) > 0 {
-			mtdt.UserAgent = userAgents[0]
-		}
-		if clientIPs := md.Get(xForwardedForHeader); len(clientIPs) > 0 {
-			mtdt.ClientIP = clientIPs[0]
-		}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if userAgents := md.Get(grpcGatewayUserAgentHeader); len(userAgents) > 0 {
+		mtdt.UserAgent = userAgents[0]
+	}
+	if userAgents := md.Get(userAgentHeader); len(userAgents) > 0 {
+		mtdt.UserAgent = userAgents[0]
 	}
 
+	// Requests from the in-process gateway have no peer; the gateway passes
+	// the HTTP connection in the context instead.
 	if p, ok := peer.FromContext(ctx); ok {
-		mtdt.ClientIP = p.Addr.String()
+		mtdt.ClientIP = s.resolveClientIP(p.Addr.String(), md.Get(xForwardedForHeader), md.Get(forwardedHeader))
+	} else if client, ok := ctx.Value(gatewayClientKey{}).(gatewayClient); ok {
+		mtdt.ClientIP = s.resolveClientIP(client.remoteAddr, client.forwardedFor, client.forwarded)
 	}
 	return mtdt
 }
@@ -0,0 +1,81 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/valkyraycho/bank_project/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+func GRPCMetrics(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	startTime := time.Now()
+	res, err := handler(ctx, req)
+	duration := time.Since(startTime)
+
+	code := status.Code(err).String()
+	metrics.GRPCRequestDuration.WithLabelValues(info.FullMethod, code).Observe(duration.Seconds())
+	metrics.GRPCRequestsTotal.WithLabelValues(info.FullMethod, code).Inc()
+
+	return res, err
+}
+
+func GRPCStreamMetrics(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	startTime := time.Now()
+	wrapped := wrapServerStream(stream)
+	err := handler(srv, wrapped)
+	duration := time.Since(startTime)
+
+	code := status.Code(err).String()
+	metrics.GRPCRequestDuration.WithLabelValues(info.FullMethod, code).Observe(duration.Seconds())
+	metrics.GRPCRequestsTotal.WithLabelValues(info.FullMethod, code).Inc()
+	metrics.GRPCStreamMessages.WithLabelValues(info.FullMethod, "sent").Add(float64(wrapped.sent.Load()))
+	metrics.GRPCStreamMessages.WithLabelValues(info.FullMethod, "received").Add(float64(wrapped.received.Load()))
+
+	return err
+}
+
+// GatewayMetrics is installed on the grpc-gateway mux rather than on the
+// http.Server so that only matched routes are measured, labelled by their
+// path template instead of the raw URL.
+func GatewayMetrics(next runtime.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		startTime := time.Now()
+		recorder := &ResponseRecorder{
+			ResponseWriter: w,
+			StatusCode:     http.StatusOK,
+		}
+		next(recorder, r, pathParams)
+		duration := time.Since(startTime)
+
+		route := routeTemplate(r.URL.Path, pathParams)
+		statusCode := strconv.Itoa(recorder.StatusCode)
+		metrics.HTTPRequestDuration.WithLabelValues(r.Method, route, statusCode).Observe(duration.Seconds())
+		metrics.HTTPRequestsTotal.WithLabelValues(r.Method, route, statusCode).Inc()
+	}
+}
+
+func routeTemplate(path string, pathParams map[string]string) string {
+	names := make([]string, 0, len(pathParams))
+	for name := range pathParams {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		for _, name := range names {
+			if segment != "" && segment == pathParams[name] {
+				segments[i] = "{" + name + "}"
+				break
+			}
+		}
+	}
+	return strings.Join(segments, "/")
+}
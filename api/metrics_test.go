@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/valkyraycho/bank_project/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGRPCMetrics(t *testing.T) {
+	method := "/pb.BankService/TestGRPCMetrics"
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+
+	_, err := GRPCMetrics(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+		return nil, nil
+	})
+	require.NoError(t, err)
+
+	_, err = GRPCMetrics(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+		return nil, status.Error(codes.NotFound, "not found")
+	})
+	require.Error(t, err)
+
+	require.Equal(t, float64(1), testutil.ToFloat64(metrics.GRPCRequestsTotal.WithLabelValues(method, codes.OK.String())))
+	require.Equal(t, float64(1), testutil.ToFloat64(metrics.GRPCRequestsTotal.WithLabelValues(method, codes.NotFound.String())))
+}
+
+func TestGatewayMetrics(t *testing.T) {
+	handler := GatewayMetrics(func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, "/v1/metrics-test/42", nil), map[string]string{"id": "42"})
+	require.Equal(t, http.StatusNotFound, recorder.Code)
+
+	counter := metrics.HTTPRequestsTotal.WithLabelValues(http.MethodGet, "/v1/metrics-test/{id}", "404")
+	require.Equal(t, float64(1), testutil.ToFloat64(counter))
+}
+
+func TestRouteTemplate(t *testing.T) {
+	require.Equal(t, "/v1/accounts", routeTemplate("/v1/accounts", nil))
+	require.Equal(t, "/v1/accounts/{id}", routeTemplate("/v1/accounts/7", map[string]string{"id": "7"}))
+	require.Equal(t, "/v1/users/{id}", routeTemplate("/v1/users/1", map[string]string{"id": "1"}))
+}
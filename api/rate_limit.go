@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/valkyraycho/bank_project/ratelimit"
+	"github.com/valkyraycho/bank_project/telemetry"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func (s *Server) GRPCRateLimit(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	authHeader := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if auths := md.Get(authorizationHeader); len(auths) > 0 {
+			authHeader = auths[0]
+		}
+	}
+
+	if err := s.checkRateLimit(ctx, info.FullMethod, authHeader, s.extractMetadata(ctx).ClientIP); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (s *Server) gatewayRateLimit(mux *runtime.ServeMux, next runtime.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		clientIP := s.resolveClientIP(r.RemoteAddr, r.Header.Values(xForwardedForHeader), r.Header.Values(forwardedHeader))
+		method := gatewayMethod(r, pathParams)
+		if err := s.checkRateLimit(r.Context(), method, r.Header.Get(authorizationHeader), clientIP); err != nil {
+			gatewayHTTPError(mux, w, r, err)
+			return
+		}
+		next(w, r, pathParams)
+	}
+}
+
+// checkRateLimit takes a token from the bucket of the caller for the given
+// method. Authenticated callers are limited per user, anonymous callers per
+// client IP. Limiter failures are logged and let the request through.
+func (s *Server) checkRateLimit(ctx context.Context, fullMethod, authHeader, clientIP string) error {
+	method := path.Base(fullMethod)
+	limit, ok := s.rateLimits[method]
+	if !ok {
+		limit, ok = s.rateLimits[ratelimit.DefaultMethod]
+	}
+	if !ok || fullMethod == "" {
+		return nil
+	}
+
+	subject := "ip:" + clientIP
+	if authHeader != "" {
+		if payload, err := s.verifyAuthorizationHeader(authHeader); err == nil {
+			subject = fmt.Sprintf("user:%d", payload.UserID)
+		}
+	}
+
+	result, err := s.limiter.Allow(ctx, method+":"+subject, limit)
+	if err != nil {
+		telemetry.Logger(ctx).Warn().Err(err).Str("method", method).Msg("rate limiter unavailable")
+		return nil
+	}
+	if !result.Allowed {
+		return rateLimitError(result.RetryAfter)
+	}
+	return nil
+}
+
+func rateLimitError(retryAfter time.Duration) error {
+	st := status.New(codes.ResourceExhausted, "rate limit exceeded")
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
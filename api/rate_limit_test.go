@@ -0,0 +1,103 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/utils"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newRateLimitedTestServer(t *testing.T, store *mockdb.MockStore) *Server {
+	server, err := NewServer(utils.Config{
+		TokenSymmetricKey: utils.RandomString(32),
+		RateLimits:        "LoginUser=1:2",
+	}, store)
+	require.NoError(t, err)
+	return server
+}
+
+func TestGatewayMethods(t *testing.T) {
+	for i := 0; i < len(pb.BankService_ServiceDesc.Methods); i++ {
+		method := "/" + pb.BankService_ServiceDesc.ServiceName + "/" + pb.BankService_ServiceDesc.Methods[i].MethodName
+		found := false
+		for _, fullMethod := range gatewayMethods {
+			found = found || fullMethod == method
+		}
+		require.True(t, found, method)
+	}
+	require.Equal(t, "/pb.BankService/GetAccount", gatewayMethods["GET /v1/accounts/{id}"])
+	require.Equal(t, "/pb.BankService/LoginUser", gatewayMethods["POST /v1/users/login"])
+}
+
+func TestGRPCRateLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := newRateLimitedTestServer(t, mockdb.NewMockStore(ctrl))
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.BankService/LoginUser"}
+	for i := 0; i < 2; i++ {
+		_, err := server.GRPCRateLimit(context.Background(), nil, info, handler)
+		require.NoError(t, err)
+	}
+
+	_, err := server.GRPCRateLimit(context.Background(), nil, info, handler)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.ResourceExhausted, st.Code())
+	require.Len(t, st.Details(), 1)
+	retryInfo, ok := st.Details()[0].(*errdetails.RetryInfo)
+	require.True(t, ok)
+	require.InDelta(t, time.Second, retryInfo.GetRetryDelay().AsDuration(), float64(10*time.Millisecond))
+
+	// Authenticated callers get their own bucket and unlimited methods pass.
+	ctx := newContextWithBearerToken(t, server.tokenMaker, 1, utils.CustomerRole, time.Minute)
+	_, err = server.GRPCRateLimit(ctx, nil, info, handler)
+	require.NoError(t, err)
+
+	for i := 0; i < 5; i++ {
+		_, err = server.GRPCRateLimit(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/pb.BankService/GetAccount"}, handler)
+		require.NoError(t, err)
+	}
+}
+
+func TestGatewayRateLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetUser(gomock.Any(), gomock.Any()).AnyTimes().Return(randomUserForRateLimit(t), nil)
+	store.EXPECT().AppendAuditLogTx(gomock.Any(), gomock.Any()).AnyTimes()
+
+	server := newRateLimitedTestServer(t, store)
+	mux, err := server.NewGatewayMux(context.Background())
+	require.NoError(t, err)
+
+	var recorder *httptest.ResponseRecorder
+	for i := 0; i < 3; i++ {
+		recorder = httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodPost, "/v1/users/login", strings.NewReader(`{"username":"rate_limit","password":"wrong_password"}`))
+		mux.ServeHTTP(recorder, request)
+	}
+	require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	require.Equal(t, "1", recorder.Header().Get("Retry-After"))
+}
+
+func randomUserForRateLimit(t *testing.T) db.User {
+	user, _ := randomUser(t)
+	return user
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/valkyraycho/bank_project/metrics"
+	"github.com/valkyraycho/bank_project/telemetry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func GRPCRecovery(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recoverGRPCPanic(ctx, info.FullMethod, r)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+func GRPCStreamRecovery(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recoverGRPCPanic(stream.Context(), info.FullMethod, r)
+		}
+	}()
+	return handler(srv, stream)
+}
+
+func recoverGRPCPanic(ctx context.Context, method string, r any) error {
+	metrics.PanicsRecovered.WithLabelValues("GRPC").Inc()
+	telemetry.Logger(ctx).Error().Ctx(ctx).
+		Str("protocol", "GRPC").
+		Str("method", method).
+		Interface("panic", r).
+		Bytes("stack", debug.Stack()).
+		Msg("recovered from panic")
+	return status.Error(codes.Internal, "internal server error")
+}
+
+func HTTPRecovery(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			rec := recover()
+			if rec == nil {
+				return
+			}
+			if rec == http.ErrAbortHandler {
+				panic(rec)
+			}
+
+			metrics.PanicsRecovered.WithLabelValues("HTTP").Inc()
+			telemetry.Logger(r.Context()).Error().Ctx(r.Context()).
+				Str("protocol", "HTTP").
+				Str("method", r.Method).
+				Str("path", r.RequestURI).
+				Interface("panic", rec).
+				Bytes("stack", debug.Stack()).
+				Msg("recovered from panic")
+
+			body, _ := gatewayMarshaler.Marshal(
+				newErrorResponse(r.Context(), status.New(codes.Internal, "internal server error")),
+			)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write(body)
+		}()
+		handler.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,100 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	header   metadata.MD
+	trailer  metadata.MD
+	received int
+}
+
+func (stream *testServerStream) Context() context.Context { return stream.ctx }
+
+func (stream *testServerStream) SetHeader(md metadata.MD) error {
+	stream.header = metadata.Join(stream.header, md)
+	return nil
+}
+
+func (stream *testServerStream) SetTrailer(md metadata.MD) {
+	stream.trailer = metadata.Join(stream.trailer, md)
+}
+
+func (stream *testServerStream) SendMsg(m any) error { return nil }
+
+func (stream *testServerStream) RecvMsg(m any) error {
+	stream.received++
+	return nil
+}
+
+func TestGRPCRecovery(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.BankService/Panic"}
+	res, err := GRPCRecovery(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+		panic("boom")
+	})
+	require.Nil(t, res)
+	require.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestGRPCStreamRecovery(t *testing.T) {
+	info := &grpc.StreamServerInfo{FullMethod: "/pb.BankService/PanicStream", IsServerStream: true}
+	stream := &testServerStream{ctx: context.Background()}
+	err := GRPCStreamRecovery(nil, stream, info, func(srv any, stream grpc.ServerStream) error {
+		panic("boom")
+	})
+	require.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestGRPCStreamInterceptors(t *testing.T) {
+	info := &grpc.StreamServerInfo{FullMethod: "/pb.BankService/Stream", IsServerStream: true}
+	stream := &testServerStream{ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(requestIDHeader, "stream-id"))}
+
+	handler := func(srv any, stream grpc.ServerStream) error {
+		require.NoError(t, stream.SendMsg(nil))
+		require.NoError(t, stream.SendMsg(nil))
+		require.NoError(t, stream.RecvMsg(nil))
+		return nil
+	}
+
+	err := GRPCStreamRequestID(nil, stream, info, func(srv any, ss grpc.ServerStream) error {
+		return GRPCStreamLogger(srv, ss, info, func(srv any, ss grpc.ServerStream) error {
+			wrapped := ss.(*wrappedServerStream)
+			require.NoError(t, handler(srv, ss))
+			require.Equal(t, int64(2), wrapped.sent.Load())
+			require.Equal(t, int64(1), wrapped.received.Load())
+			return nil
+		})
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"stream-id"}, stream.header.Get(requestIDHeader))
+	require.Equal(t, []string{"stream-id"}, stream.trailer.Get(requestIDHeader))
+}
+
+func TestHTTPRecovery(t *testing.T) {
+	handler := HTTPRecovery(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/v1/accounts", nil))
+	require.Equal(t, http.StatusInternalServerError, recorder.Code)
+	require.Contains(t, recorder.Body.String(), "internal server error")
+
+	require.Panics(t, func() {
+		HTTPRecovery(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			panic(http.ErrAbortHandler)
+		})).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	})
+}
@@ -0,0 +1,99 @@
+package api
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/valkyraycho/bank_project/telemetry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	requestIDHeader     = "x-request-id"
+	maxRequestIDLength  = 128
+	httpRequestIDHeader = "X-Request-ID"
+)
+
+func GRPCRequestID(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	ctx, md := grpcRequestContext(ctx)
+	if err := grpc.SetHeader(ctx, md); err != nil {
+		telemetry.Logger(ctx).Warn().Err(err).Msg("cannot set request id header")
+	}
+	res, err := handler(ctx, req)
+	grpc.SetTrailer(ctx, md)
+	return res, err
+}
+
+func GRPCStreamRequestID(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	wrapped := wrapServerStream(stream)
+	ctx, md := grpcRequestContext(stream.Context())
+	wrapped.ctx = ctx
+
+	if err := stream.SetHeader(md); err != nil {
+		telemetry.Logger(ctx).Warn().Err(err).Msg("cannot set request id header")
+	}
+	err := handler(srv, wrapped)
+	stream.SetTrailer(md)
+	return err
+}
+
+func grpcRequestContext(ctx context.Context) (context.Context, metadata.MD) {
+	requestID := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(requestIDHeader); len(values) > 0 {
+			requestID = values[0]
+		}
+	}
+	requestID = normalizeRequestID(requestID)
+
+	clientIP := ""
+	if p, ok := peer.FromContext(ctx); ok {
+		clientIP = p.Addr.String()
+	}
+
+	ctx = withRequestLogger(ctx, requestID, clientIP)
+	return ctx, metadata.Pairs(requestIDHeader, requestID)
+}
+
+func HTTPRequestID(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := normalizeRequestID(r.Header.Get(httpRequestIDHeader))
+		w.Header().Set(httpRequestIDHeader, requestID)
+
+		clientIP, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			clientIP = r.RemoteAddr
+		}
+
+		ctx := withRequestLogger(r.Context(), requestID, clientIP)
+		handler.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func withRequestLogger(ctx context.Context, requestID, clientIP string) context.Context {
+	ctx = telemetry.WithRequestID(ctx, requestID)
+	logger := log.With().
+		Str("request_id", requestID).
+		Str("client_ip", clientIP).
+		Logger()
+	return logger.WithContext(ctx)
+}
+
+// normalizeRequestID keeps a caller supplied request ID when it is safe to log
+// and echo back, and generates a new one otherwise.
+func normalizeRequestID(requestID string) string {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return uuid.NewString()
+	}
+	for _, c := range requestID {
+		if c < 0x21 || c > 0x7e {
+			return uuid.NewString()
+		}
+	}
+	return requestID
+}
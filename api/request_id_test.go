@@ -0,0 +1,74 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/require"
+	"github.com/valkyraycho/bank_project/telemetry"
+	"github.com/valkyraycho/bank_project/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestNormalizeRequestID(t *testing.T) {
+	require.Equal(t, "abc-123", normalizeRequestID("abc-123"))
+
+	for _, requestID := range []string{"", "has space", "line\nbreak", strings.Repeat("a", maxRequestIDLength+1)} {
+		normalized := normalizeRequestID(requestID)
+		require.NotEqual(t, requestID, normalized)
+		require.Len(t, normalized, 36)
+	}
+}
+
+func TestHTTPRequestID(t *testing.T) {
+	var gotRequestID string
+	handler := HTTPRequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotRequestID = telemetry.RequestID(r.Context())
+	}))
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/v1/accounts", nil)
+	request.Header.Set(httpRequestIDHeader, "client-request-id")
+	handler.ServeHTTP(recorder, request)
+	require.Equal(t, "client-request-id", gotRequestID)
+	require.Equal(t, "client-request-id", recorder.Header().Get(httpRequestIDHeader))
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/v1/accounts", nil))
+	require.NotEmpty(t, gotRequestID)
+	require.Equal(t, gotRequestID, recorder.Header().Get(httpRequestIDHeader))
+}
+
+func TestGRPCRequestIDLogsUserID(t *testing.T) {
+	var buf bytes.Buffer
+	globalLogger := log.Logger
+	log.Logger = zerolog.New(&buf)
+	defer func() { log.Logger = globalLogger }()
+
+	server := NewTestServer(t, nil)
+	ctx := newContextWithBearerToken(t, server.tokenMaker, 42, utils.CustomerRole, time.Minute)
+	md, _ := metadata.FromIncomingContext(ctx)
+	md.Set(requestIDHeader, "grpc-request-id")
+	ctx = metadata.NewIncomingContext(ctx, md)
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.BankService/GetAccount"}
+	_, err := GRPCRequestID(ctx, nil, info, func(ctx context.Context, req any) (any, error) {
+		require.Equal(t, "grpc-request-id", telemetry.RequestID(ctx))
+		_, err := server.authorizeUser(ctx, utils.SelfAndBanker)
+		require.NoError(t, err)
+		telemetry.Logger(ctx).Info().Msg("handled")
+		return nil, nil
+	})
+	require.NoError(t, err)
+
+	require.Contains(t, buf.String(), `"request_id":"grpc-request-id"`)
+	require.Contains(t, buf.String(), `"user_id":42`)
+}
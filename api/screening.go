@@ -0,0 +1,209 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/utils"
+	"github.com/valkyraycho/bank_project/validator"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var requestScreeningMatchStatuses = map[pb.ScreeningMatchStatus]db.ScreeningMatchStatus{
+	pb.ScreeningMatchStatus_SCREENING_MATCH_STATUS_PENDING:   db.ScreeningMatchStatusPending,
+	pb.ScreeningMatchStatus_SCREENING_MATCH_STATUS_CLEARED:   db.ScreeningMatchStatusCleared,
+	pb.ScreeningMatchStatus_SCREENING_MATCH_STATUS_CONFIRMED: db.ScreeningMatchStatusConfirmed,
+}
+
+var screeningMatchStatuses = map[db.ScreeningMatchStatus]pb.ScreeningMatchStatus{
+	db.ScreeningMatchStatusPending:   pb.ScreeningMatchStatus_SCREENING_MATCH_STATUS_PENDING,
+	db.ScreeningMatchStatusCleared:   pb.ScreeningMatchStatus_SCREENING_MATCH_STATUS_CLEARED,
+	db.ScreeningMatchStatusConfirmed: pb.ScreeningMatchStatus_SCREENING_MATCH_STATUS_CONFIRMED,
+}
+
+var userScreeningStatuses = map[db.ScreeningStatus]pb.UserScreeningStatus{
+	db.ScreeningStatusClear:         pb.UserScreeningStatus_USER_SCREENING_STATUS_CLEAR,
+	db.ScreeningStatusPendingReview: pb.UserScreeningStatus_USER_SCREENING_STATUS_PENDING_REVIEW,
+	db.ScreeningStatusBlocked:       pb.UserScreeningStatus_USER_SCREENING_STATUS_BLOCKED,
+}
+
+// userScreener returns the sanctions screener users are screened with, or
+// nil when no sanctions list is configured.
+func (s *Server) userScreener() db.UserScreener {
+	if s.sanctions == nil {
+		return nil
+	}
+	return s.sanctions
+}
+
+func (s *Server) ListScreeningMatches(ctx context.Context, req *pb.ListScreeningMatchesRequest) (*pb.ListScreeningMatchesResponse, error) {
+	_, err := s.authorizeUser(ctx, []string{utils.BankerRole})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	violations := validateListScreeningMatchesRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentsError(violations)
+	}
+
+	args := db.ListScreeningMatchesParams{
+		Limit:  req.GetLimit(),
+		Offset: req.GetOffset(),
+		UserID: optionalInt4(req.UserId),
+	}
+	if req.Status != nil {
+		args.Status = db.NullScreeningMatchStatus{ScreeningMatchStatus: requestScreeningMatchStatuses[req.GetStatus()], Valid: true}
+	}
+
+	matches, err := s.store.ListScreeningMatches(ctx, args)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list screening matches: %s", err)
+	}
+	return &pb.ListScreeningMatchesResponse{ScreeningMatches: convertScreeningMatches(matches)}, nil
+}
+
+func validateListScreeningMatchesRequest(req *pb.ListScreeningMatchesRequest) []*errdetails.BadRequest_FieldViolation {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+
+	defaultLimit := int32(10)
+	defaultOffset := int32(0)
+
+	if req.UserId != nil {
+		if err := validator.ValidateID(req.GetUserId()); err != nil {
+			violations = append(violations, fieldViolation("user_id", err))
+		}
+	}
+
+	if req.Status != nil {
+		if _, ok := requestScreeningMatchStatuses[req.GetStatus()]; !ok {
+			violations = append(violations, fieldViolation("status", errors.New("unknown screening match status")))
+		}
+	}
+
+	if req.Limit != nil {
+		if err := validator.ValidateLimit(req.GetLimit()); err != nil {
+			violations = append(violations, fieldViolation("limit", err))
+		}
+	} else {
+		req.Limit = &defaultLimit
+	}
+
+	if req.Offset != nil {
+		if err := validator.ValidateOffset(req.GetOffset()); err != nil {
+			violations = append(violations, fieldViolation("offset", err))
+		}
+	} else {
+		req.Offset = &defaultOffset
+	}
+
+	return violations
+}
+
+func (s *Server) ClearScreeningReview(ctx context.Context, req *pb.ClearScreeningReviewRequest) (*pb.ClearScreeningReviewResponse, error) {
+	result, err := s.resolveScreeningReview(ctx, req.GetUserId(), req.GetReason(), false)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ClearScreeningReviewResponse{
+		UserId:           result.User.ID,
+		ScreeningStatus:  userScreeningStatuses[result.User.ScreeningStatus],
+		ScreeningMatches: convertScreeningMatches(result.Matches),
+	}, nil
+}
+
+func (s *Server) ConfirmScreeningMatch(ctx context.Context, req *pb.ConfirmScreeningMatchRequest) (*pb.ConfirmScreeningMatchResponse, error) {
+	result, err := s.resolveScreeningReview(ctx, req.GetUserId(), req.GetReason(), true)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ConfirmScreeningMatchResponse{
+		UserId:           result.User.ID,
+		ScreeningStatus:  userScreeningStatuses[result.User.ScreeningStatus],
+		ScreeningMatches: convertScreeningMatches(result.Matches),
+	}, nil
+}
+
+func (s *Server) resolveScreeningReview(ctx context.Context, userID int32, reason string, confirmed bool) (db.ResolveScreeningReviewTxResult, error) {
+	payload, err := s.authorizeUser(ctx, []string{utils.BankerRole})
+	if err != nil {
+		return db.ResolveScreeningReviewTxResult{}, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	violations := []*errdetails.BadRequest_FieldViolation{}
+	if err := validator.ValidateID(userID); err != nil {
+		violations = append(violations, fieldViolation("user_id", err))
+	}
+	if err := validator.ValidateString(reason, 1, 200); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+	if len(violations) > 0 {
+		return db.ResolveScreeningReviewTxResult{}, invalidArgumentsError(violations)
+	}
+
+	result, err := s.store.ResolveScreeningReviewTx(ctx, db.ResolveScreeningReviewTxParams{
+		UserID:     userID,
+		Confirmed:  confirmed,
+		ReviewedBy: payload.UserID,
+		Reason:     reason,
+	})
+	if err != nil {
+		switch {
+		case err == pgx.ErrNoRows:
+			return db.ResolveScreeningReviewTxResult{}, status.Errorf(codes.NotFound, "user not found: %s", err)
+		case errors.Is(err, db.ErrNoPendingScreeningReview):
+			return db.ResolveScreeningReviewTxResult{}, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return db.ResolveScreeningReviewTxResult{}, status.Errorf(codes.Internal, "failed to resolve screening review: %s", err)
+	}
+
+	matchIDs := []int64{}
+	for _, match := range result.Matches {
+		matchIDs = append(matchIDs, match.ID)
+	}
+	auditTarget(ctx, "user", result.User.ID,
+		map[string]any{"screening_status": db.ScreeningStatusPendingReview},
+		map[string]any{
+			"screening_status": result.User.ScreeningStatus,
+			"reason":           reason,
+			"match_ids":        matchIDs,
+		},
+	)
+	return result, nil
+}
+
+func convertScreeningMatches(matches []db.ScreeningMatch) []*pb.ScreeningMatch {
+	rsp := []*pb.ScreeningMatch{}
+	for _, match := range matches {
+		rsp = append(rsp, convertScreeningMatch(match))
+	}
+	return rsp
+}
+
+func convertScreeningMatch(match db.ScreeningMatch) *pb.ScreeningMatch {
+	rsp := &pb.ScreeningMatch{
+		Id:           match.ID,
+		UserId:       match.UserID,
+		EntryId:      match.EntryID,
+		EntryName:    match.EntryName,
+		MatchedName:  match.MatchedName,
+		Score:        match.Score,
+		Source:       match.Source,
+		Status:       screeningMatchStatuses[match.Status],
+		ReviewReason: match.ReviewReason,
+		CreatedAt:    timestamppb.New(match.CreatedAt),
+	}
+	if match.ReviewedBy.Valid {
+		rsp.ReviewedBy = &match.ReviewedBy.Int32
+	}
+	if match.ReviewedAt.Valid {
+		rsp.ReviewedAt = timestamppb.New(match.ReviewedAt.Time)
+	}
+	return rsp
+}
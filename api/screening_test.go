@@ -0,0 +1,416 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/sanctions"
+	"github.com/valkyraycho/bank_project/token"
+	"github.com/valkyraycho/bank_project/utils"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func randomScreeningMatch(user db.User) db.ScreeningMatch {
+	return db.ScreeningMatch{
+		ID:          int64(utils.RandomInt(1, 1000)),
+		UserID:      user.ID,
+		EntryID:     utils.RandomString(6),
+		EntryName:   user.FullName,
+		MatchedName: user.FullName,
+		Score:       0.92,
+		Source:      db.ScreeningSourceCreateUser,
+		Status:      db.ScreeningMatchStatusPending,
+		CreatedAt:   time.Now(),
+	}
+}
+
+func TestCreateUserScreensSanctions(t *testing.T) {
+	user, password := randomUser(t)
+
+	flagged := user
+	flagged.ScreeningStatus = db.ScreeningStatusPendingReview
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.CreateUserResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateUserTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, args db.CreateUserTxParams) (db.CreateUserTxResult, error) {
+						require.NotNil(t, args.Screener)
+						require.Equal(t, []db.NewScreeningMatch{
+							{EntryID: "X-1", EntryName: user.FullName, MatchedName: user.FullName, Score: 1},
+						}, args.Screener.ScreenName(args.FullName))
+						return db.CreateUserTxResult{User: flagged, Matches: []db.ScreeningMatch{randomScreeningMatch(user)}}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateUserResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, user.Username, res.GetUser().GetUsername())
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateUserTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CreateUserTxResult{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+	}
+
+	screener, err := sanctions.NewScreener([]sanctions.Entry{{ID: "X-1", Name: user.FullName}}, 0)
+	require.NoError(t, err)
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		server.sanctions = screener
+		res, err := server.CreateUser(context.Background(), &pb.CreateUserRequest{
+			Username: user.Username,
+			Password: password,
+			FullName: user.FullName,
+			Email:    user.Email,
+		})
+		testCase.checkResponse(t, res, err)
+	}
+}
+
+func TestListScreeningMatches(t *testing.T) {
+	user, _ := randomUser(t)
+	banker, _ := randomUser(t)
+	banker.Role = utils.BankerRole
+
+	matches := []db.ScreeningMatch{randomScreeningMatch(user), randomScreeningMatch(user)}
+	pending := pb.ScreeningMatchStatus_SCREENING_MATCH_STATUS_PENDING
+
+	testCases := []struct {
+		name          string
+		req           *pb.ListScreeningMatchesRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.TokenMaker) context.Context
+		checkResponse func(t *testing.T, res *pb.ListScreeningMatchesResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.ListScreeningMatchesRequest{UserId: &user.ID, Status: &pending},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListScreeningMatches(gomock.Any(), gomock.Eq(db.ListScreeningMatchesParams{
+						Limit:  10,
+						Offset: 0,
+						UserID: pgtype.Int4{Int32: user.ID, Valid: true},
+						Status: db.NullScreeningMatchStatus{ScreeningMatchStatus: db.ScreeningMatchStatusPending, Valid: true},
+					})).
+					Times(1).
+					Return(matches, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListScreeningMatchesResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetScreeningMatches(), len(matches))
+				for i, match := range res.GetScreeningMatches() {
+					require.Equal(t, matches[i].ID, match.GetId())
+					require.Equal(t, matches[i].EntryID, match.GetEntryId())
+					require.Equal(t, pb.ScreeningMatchStatus_SCREENING_MATCH_STATUS_PENDING, match.GetStatus())
+					require.Nil(t, match.ReviewedBy)
+				}
+			},
+		},
+		{
+			name: "CustomerNotAllowed",
+			req:  &pb.ListScreeningMatchesRequest{UserId: &user.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListScreeningMatches(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListScreeningMatchesResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name: "InvalidStatus",
+			req:  &pb.ListScreeningMatchesRequest{Status: pb.ScreeningMatchStatus_SCREENING_MATCH_STATUS_UNSPECIFIED.Enum()},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListScreeningMatches(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListScreeningMatchesResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "InternalError",
+			req:  &pb.ListScreeningMatchesRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListScreeningMatches(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListScreeningMatchesResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		res, err := server.ListScreeningMatches(testCase.buildContext(t, server.tokenMaker), testCase.req)
+		testCase.checkResponse(t, res, err)
+	}
+}
+
+func TestClearScreeningReview(t *testing.T) {
+	user, _ := randomUser(t)
+	banker, _ := randomUser(t)
+	banker.Role = utils.BankerRole
+
+	cleared := user
+	cleared.ScreeningStatus = db.ScreeningStatusClear
+	match := randomScreeningMatch(user)
+	match.Status = db.ScreeningMatchStatusCleared
+	match.ReviewedBy = pgtype.Int4{Int32: banker.ID, Valid: true}
+	match.ReviewReason = "different date of birth"
+	match.ReviewedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+	testCases := []struct {
+		name          string
+		req           *pb.ClearScreeningReviewRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.TokenMaker) context.Context
+		checkResponse func(t *testing.T, res *pb.ClearScreeningReviewResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.ClearScreeningReviewRequest{UserId: user.ID, Reason: "different date of birth"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ResolveScreeningReviewTx(gomock.Any(), gomock.Eq(db.ResolveScreeningReviewTxParams{
+						UserID:     user.ID,
+						ReviewedBy: banker.ID,
+						Reason:     "different date of birth",
+					})).
+					Times(1).
+					Return(db.ResolveScreeningReviewTxResult{User: cleared, Matches: []db.ScreeningMatch{match}}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ClearScreeningReviewResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, user.ID, res.GetUserId())
+				require.Equal(t, pb.UserScreeningStatus_USER_SCREENING_STATUS_CLEAR, res.GetScreeningStatus())
+				require.Len(t, res.GetScreeningMatches(), 1)
+				require.Equal(t, pb.ScreeningMatchStatus_SCREENING_MATCH_STATUS_CLEARED, res.GetScreeningMatches()[0].GetStatus())
+				require.Equal(t, banker.ID, res.GetScreeningMatches()[0].GetReviewedBy())
+			},
+		},
+		{
+			name: "CustomerNotAllowed",
+			req:  &pb.ClearScreeningReviewRequest{UserId: user.ID, Reason: "not me"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ResolveScreeningReviewTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ClearScreeningReviewResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name: "ReasonRequired",
+			req:  &pb.ClearScreeningReviewRequest{UserId: user.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ResolveScreeningReviewTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ClearScreeningReviewResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "NotPendingReview",
+			req:  &pb.ClearScreeningReviewRequest{UserId: user.ID, Reason: "different date of birth"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ResolveScreeningReviewTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ResolveScreeningReviewTxResult{}, db.ErrNoPendingScreeningReview)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ClearScreeningReviewResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "InternalError",
+			req:  &pb.ClearScreeningReviewRequest{UserId: user.ID, Reason: "different date of birth"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ResolveScreeningReviewTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ResolveScreeningReviewTxResult{}, sql.ErrConnDone)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ClearScreeningReviewResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		res, err := server.ClearScreeningReview(testCase.buildContext(t, server.tokenMaker), testCase.req)
+		testCase.checkResponse(t, res, err)
+	}
+}
+
+func TestConfirmScreeningMatch(t *testing.T) {
+	user, _ := randomUser(t)
+	banker, _ := randomUser(t)
+	banker.Role = utils.BankerRole
+
+	blocked := user
+	blocked.ScreeningStatus = db.ScreeningStatusBlocked
+	match := randomScreeningMatch(user)
+	match.Status = db.ScreeningMatchStatusConfirmed
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.ConfirmScreeningMatchResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ResolveScreeningReviewTx(gomock.Any(), gomock.Eq(db.ResolveScreeningReviewTxParams{
+						UserID:     user.ID,
+						Confirmed:  true,
+						ReviewedBy: banker.ID,
+						Reason:     "passport matches listing",
+					})).
+					Times(1).
+					Return(db.ResolveScreeningReviewTxResult{User: blocked, Matches: []db.ScreeningMatch{match}}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ConfirmScreeningMatchResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, pb.UserScreeningStatus_USER_SCREENING_STATUS_BLOCKED, res.GetScreeningStatus())
+				require.Equal(t, pb.ScreeningMatchStatus_SCREENING_MATCH_STATUS_CONFIRMED, res.GetScreeningMatches()[0].GetStatus())
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ResolveScreeningReviewTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ResolveScreeningReviewTxResult{}, pgx.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, res *pb.ConfirmScreeningMatchResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		ctx := newContextWithBearerToken(t, server.tokenMaker, banker.ID, banker.Role, time.Minute)
+		res, err := server.ConfirmScreeningMatch(ctx, &pb.ConfirmScreeningMatchRequest{UserId: user.ID, Reason: "passport matches listing"})
+		testCase.checkResponse(t, res, err)
+	}
+}
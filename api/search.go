@@ -0,0 +1,329 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/utils"
+	"github.com/valkyraycho/bank_project/validator"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const defaultSearchPageSize = int32(20)
+
+const (
+	sortByID        = "id"
+	sortByBalance   = "balance"
+	sortByUsername  = "username"
+	sortByCreatedAt = "created_at"
+)
+
+var accountSortFields = map[pb.AccountSortField]string{
+	pb.AccountSortField_ACCOUNT_SORT_FIELD_UNSPECIFIED: sortByID,
+	pb.AccountSortField_ACCOUNT_SORT_FIELD_ID:          sortByID,
+	pb.AccountSortField_ACCOUNT_SORT_FIELD_BALANCE:     sortByBalance,
+	pb.AccountSortField_ACCOUNT_SORT_FIELD_CREATED_AT:  sortByCreatedAt,
+}
+
+var userSortFields = map[pb.UserSortField]string{
+	pb.UserSortField_USER_SORT_FIELD_UNSPECIFIED: sortByID,
+	pb.UserSortField_USER_SORT_FIELD_ID:          sortByID,
+	pb.UserSortField_USER_SORT_FIELD_USERNAME:    sortByUsername,
+	pb.UserSortField_USER_SORT_FIELD_CREATED_AT:  sortByCreatedAt,
+}
+
+// pageCursor is the keyset position carried by a page token: the sort key and
+// id of the last row of the previous page. The sort settings are kept so a
+// token can't be replayed against a different ordering.
+type pageCursor struct {
+	SortBy     string    `json:"s"`
+	Descending bool      `json:"d"`
+	ID         int32     `json:"i"`
+	Balance    int32     `json:"b,omitempty"`
+	Username   string    `json:"u,omitempty"`
+	CreatedAt  time.Time `json:"c,omitempty"`
+}
+
+func encodePageToken(cursor pageCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePageToken(token string, sortBy string, descending bool) (*pageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("malformed page token")
+	}
+
+	cursor := &pageCursor{}
+	if err := json.Unmarshal(data, cursor); err != nil || cursor.ID <= 0 {
+		return nil, fmt.Errorf("malformed page token")
+	}
+	if cursor.SortBy != sortBy || cursor.Descending != descending {
+		return nil, fmt.Errorf("page token was issued for a different sort order")
+	}
+	return cursor, nil
+}
+
+// escapeLikePattern quotes the LIKE wildcards in a user supplied prefix.
+// Usernames may contain underscores, which would otherwise match any character.
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func optionalInt4(v *int32) pgtype.Int4 {
+	if v == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: *v, Valid: true}
+}
+
+func optionalText(v *string) pgtype.Text {
+	if v == nil {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: *v, Valid: true}
+}
+
+func optionalTimestamptz(ts *timestamppb.Timestamp) pgtype.Timestamptz {
+	if ts == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: ts.AsTime(), Valid: true}
+}
+
+func (s *Server) SearchAccounts(ctx context.Context, req *pb.SearchAccountsRequest) (*pb.SearchAccountsResponse, error) {
+	_, err := s.authorizeUser(ctx, []string{utils.BankerRole})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	violations := validateSearchAccountsRequest(req)
+	sortBy := accountSortFields[req.GetSortBy()]
+	descending := req.GetOrder() == pb.SortOrder_SORT_ORDER_DESC
+
+	cursor, err := decodePageToken(req.GetPageToken(), sortBy, descending)
+	if err != nil {
+		violations = append(violations, fieldViolation("page_token", err))
+	}
+
+	if len(violations) > 0 {
+		return nil, invalidArgumentsError(violations)
+	}
+
+	arg := db.SearchAccountsParams{
+		OwnerID:       optionalInt4(req.OwnerId),
+		Currency:      optionalText(req.Currency),
+		MinBalance:    optionalInt4(req.MinBalance),
+		MaxBalance:    optionalInt4(req.MaxBalance),
+		CreatedAfter:  optionalTimestamptz(req.GetCreatedAfter()),
+		CreatedBefore: optionalTimestamptz(req.GetCreatedBefore()),
+		SortBy:        sortBy,
+		Descending:    descending,
+		PageSize:      req.GetPageSize() + 1,
+	}
+	if cursor != nil {
+		arg.CursorID = pgtype.Int4{Int32: cursor.ID, Valid: true}
+		arg.CursorBalance = cursor.Balance
+		arg.CursorCreatedAt = cursor.CreatedAt
+	}
+
+	accounts, err := s.store.SearchAccounts(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search accounts: %s", err)
+	}
+
+	rsp := &pb.SearchAccountsResponse{Accounts: []*pb.Account{}}
+	if len(accounts) > int(req.GetPageSize()) {
+		accounts = accounts[:req.GetPageSize()]
+		last := accounts[len(accounts)-1]
+		rsp.NextPageToken = encodePageToken(pageCursor{
+			SortBy:     sortBy,
+			Descending: descending,
+			ID:         last.ID,
+			Balance:    last.Balance,
+			CreatedAt:  last.CreatedAt,
+		})
+	}
+
+	for _, account := range accounts {
+		rsp.Accounts = append(rsp.Accounts, convertAccount(account))
+	}
+	return rsp, nil
+}
+
+func validateSearchAccountsRequest(req *pb.SearchAccountsRequest) []*errdetails.BadRequest_FieldViolation {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+
+	if req.OwnerId != nil {
+		if err := validator.ValidateID(req.GetOwnerId()); err != nil {
+			violations = append(violations, fieldViolation("owner_id", err))
+		}
+	}
+
+	if req.Currency != nil {
+		if err := validator.ValidateCurrency(req.GetCurrency()); err != nil {
+			violations = append(violations, fieldViolation("currency", err))
+		}
+	}
+
+	if req.MinBalance != nil && req.MaxBalance != nil && req.GetMinBalance() > req.GetMaxBalance() {
+		violations = append(violations, fieldViolation("max_balance", fmt.Errorf("must not be less than min_balance")))
+	}
+
+	violations = append(violations, validateCreatedAtRange(req.GetCreatedAfter(), req.GetCreatedBefore())...)
+
+	if _, ok := accountSortFields[req.GetSortBy()]; !ok {
+		violations = append(violations, fieldViolation("sort_by", fmt.Errorf("unsupported sort field")))
+	}
+
+	violations = append(violations, validatePageSize(&req.PageSize)...)
+
+	return violations
+}
+
+func (s *Server) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest) (*pb.SearchUsersResponse, error) {
+	_, err := s.authorizeUser(ctx, []string{utils.BankerRole})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	violations := validateSearchUsersRequest(req)
+	sortBy := userSortFields[req.GetSortBy()]
+	descending := req.GetOrder() == pb.SortOrder_SORT_ORDER_DESC
+
+	cursor, err := decodePageToken(req.GetPageToken(), sortBy, descending)
+	if err != nil {
+		violations = append(violations, fieldViolation("page_token", err))
+	}
+
+	if len(violations) > 0 {
+		return nil, invalidArgumentsError(violations)
+	}
+
+	arg := db.SearchUsersParams{
+		Role:          optionalText(req.Role),
+		CreatedAfter:  optionalTimestamptz(req.GetCreatedAfter()),
+		CreatedBefore: optionalTimestamptz(req.GetCreatedBefore()),
+		SortBy:        sortBy,
+		Descending:    descending,
+		PageSize:      req.GetPageSize() + 1,
+	}
+	if req.UsernamePrefix != nil {
+		arg.UsernamePrefix = pgtype.Text{String: escapeLikePattern(req.GetUsernamePrefix()), Valid: true}
+	}
+	if req.EmailPrefix != nil {
+		arg.EmailPrefix = pgtype.Text{String: escapeLikePattern(req.GetEmailPrefix()), Valid: true}
+	}
+	if cursor != nil {
+		arg.CursorID = pgtype.Int4{Int32: cursor.ID, Valid: true}
+		arg.CursorUsername = cursor.Username
+		arg.CursorCreatedAt = cursor.CreatedAt
+	}
+
+	users, err := s.store.SearchUsers(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search users: %s", err)
+	}
+
+	rsp := &pb.SearchUsersResponse{Users: []*pb.User{}}
+	if len(users) > int(req.GetPageSize()) {
+		users = users[:req.GetPageSize()]
+		last := users[len(users)-1]
+		rsp.NextPageToken = encodePageToken(pageCursor{
+			SortBy:     sortBy,
+			Descending: descending,
+			ID:         last.ID,
+			Username:   last.Username,
+			CreatedAt:  last.CreatedAt,
+		})
+	}
+
+	for _, user := range users {
+		rsp.Users = append(rsp.Users, convertUser(user))
+	}
+	return rsp, nil
+}
+
+func validateSearchUsersRequest(req *pb.SearchUsersRequest) []*errdetails.BadRequest_FieldViolation {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+
+	if req.UsernamePrefix != nil {
+		if err := validator.ValidateString(req.GetUsernamePrefix(), 1, 100); err != nil {
+			violations = append(violations, fieldViolation("username_prefix", err))
+		}
+	}
+
+	if req.EmailPrefix != nil {
+		if err := validator.ValidateString(req.GetEmailPrefix(), 1, 200); err != nil {
+			violations = append(violations, fieldViolation("email_prefix", err))
+		}
+	}
+
+	if req.Role != nil {
+		if err := validator.ValidateRole(req.GetRole()); err != nil {
+			violations = append(violations, fieldViolation("role", err))
+		}
+	}
+
+	violations = append(violations, validateCreatedAtRange(req.GetCreatedAfter(), req.GetCreatedBefore())...)
+
+	if _, ok := userSortFields[req.GetSortBy()]; !ok {
+		violations = append(violations, fieldViolation("sort_by", fmt.Errorf("unsupported sort field")))
+	}
+
+	violations = append(violations, validatePageSize(&req.PageSize)...)
+
+	return violations
+}
+
+func validateCreatedAtRange(after, before *timestamppb.Timestamp) []*errdetails.BadRequest_FieldViolation {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+
+	if after != nil {
+		if err := after.CheckValid(); err != nil {
+			violations = append(violations, fieldViolation("created_after", err))
+		}
+	}
+
+	if before != nil {
+		if err := before.CheckValid(); err != nil {
+			violations = append(violations, fieldViolation("created_before", err))
+		}
+	}
+
+	if len(violations) == 0 && after != nil && before != nil && !after.AsTime().Before(before.AsTime()) {
+		violations = append(violations, fieldViolation("created_before", fmt.Errorf("must be after created_after")))
+	}
+
+	return violations
+}
+
+func validatePageSize(size **int32) []*errdetails.BadRequest_FieldViolation {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+
+	if *size != nil {
+		if err := validator.ValidatePageSize(**size); err != nil {
+			violations = append(violations, fieldViolation("page_size", err))
+		}
+	} else {
+		defaultSize := defaultSearchPageSize
+		*size = &defaultSize
+	}
+
+	return violations
+}
@@ -0,0 +1,422 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/token"
+	"github.com/valkyraycho/bank_project/utils"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSearchAccounts(t *testing.T) {
+	banker, _ := randomUser(t)
+	banker.Role = utils.BankerRole
+	customer, _ := randomUser(t)
+
+	accounts := randomAccountsFromUser(customer.ID)
+	for i := range accounts {
+		accounts[i].Balance = int32(i * 100)
+		accounts[i].CreatedAt = time.Now().Add(time.Duration(i) * time.Minute).UTC().Truncate(time.Microsecond)
+	}
+
+	pageSize := int32(2)
+	invalidPageSize := int32(101)
+	minBalance := int32(500)
+	maxBalance := int32(100)
+	currency := utils.USD
+
+	createdAfter := timestamppb.New(time.Now().Add(-time.Hour))
+	createdBefore := timestamppb.New(time.Now())
+
+	balanceToken := encodePageToken(pageCursor{
+		SortBy:     sortByBalance,
+		Descending: true,
+		ID:         accounts[1].ID,
+		Balance:    accounts[1].Balance,
+		CreatedAt:  accounts[1].CreatedAt,
+	})
+
+	testCases := []struct {
+		name          string
+		req           *pb.SearchAccountsRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.TokenMaker) context.Context
+		checkResponse func(t *testing.T, res *pb.SearchAccountsResponse, err error)
+	}{
+		{
+			name: "FirstPage",
+			req: &pb.SearchAccountsRequest{
+				OwnerId:       &customer.ID,
+				Currency:      &currency,
+				CreatedAfter:  createdAfter,
+				CreatedBefore: createdBefore,
+				SortBy:        pb.AccountSortField_ACCOUNT_SORT_FIELD_BALANCE,
+				Order:         pb.SortOrder_SORT_ORDER_DESC,
+				PageSize:      &pageSize,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchAccounts(gomock.Any(), gomock.Eq(db.SearchAccountsParams{
+						OwnerID:       pgtype.Int4{Int32: customer.ID, Valid: true},
+						Currency:      pgtype.Text{String: currency, Valid: true},
+						CreatedAfter:  pgtype.Timestamptz{Time: createdAfter.AsTime(), Valid: true},
+						CreatedBefore: pgtype.Timestamptz{Time: createdBefore.AsTime(), Valid: true},
+						SortBy:        sortByBalance,
+						Descending:    true,
+						PageSize:      pageSize + 1,
+					})).
+					Times(1).
+					Return(accounts[:3], nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchAccountsResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetAccounts(), int(pageSize))
+				require.Equal(t, accounts[0].ID, res.GetAccounts()[0].GetId())
+				require.Equal(t, balanceToken, res.GetNextPageToken())
+			},
+		},
+		{
+			name: "NextPage",
+			req: &pb.SearchAccountsRequest{
+				SortBy:    pb.AccountSortField_ACCOUNT_SORT_FIELD_BALANCE,
+				Order:     pb.SortOrder_SORT_ORDER_DESC,
+				PageSize:  &pageSize,
+				PageToken: balanceToken,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchAccounts(gomock.Any(), gomock.Eq(db.SearchAccountsParams{
+						SortBy:          sortByBalance,
+						Descending:      true,
+						CursorID:        pgtype.Int4{Int32: accounts[1].ID, Valid: true},
+						CursorBalance:   accounts[1].Balance,
+						CursorCreatedAt: accounts[1].CreatedAt,
+						PageSize:        pageSize + 1,
+					})).
+					Times(1).
+					Return(accounts[2:3], nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchAccountsResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetAccounts(), 1)
+				require.Empty(t, res.GetNextPageToken())
+			},
+		},
+		{
+			name: "DefaultPageSize",
+			req:  &pb.SearchAccountsRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchAccounts(gomock.Any(), gomock.Eq(db.SearchAccountsParams{
+						SortBy:   sortByID,
+						PageSize: defaultSearchPageSize + 1,
+					})).
+					Times(1).
+					Return([]db.Account{}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchAccountsResponse, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res.GetAccounts())
+				require.Empty(t, res.GetAccounts())
+				require.Empty(t, res.GetNextPageToken())
+			},
+		},
+		{
+			name: "CustomerNotAllowed",
+			req:  &pb.SearchAccountsRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchAccounts(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, customer.ID, customer.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchAccountsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name: "PageTokenForDifferentSort",
+			req: &pb.SearchAccountsRequest{
+				SortBy:    pb.AccountSortField_ACCOUNT_SORT_FIELD_CREATED_AT,
+				PageToken: balanceToken,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchAccounts(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchAccountsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "MalformedPageToken",
+			req:  &pb.SearchAccountsRequest{PageToken: "not a token"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchAccounts(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchAccountsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "InvalidFilters",
+			req: &pb.SearchAccountsRequest{
+				MinBalance:    &minBalance,
+				MaxBalance:    &maxBalance,
+				CreatedAfter:  createdBefore,
+				CreatedBefore: createdAfter,
+				PageSize:      &invalidPageSize,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchAccounts(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchAccountsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+				require.Len(t, st.Details(), 1)
+			},
+		},
+		{
+			name: "InternalError",
+			req:  &pb.SearchAccountsRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchAccounts(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchAccountsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		res, err := server.SearchAccounts(testCase.buildContext(t, server.tokenMaker), testCase.req)
+		testCase.checkResponse(t, res, err)
+	}
+}
+
+func TestSearchUsers(t *testing.T) {
+	banker, _ := randomUser(t)
+	banker.Role = utils.BankerRole
+
+	users := []db.User{}
+	for i := 0; i < 3; i++ {
+		user, _ := randomUser(t)
+		user.ID = int32(i + 1)
+		users = append(users, user)
+	}
+
+	pageSize := int32(2)
+	usernamePrefix := "a_b%"
+	emailPrefix := "Alice@"
+	role := utils.CustomerRole
+	invalidRole := "admin"
+	emptyPrefix := ""
+
+	usernameToken := encodePageToken(pageCursor{
+		SortBy:    sortByUsername,
+		ID:        users[1].ID,
+		Username:  users[1].Username,
+		CreatedAt: users[1].CreatedAt,
+	})
+
+	testCases := []struct {
+		name          string
+		req           *pb.SearchUsersRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.TokenMaker) context.Context
+		checkResponse func(t *testing.T, res *pb.SearchUsersResponse, err error)
+	}{
+		{
+			name: "FirstPage",
+			req: &pb.SearchUsersRequest{
+				UsernamePrefix: &usernamePrefix,
+				EmailPrefix:    &emailPrefix,
+				Role:           &role,
+				SortBy:         pb.UserSortField_USER_SORT_FIELD_USERNAME,
+				PageSize:       &pageSize,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchUsers(gomock.Any(), gomock.Eq(db.SearchUsersParams{
+						UsernamePrefix: pgtype.Text{String: `a\_b\%`, Valid: true},
+						EmailPrefix:    pgtype.Text{String: emailPrefix, Valid: true},
+						Role:           pgtype.Text{String: role, Valid: true},
+						SortBy:         sortByUsername,
+						PageSize:       pageSize + 1,
+					})).
+					Times(1).
+					Return(users, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchUsersResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetUsers(), int(pageSize))
+				require.Equal(t, users[0].Username, res.GetUsers()[0].GetUsername())
+				require.Equal(t, usernameToken, res.GetNextPageToken())
+			},
+		},
+		{
+			name: "NextPage",
+			req: &pb.SearchUsersRequest{
+				SortBy:    pb.UserSortField_USER_SORT_FIELD_USERNAME,
+				PageSize:  &pageSize,
+				PageToken: usernameToken,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchUsers(gomock.Any(), gomock.Eq(db.SearchUsersParams{
+						SortBy:         sortByUsername,
+						CursorID:       pgtype.Int4{Int32: users[1].ID, Valid: true},
+						CursorUsername: users[1].Username,
+						PageSize:       pageSize + 1,
+					})).
+					Times(1).
+					Return(users[2:], nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchUsersResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetUsers(), 1)
+				require.Empty(t, res.GetNextPageToken())
+			},
+		},
+		{
+			name: "CustomerNotAllowed",
+			req:  &pb.SearchUsersRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchUsers(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, users[0].ID, users[0].Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchUsersResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name: "InvalidFilters",
+			req: &pb.SearchUsersRequest{
+				UsernamePrefix: &emptyPrefix,
+				Role:           &invalidRole,
+				SortBy:         pb.UserSortField(42),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchUsers(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchUsersResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "InternalError",
+			req:  &pb.SearchUsersRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchUsers(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchUsersResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		res, err := server.SearchUsers(testCase.buildContext(t, server.tokenMaker), testCase.req)
+		testCase.checkResponse(t, res, err)
+	}
+}
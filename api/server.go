@@ -2,9 +2,15 @@ package api
 
 import (
 	"fmt"
+	"net/http"
+	"net/netip"
 
 	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/fraud"
 	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/ratelimit"
+	"github.com/valkyraycho/bank_project/sanctions"
+	"github.com/valkyraycho/bank_project/tlsutil"
 	"github.com/valkyraycho/bank_project/token"
 	"github.com/valkyraycho/bank_project/utils"
 )
@@ -14,6 +20,16 @@ type Server struct {
 	cfg        utils.Config
 	store      db.Store
 	tokenMaker token.TokenMaker
+	limiter    ratelimit.Limiter
+	rateLimits map[string]ratelimit.Limit
+	screener   db.TransferScreener
+	sanctions  *sanctions.Screener
+
+	serviceIdentities map[string]string
+	trustedProxies    []netip.Prefix
+
+	cors                 corsPolicy
+	refreshTokenSameSite http.SameSite
 }
 
 func NewServer(cfg utils.Config, store db.Store) (*Server, error) {
@@ -21,5 +37,74 @@ func NewServer(cfg utils.Config, store db.Store) (*Server, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to create token maker: %w", err)
 	}
-	return &Server{cfg: cfg, store: store, tokenMaker: tokenMaker}, nil
+
+	rateLimits, err := ratelimit.ParseLimits(cfg.RateLimits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse rate limits: %w", err)
+	}
+
+	var limiter ratelimit.Limiter
+	switch cfg.RateLimitBackend {
+	case "", ratelimit.BackendMemory:
+		limiter = ratelimit.NewMemoryLimiter()
+	case ratelimit.BackendPostgres:
+		limiter = ratelimit.NewPostgresLimiter(store)
+	default:
+		return nil, fmt.Errorf("unsupported rate limit backend: %s", cfg.RateLimitBackend)
+	}
+
+	var screener db.TransferScreener
+	if cfg.FraudRulesFile != "" {
+		rules, err := fraud.LoadRules(cfg.FraudRulesFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load fraud rules: %w", err)
+		}
+		screener = fraud.NewEngine(rules)
+	}
+
+	var sanctionsScreener *sanctions.Screener
+	if cfg.SanctionsListFile != "" {
+		sanctionsScreener, err = sanctions.LoadScreener(cfg.SanctionsListFile, cfg.SanctionsMatchThreshold)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load sanctions list: %w", err)
+		}
+	}
+
+	serviceIdentities, err := tlsutil.ParseServiceIdentities(cfg.ServiceIdentities)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse service identities: %w", err)
+	}
+
+	trustedProxies, err := parseTrustedProxies(cfg.TrustedProxies)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse trusted proxies: %w", err)
+	}
+
+	cors, err := newCORSPolicy(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CORS config: %w", err)
+	}
+
+	refreshTokenSameSite, err := parseSameSite(cfg.RefreshTokenCookieSameSite)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse refresh token cookie config: %w", err)
+	}
+	if refreshTokenSameSite == http.SameSiteNoneMode && !cfg.RefreshTokenCookieSecure {
+		return nil, fmt.Errorf("refresh token cookie with SameSite=None must be secure")
+	}
+
+	return &Server{
+		cfg:               cfg,
+		store:             store,
+		tokenMaker:        tokenMaker,
+		limiter:           limiter,
+		rateLimits:        rateLimits,
+		screener:          screener,
+		sanctions:         sanctionsScreener,
+		serviceIdentities: serviceIdentities,
+		trustedProxies:    trustedProxies,
+
+		cors:                 cors,
+		refreshTokenSameSite: refreshTokenSameSite,
+	}, nil
 }
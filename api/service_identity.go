@@ -0,0 +1,66 @@
+package api
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"github.com/valkyraycho/bank_project/telemetry"
+	"github.com/valkyraycho/bank_project/tlsutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type serviceIdentityKey struct{}
+
+// ServiceIdentity returns the identity of an internal service that
+// authenticated with a client certificate.
+func ServiceIdentity(ctx context.Context) (string, bool) {
+	identity, ok := ctx.Value(serviceIdentityKey{}).(string)
+	return identity, ok
+}
+
+func (s *Server) GRPCServiceIdentity(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	ctx, err = s.withServiceIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (s *Server) GRPCStreamServiceIdentity(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := s.withServiceIdentity(stream.Context())
+	if err != nil {
+		return err
+	}
+	wrapped := wrapServerStream(stream)
+	wrapped.ctx = ctx
+	return handler(srv, wrapped)
+}
+
+// withServiceIdentity maps a verified client certificate to a service
+// identity. Callers without a client certificate pass through unchanged;
+// callers with a verified certificate that maps to no identity are rejected.
+func (s *Server) withServiceIdentity(ctx context.Context) (context.Context, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ctx, nil
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return ctx, nil
+	}
+
+	cert := tlsInfo.State.VerifiedChains[0][0]
+	identity, ok := tlsutil.ServiceIdentity(s.serviceIdentities, cert)
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "unknown service identity: %s", cert.Subject)
+	}
+
+	telemetry.UpdateLogger(ctx, func(c zerolog.Context) zerolog.Context {
+		return c.Str("service", identity)
+	})
+	return context.WithValue(ctx, serviceIdentityKey{}, identity), nil
+}
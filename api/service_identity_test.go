@@ -0,0 +1,105 @@
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509/pkix"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	"github.com/valkyraycho/bank_project/tlsutil"
+	"github.com/valkyraycho/bank_project/tlsutil/tlstest"
+	"github.com/valkyraycho/bank_project/utils"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestGRPCServiceIdentity(t *testing.T) {
+	ca := tlstest.NewCA(t, "test-ca")
+	dir := t.TempDir()
+	certFile, keyFile := ca.ServerCert(t, "bank").WriteFiles(t, dir)
+	clientCAFile := filepath.Join(dir, "client-ca.pem")
+	require.NoError(t, os.WriteFile(clientCAFile, ca.CertPEM(), 0o600))
+
+	reloader, err := tlsutil.NewReloader(certFile, keyFile, clientCAFile)
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server, err := NewServer(utils.Config{
+		TokenSymmetricKey: utils.RandomString(32),
+		ServiceIdentities: "ledger.internal=ledger",
+	}, mockdb.NewMockStore(ctrl))
+	require.NoError(t, err)
+
+	identities := make(chan string, 1)
+	captureIdentity := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		identity, _ := ServiceIdentity(ctx)
+		identities <- identity
+		return handler(ctx, req)
+	}
+
+	grpcServer := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(reloader.ServerConfig(false))),
+		grpc.ChainUnaryInterceptor(server.GRPCServiceIdentity, captureIdentity),
+	)
+	healthpb.RegisterHealthServer(grpcServer, health.NewServer())
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+
+	testCases := []struct {
+		name          string
+		clientCert    *tlstest.KeyPair
+		checkResponse func(t *testing.T, err error)
+	}{
+		{
+			name:       "MappedIdentity",
+			clientCert: ca.ClientCert(t, pkix.Name{CommonName: "ledger.internal"}),
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "ledger", <-identities)
+			},
+		},
+		{
+			name:       "UnknownIdentity",
+			clientCert: ca.ClientCert(t, pkix.Name{CommonName: "unknown.internal"}),
+			checkResponse: func(t *testing.T, err error) {
+				require.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+		{
+			name: "NoClientCertificate",
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+				require.Empty(t, <-identities)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		tlsConfig := &tls.Config{RootCAs: ca.Pool(), ServerName: "localhost"}
+		if testCase.clientCert != nil {
+			tlsConfig.Certificates = []tls.Certificate{testCase.clientCert.TLSCertificate(t)}
+		}
+
+		conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+		require.NoError(t, err)
+
+		_, err = healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+		testCase.checkResponse(t, err)
+		conn.Close()
+	}
+}
@@ -0,0 +1,25 @@
+package api
+
+import (
+	"io/fs"
+	"net/http"
+
+	"github.com/valkyraycho/bank_project/doc"
+)
+
+const swaggerContentSecurityPolicy = "default-src 'self'; img-src 'self' data:; style-src 'self' 'unsafe-inline'; frame-ancestors 'none'"
+
+// SwaggerHandler serves the embedded OpenAPI spec and Swagger UI. It expects
+// to be mounted at /swagger/.
+func SwaggerHandler() http.Handler {
+	swagger, err := fs.Sub(doc.Swagger, "swagger")
+	if err != nil {
+		panic(err)
+	}
+	fileServer := http.StripPrefix("/swagger/", http.FileServerFS(swagger))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Security-Policy", swaggerContentSecurityPolicy)
+		fileServer.ServeHTTP(w, r)
+	})
+}
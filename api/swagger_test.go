@@ -0,0 +1,58 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/valkyraycho/bank_project/pb"
+)
+
+type swaggerSpec struct {
+	Paths               map[string]map[string]swaggerOperation `json:"paths"`
+	SecurityDefinitions map[string]any                         `json:"securityDefinitions"`
+}
+
+type swaggerOperation struct {
+	OperationID string           `json:"operationId"`
+	Responses   map[string]any   `json:"responses"`
+	Security    []map[string]any `json:"security"`
+}
+
+func TestSwaggerSpecCoversEveryRPC(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	SwaggerHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/swagger/bank_project.swagger.json", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var spec swaggerSpec
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&spec))
+	require.Contains(t, spec.SecurityDefinitions, "bearer")
+
+	operations := map[string]swaggerOperation{}
+	for _, methods := range spec.Paths {
+		for _, operation := range methods {
+			operations[operation.OperationID] = operation
+		}
+	}
+
+	for _, method := range pb.BankService_ServiceDesc.Methods {
+		operation, ok := operations["BankService_"+method.MethodName]
+		require.True(t, ok, "missing OpenAPI operation for %s", method.MethodName)
+		for _, code := range []string{"200", "400", "401", "500"} {
+			require.Contains(t, operation.Responses, code, "%s has no %s response", method.MethodName, code)
+		}
+	}
+	for _, stream := range pb.BankService_ServiceDesc.Streams {
+		require.Contains(t, operations, "BankService_"+stream.StreamName)
+	}
+}
+
+func TestSwaggerUI(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	SwaggerHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/swagger/", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Contains(t, recorder.Body.String(), "swagger-ui-bundle.js")
+	require.Equal(t, swaggerContentSecurityPolicy, recorder.Header().Get("Content-Security-Policy"))
+}
@@ -0,0 +1,75 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/valkyraycho/bank_project/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) RenewAccessToken(ctx context.Context, req *pb.RenewAccessTokenRequest) (*pb.RenewAccessTokenResponse, error) {
+	refreshToken := req.GetRefreshToken()
+	if refreshToken == "" && s.cfg.RefreshTokenCookie {
+		refreshToken = refreshTokenFromCookie(ctx)
+	}
+
+	violations := validateRenewAccessTokenRequest(refreshToken)
+	if len(violations) > 0 {
+		return nil, invalidArgumentsError(violations)
+	}
+
+	refreshPayload, err := s.tokenMaker.VerifyToken(refreshToken)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid refresh token: %s", err)
+	}
+
+	session, err := s.store.GetSession(ctx, refreshPayload.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.Unauthenticated, "session not found: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to find session: %s", err)
+	}
+
+	if session.IsBlocked {
+		return nil, status.Errorf(codes.Unauthenticated, "blocked session")
+	}
+	if session.UserID != refreshPayload.UserID {
+		return nil, status.Errorf(codes.Unauthenticated, "incorrect session user")
+	}
+	if session.RefreshToken != refreshToken {
+		return nil, status.Errorf(codes.Unauthenticated, "mismatched session token")
+	}
+	if time.Now().After(session.ExpiresAt) {
+		return nil, status.Errorf(codes.Unauthenticated, "expired session")
+	}
+
+	auditActor(ctx, refreshPayload.UserID, refreshPayload.Role)
+	auditTarget(ctx, "session", session.ID, nil, nil)
+
+	accessToken, accessTokenPayload, err := s.tokenMaker.CreateToken(refreshPayload.UserID, refreshPayload.Role, s.cfg.AccessTokenDuration)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create access token: %s", err)
+	}
+
+	return &pb.RenewAccessTokenResponse{
+		AccessToken:          accessToken,
+		AccessTokenExpiresAt: timestamppb.New(accessTokenPayload.ExpiredAt),
+	}, nil
+}
+
+func validateRenewAccessTokenRequest(refreshToken string) []*errdetails.BadRequest_FieldViolation {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+
+	if refreshToken == "" {
+		violations = append(violations, fieldViolation("refresh_token", fmt.Errorf("must not be empty")))
+	}
+	return violations
+}
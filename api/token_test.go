@@ -0,0 +1,176 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/utils"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestRenewAccessToken(t *testing.T) {
+	user, _ := randomUser(t)
+	tokenMaker := NewTestServer(t, nil).tokenMaker
+
+	refreshToken, refreshPayload, err := tokenMaker.CreateToken(user.ID, user.Role, time.Hour)
+	require.NoError(t, err)
+
+	session := db.Session{
+		ID:           refreshPayload.ID,
+		UserID:       user.ID,
+		RefreshToken: refreshToken,
+		ExpiresAt:    refreshPayload.ExpiredAt,
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.RenewAccessTokenRequest
+		cookieMode    bool
+		buildContext  func() context.Context
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.RenewAccessTokenResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.RenewAccessTokenRequest{RefreshToken: refreshToken},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(refreshPayload.ID)).Times(1).Return(session, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.RenewAccessTokenResponse, err error) {
+				require.NoError(t, err)
+				require.NotEmpty(t, res.GetAccessToken())
+				payload, err := tokenMaker.VerifyToken(res.GetAccessToken())
+				require.NoError(t, err)
+				require.Equal(t, user.ID, payload.UserID)
+			},
+		},
+		{
+			name:       "CookieMode",
+			req:        &pb.RenewAccessTokenRequest{},
+			cookieMode: true,
+			buildContext: func() context.Context {
+				return metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+					gatewayCookieKey, "csrf_token=abc; refresh_token="+refreshToken,
+				))
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(refreshPayload.ID)).Times(1).Return(session, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.RenewAccessTokenResponse, err error) {
+				require.NoError(t, err)
+				require.NotEmpty(t, res.GetAccessToken())
+			},
+		},
+		{
+			name: "CookieIgnoredWithoutCookieMode",
+			req:  &pb.RenewAccessTokenRequest{},
+			buildContext: func() context.Context {
+				return metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+					gatewayCookieKey, "refresh_token="+refreshToken,
+				))
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.RenewAccessTokenResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "InvalidToken",
+			req:  &pb.RenewAccessTokenRequest{RefreshToken: "invalid"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.RenewAccessTokenResponse, err error) {
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name: "SessionNotFound",
+			req:  &pb.RenewAccessTokenRequest{RefreshToken: refreshToken},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Any()).Times(1).Return(db.Session{}, pgx.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, res *pb.RenewAccessTokenResponse, err error) {
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name: "InternalError",
+			req:  &pb.RenewAccessTokenRequest{RefreshToken: refreshToken},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Any()).Times(1).Return(db.Session{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, res *pb.RenewAccessTokenResponse, err error) {
+				require.Equal(t, codes.Internal, status.Code(err))
+			},
+		},
+		{
+			name: "BlockedSession",
+			req:  &pb.RenewAccessTokenRequest{RefreshToken: refreshToken},
+			buildStubs: func(store *mockdb.MockStore) {
+				blocked := session
+				blocked.IsBlocked = true
+				store.EXPECT().GetSession(gomock.Any(), gomock.Any()).Times(1).Return(blocked, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.RenewAccessTokenResponse, err error) {
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name: "MismatchedToken",
+			req:  &pb.RenewAccessTokenRequest{RefreshToken: refreshToken},
+			buildStubs: func(store *mockdb.MockStore) {
+				mismatched := session
+				mismatched.RefreshToken = utils.RandomString(32)
+				store.EXPECT().GetSession(gomock.Any(), gomock.Any()).Times(1).Return(mismatched, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.RenewAccessTokenResponse, err error) {
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name: "ExpiredSession",
+			req:  &pb.RenewAccessTokenRequest{RefreshToken: refreshToken},
+			buildStubs: func(store *mockdb.MockStore) {
+				expired := session
+				expired.ExpiresAt = time.Now().Add(-time.Minute)
+				store.EXPECT().GetSession(gomock.Any(), gomock.Any()).Times(1).Return(expired, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.RenewAccessTokenResponse, err error) {
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		server.tokenMaker = tokenMaker
+		server.cfg.AccessTokenDuration = time.Minute
+		server.cfg.RefreshTokenCookie = testCase.cookieMode
+
+		ctx := context.Background()
+		if testCase.buildContext != nil {
+			ctx = testCase.buildContext()
+		}
+		res, err := server.RenewAccessToken(ctx, testCase.req)
+		testCase.checkResponse(t, res, err)
+	}
+}
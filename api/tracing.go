@@ -0,0 +1,39 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/valkyraycho/bank_project/utils"
+	"go.opentelemetry.io/otel"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/valkyraycho/bank_project/api")
+
+// GatewayTracing names the HTTP server span started by otelhttp after the
+// matched gateway route. The span travels in the request context, so it is
+// the parent of everything the in-process handler does.
+func GatewayTracing(next runtime.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		route := routeTemplate(r.URL.Path, pathParams)
+		span := trace.SpanFromContext(r.Context())
+		span.SetName(r.Method + " " + route)
+		span.SetAttributes(semconv.HTTPRoute(route))
+		next(w, r, pathParams)
+	}
+}
+
+func hashPassword(ctx context.Context, password string) (string, error) {
+	_, span := tracer.Start(ctx, "bcrypt.HashPassword")
+	defer span.End()
+	return utils.HashPassword(password)
+}
+
+func verifyPassword(ctx context.Context, password, hashedPassword string) error {
+	_, span := tracer.Start(ctx, "bcrypt.VerifyPassword")
+	defer span.End()
+	return utils.VerifyPassword(password, hashedPassword)
+}
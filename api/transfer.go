@@ -2,9 +2,13 @@ package api
 
 import (
 	"context"
+	"errors"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/metrics"
 	"github.com/valkyraycho/bank_project/pb"
 	"github.com/valkyraycho/bank_project/utils"
 	"github.com/valkyraycho/bank_project/validator"
@@ -49,51 +53,125 @@ func (s *Server) CreateTransfer(ctx context.Context, req *pb.CreateTransferReque
 		return nil, status.Errorf(codes.Internal, "failed to retrieve account: %s", err)
 	}
 
+	for _, account := range []db.Account{fromAccount, toAccount} {
+		if account.Status != db.AccountStatusActive {
+			return nil, status.Errorf(codes.FailedPrecondition, "account %d is %s", account.ID, account.Status)
+		}
+	}
+
 	// Check currency match for both accounts
 	if fromAccount.Currency != req.Currency || toAccount.Currency != req.Currency {
 		return nil, status.Errorf(codes.FailedPrecondition, "account currency must match transfer currency %s", req.Currency)
 	}
 
+	// Only the bank posts to its own ledger accounts.
+	toProduct, err := s.store.GetAccountProduct(ctx, toAccount.ProductCode)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve account product: %s", err)
+	}
+	if toProduct.Internal {
+		return nil, status.Errorf(codes.FailedPrecondition, "transfers to internal account %d are not allowed", toAccount.ID)
+	}
+
 	res, err := s.store.TransferTx(ctx, db.TransferTxParams{
 		FromAccountID: req.FromAccountId,
 		ToAccountID:   req.ToAccountId,
 		Amount:        req.Amount,
+		Memo:          req.GetMemo(),
+		Reference:     req.GetReference(),
+		SenderID:      pgtype.Int4{Int32: payload.UserID, Valid: true},
+		Category:      req.GetCategory(),
+		Screener:      s.screener,
 	})
 	if err != nil {
+		var notActive *db.AccountNotActiveError
+		var ruleErr *db.ProductRuleError
+		var limitErr *db.TransferLimitError
+		var screeningErr *db.OwnerScreeningError
+		if errors.As(err, &notActive) || errors.As(err, &ruleErr) || errors.As(err, &limitErr) || errors.As(err, &screeningErr) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == db.UniqueViolation {
+			return nil, status.Errorf(codes.AlreadyExists, "reference %q was already used: %s", req.GetReference(), err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to create account: %s", err)
 	}
 
-	return &pb.CreateTransferResponse{
-		Transfer: &pb.Transfer{
-			FromAccountId: res.Transfer.FromAccountID,
-			ToAccountId:   res.Transfer.ToAccountID,
-			Amount:        res.Transfer.Amount,
-			CreatedAt:     timestamppb.New(res.Transfer.CreatedAt),
-		},
-		FromAccount: &pb.Account{
-			Id:        res.FromAccount.ID,
-			OwnerId:   res.FromAccount.OwnerID,
-			Balance:   res.FromAccount.Balance,
-			Currency:  res.FromAccount.Currency,
-			CreatedAt: timestamppb.New(res.FromAccount.CreatedAt),
-		},
-		ToAccount: &pb.Account{
-			Id:        res.ToAccount.ID,
-			OwnerId:   res.ToAccount.OwnerID,
-			Balance:   res.ToAccount.Balance,
-			Currency:  res.ToAccount.Currency,
-			CreatedAt: timestamppb.New(res.ToAccount.CreatedAt),
-		},
-		FromEntry: &pb.Entry{
-			AccountId: res.FromEntry.AccountID,
-			Amount:    res.FromEntry.Amount,
-			CreatedAt: timestamppb.New(res.FromEntry.CreatedAt),
-		},
-		ToEntry: &pb.Entry{
-			AccountId: res.ToEntry.AccountID,
-			Amount:    res.ToEntry.Amount,
-			CreatedAt: timestamppb.New(res.ToEntry.CreatedAt),
-		},
+	auditTarget(ctx, "transfer", res.Transfer.ID, nil, map[string]any{
+		"from_account_id": res.Transfer.FromAccountID,
+		"to_account_id":   res.Transfer.ToAccountID,
+		"amount":          res.Transfer.Amount,
+		"currency":        req.Currency,
+		"memo":            res.Transfer.Memo,
+		"reference":       res.Transfer.Reference.String,
+		"status":          res.Transfer.Status,
+	})
+
+	switch res.Transfer.Status {
+	case db.TransferStatusBlocked:
+		return nil, status.Errorf(codes.PermissionDenied, "transfer %d was blocked by fraud screening", res.Transfer.ID)
+	case db.TransferStatusHeld:
+		return &pb.CreateTransferResponse{
+			Transfer:    convertTransfer(res.Transfer),
+			FromAccount: convertAccount(res.FromAccount),
+			ToAccount:   convertAccount(res.ToAccount),
+		}, nil
+	}
+
+	metrics.TransfersCreated.WithLabelValues(req.Currency).Inc()
+	metrics.TransferVolume.WithLabelValues(req.Currency).Add(float64(res.Transfer.Amount))
+
+	rsp := &pb.CreateTransferResponse{
+		Transfer:    convertTransfer(res.Transfer),
+		FromAccount: convertAccount(res.FromAccount),
+		ToAccount:   convertAccount(res.ToAccount),
+		FromEntry:   convertEntry(res.FromEntry),
+		ToEntry:     convertEntry(res.ToEntry),
+	}
+	if res.Fee != nil {
+		rsp.Fee = res.Fee.Amount
+	}
+	if res.FeeEntry != nil {
+		rsp.FeeEntry = convertEntry(*res.FeeEntry)
+	}
+	return rsp, nil
+}
+
+func (s *Server) PreviewTransferFee(ctx context.Context, req *pb.PreviewTransferFeeRequest) (*pb.PreviewTransferFeeResponse, error) {
+	payload, err := s.authorizeUser(ctx, []string{utils.CustomerRole})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	violations := validatePreviewTransferFeeRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentsError(violations)
+	}
+
+	fromAccount, err := s.store.GetAccount(ctx, req.GetFromAccountId())
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "account not found: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to retrieve account: %s", err)
+	}
+
+	if payload.UserID != fromAccount.OwnerID {
+		return nil, status.Error(codes.PermissionDenied, "no permission to transfer from this account")
+	}
+
+	if fromAccount.Currency != req.GetCurrency() {
+		return nil, status.Errorf(codes.FailedPrecondition, "account currency must match transfer currency %s", req.GetCurrency())
+	}
+
+	fee, err := s.store.QuoteTransferFee(ctx, fromAccount, req.GetAmount())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to quote transfer fee: %s", err)
+	}
+
+	return &pb.PreviewTransferFeeResponse{
+		Fee:        fee,
+		TotalDebit: int64(req.GetAmount()) + int64(fee),
 	}, nil
 }
 
@@ -112,5 +190,69 @@ func validateCreateTransferRequest(req *pb.CreateTransferRequest) []*errdetails.
 		violations = append(violations, fieldViolation("amount", err))
 	}
 
+	if err := validator.ValidateMemo(req.GetMemo()); err != nil {
+		violations = append(violations, fieldViolation("memo", err))
+	}
+
+	if req.GetReference() != "" {
+		if err := validator.ValidateReference(req.GetReference()); err != nil {
+			violations = append(violations, fieldViolation("reference", err))
+		}
+	}
+
+	if req.GetCategory() != "" {
+		if err := validator.ValidateCategory(req.GetCategory()); err != nil {
+			violations = append(violations, fieldViolation("category", err))
+		}
+	}
+
 	return violations
 }
+
+func validatePreviewTransferFeeRequest(req *pb.PreviewTransferFeeRequest) []*errdetails.BadRequest_FieldViolation {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+
+	if err := validator.ValidateID(req.GetFromAccountId()); err != nil {
+		violations = append(violations, fieldViolation("from_account_id", err))
+	}
+
+	if err := validator.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	if err := validator.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	return violations
+}
+
+var transferStatuses = map[db.TransferStatus]pb.TransferStatus{
+	db.TransferStatusCompleted: pb.TransferStatus_TRANSFER_STATUS_COMPLETED,
+	db.TransferStatusHeld:      pb.TransferStatus_TRANSFER_STATUS_HELD,
+	db.TransferStatusRejected:  pb.TransferStatus_TRANSFER_STATUS_REJECTED,
+	db.TransferStatusBlocked:   pb.TransferStatus_TRANSFER_STATUS_BLOCKED,
+}
+
+func convertTransfer(transfer db.Transfer) *pb.Transfer {
+	return &pb.Transfer{
+		Id:            transfer.ID,
+		FromAccountId: transfer.FromAccountID,
+		ToAccountId:   transfer.ToAccountID,
+		Amount:        transfer.Amount,
+		Memo:          transfer.Memo,
+		Reference:     transfer.Reference.String,
+		Status:        transferStatuses[transfer.Status],
+		CreatedAt:     timestamppb.New(transfer.CreatedAt),
+	}
+}
+
+func convertEntry(entry db.Entry) *pb.Entry {
+	return &pb.Entry{
+		Id:        entry.ID,
+		AccountId: entry.AccountID,
+		Amount:    entry.Amount,
+		Category:  entry.Category.String,
+		CreatedAt: timestamppb.New(entry.CreatedAt),
+	}
+}
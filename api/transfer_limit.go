@@ -0,0 +1,214 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/token"
+	"github.com/valkyraycho/bank_project/utils"
+	"github.com/valkyraycho/bank_project/validator"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) GetTransferLimits(ctx context.Context, req *pb.GetTransferLimitsRequest) (*pb.GetTransferLimitsResponse, error) {
+	payload, err := s.authorizeUser(ctx, utils.SelfAndBanker)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	if err := validator.ValidateID(req.GetAccountId()); err != nil {
+		return nil, invalidArgumentsError([]*errdetails.BadRequest_FieldViolation{fieldViolation("account_id", err)})
+	}
+
+	account, err := s.getTransferLimitsAccount(ctx, payload, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	allowance, err := s.store.GetTransferAllowance(ctx, account)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve transfer limits: %s", err)
+	}
+	return &pb.GetTransferLimitsResponse{Limits: convertTransferAllowance(allowance)}, nil
+}
+
+func (s *Server) LowerTransferLimits(ctx context.Context, req *pb.LowerTransferLimitsRequest) (*pb.LowerTransferLimitsResponse, error) {
+	payload, err := s.authorizeUser(ctx, []string{utils.CustomerRole})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	limits := db.TransferLimits{
+		PerTransfer:   optionalInt4(req.PerTransferLimit),
+		DailyAmount:   optionalInt4(req.DailyAmountLimit),
+		DailyCount:    optionalInt4(req.DailyCountLimit),
+		MonthlyAmount: optionalInt4(req.MonthlyAmountLimit),
+		MonthlyCount:  optionalInt4(req.MonthlyCountLimit),
+	}
+
+	allowance, err := s.setTransferLimits(ctx, payload, req.GetAccountId(), limits, true)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LowerTransferLimitsResponse{Limits: convertTransferAllowance(allowance)}, nil
+}
+
+func (s *Server) RaiseTransferLimits(ctx context.Context, req *pb.RaiseTransferLimitsRequest) (*pb.RaiseTransferLimitsResponse, error) {
+	payload, err := s.authorizeUser(ctx, []string{utils.BankerRole})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	limits := db.TransferLimits{
+		PerTransfer:   optionalInt4(req.PerTransferLimit),
+		DailyAmount:   optionalInt4(req.DailyAmountLimit),
+		DailyCount:    optionalInt4(req.DailyCountLimit),
+		MonthlyAmount: optionalInt4(req.MonthlyAmountLimit),
+		MonthlyCount:  optionalInt4(req.MonthlyCountLimit),
+	}
+
+	allowance, err := s.setTransferLimits(ctx, payload, req.GetAccountId(), limits, false)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RaiseTransferLimitsResponse{Limits: convertTransferAllowance(allowance)}, nil
+}
+
+// getTransferLimitsAccount fetches an account whose limits the caller may
+// see: customers only their own, bankers any.
+func (s *Server) getTransferLimitsAccount(ctx context.Context, payload *token.Payload, accountID int32) (db.Account, error) {
+	account, err := s.store.GetAccount(ctx, accountID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return db.Account{}, status.Errorf(codes.NotFound, "account not found: %s", err)
+		}
+		return db.Account{}, status.Errorf(codes.Internal, "failed to retrieve account: %s", err)
+	}
+
+	if payload.Role != utils.BankerRole && payload.UserID != account.OwnerID {
+		return db.Account{}, status.Error(codes.PermissionDenied, "no permission to access the limits of an account that does not belong to you")
+	}
+	return account, nil
+}
+
+func (s *Server) setTransferLimits(ctx context.Context, payload *token.Payload, accountID int32, limits db.TransferLimits, lower bool) (db.TransferAllowance, error) {
+	violations := validateTransferLimitsChange(accountID, limits)
+	if len(violations) > 0 {
+		return db.TransferAllowance{}, invalidArgumentsError(violations)
+	}
+
+	account, err := s.getTransferLimitsAccount(ctx, payload, accountID)
+	if err != nil {
+		return db.TransferAllowance{}, err
+	}
+
+	result, err := s.store.SetTransferLimitsTx(ctx, db.SetTransferLimitsTxParams{
+		AccountID: account.ID,
+		Limits:    limits,
+		Lower:     lower,
+		UpdatedBy: payload.UserID,
+	})
+	if err != nil {
+		var changeErr *db.TransferLimitChangeError
+		if errors.As(err, &changeErr) {
+			return db.TransferAllowance{}, invalidArgumentsError([]*errdetails.BadRequest_FieldViolation{fieldViolation(changeErr.Limit, err)})
+		}
+		return db.TransferAllowance{}, status.Errorf(codes.Internal, "failed to set transfer limits: %s", err)
+	}
+
+	auditTarget(ctx, "account_limits", account.ID,
+		auditTransferLimitFields(result.Previous),
+		auditTransferLimitFields(result.Allowance.Limits),
+	)
+	return result.Allowance, nil
+}
+
+type namedTransferLimit struct {
+	name  string
+	value pgtype.Int4
+}
+
+func namedTransferLimits(limits db.TransferLimits) []namedTransferLimit {
+	return []namedTransferLimit{
+		{"per_transfer_limit", limits.PerTransfer},
+		{"daily_amount_limit", limits.DailyAmount},
+		{"daily_count_limit", limits.DailyCount},
+		{"monthly_amount_limit", limits.MonthlyAmount},
+		{"monthly_count_limit", limits.MonthlyCount},
+	}
+}
+
+func validateTransferLimitsChange(accountID int32, limits db.TransferLimits) []*errdetails.BadRequest_FieldViolation {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+
+	if err := validator.ValidateID(accountID); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	changed := false
+	for _, limit := range namedTransferLimits(limits) {
+		if !limit.value.Valid {
+			continue
+		}
+		changed = true
+		if err := validator.ValidateAmount(limit.value.Int32); err != nil {
+			violations = append(violations, fieldViolation(limit.name, err))
+		}
+	}
+	if !changed {
+		violations = append(violations, fieldViolation("per_transfer_limit", errors.New("at least one limit is required")))
+	}
+
+	return violations
+}
+
+// auditTransferLimitFields records unlimited limits as nil.
+func auditTransferLimitFields(limits db.TransferLimits) map[string]any {
+	fields := map[string]any{}
+	for _, limit := range namedTransferLimits(limits) {
+		if limit.value.Valid {
+			fields[limit.name] = limit.value.Int32
+		} else {
+			fields[limit.name] = nil
+		}
+	}
+	return fields
+}
+
+func convertTransferLimitWindow(amountLimit, countLimit pgtype.Int4, amountUsed, countUsed int64) *pb.TransferLimitWindow {
+	window := &pb.TransferLimitWindow{
+		AmountUsed: amountUsed,
+		CountUsed:  countUsed,
+	}
+	if amountLimit.Valid {
+		remaining := max(int64(amountLimit.Int32)-amountUsed, 0)
+		window.AmountLimit = &amountLimit.Int32
+		window.AmountRemaining = &remaining
+	}
+	if countLimit.Valid {
+		remaining := max(int64(countLimit.Int32)-countUsed, 0)
+		window.CountLimit = &countLimit.Int32
+		window.CountRemaining = &remaining
+	}
+	return window
+}
+
+func convertTransferAllowance(allowance db.TransferAllowance) *pb.TransferLimits {
+	limits, used := allowance.Limits, allowance.Used
+
+	rsp := &pb.TransferLimits{
+		AccountId: allowance.AccountID,
+		Daily:     convertTransferLimitWindow(limits.DailyAmount, limits.DailyCount, used.DailyAmount, used.DailyCount),
+		Monthly:   convertTransferLimitWindow(limits.MonthlyAmount, limits.MonthlyCount, used.MonthlyAmount, used.MonthlyCount),
+	}
+	if limits.PerTransfer.Valid {
+		rsp.PerTransferLimit = &limits.PerTransfer.Int32
+	}
+	return rsp
+}
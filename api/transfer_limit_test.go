@@ -0,0 +1,421 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/token"
+	"github.com/valkyraycho/bank_project/utils"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func randomTransferAllowance(accountID int32) db.TransferAllowance {
+	return db.TransferAllowance{
+		AccountID: accountID,
+		Limits: db.TransferLimits{
+			PerTransfer: pgtype.Int4{Int32: 5000, Valid: true},
+			DailyAmount: pgtype.Int4{Int32: 10000, Valid: true},
+			DailyCount:  pgtype.Int4{Int32: 5, Valid: true},
+		},
+		Used: db.GetOutgoingTransferTotalsRow{
+			DailyAmount:   12000,
+			DailyCount:    2,
+			MonthlyAmount: 30000,
+			MonthlyCount:  9,
+		},
+	}
+}
+
+func TestGetTransferLimits(t *testing.T) {
+	user, account := randomAccount(t)
+	other, _ := randomUser(t)
+	other.ID = user.ID + 1
+
+	allowance := randomTransferAllowance(account.ID)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.TokenMaker) context.Context
+		checkResponse func(t *testing.T, res *pb.GetTransferLimitsResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+				store.EXPECT().
+					GetTransferAllowance(gomock.Any(), gomock.Eq(account)).
+					Times(1).
+					Return(allowance, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTransferLimitsResponse, err error) {
+				require.NoError(t, err)
+
+				limits := res.GetLimits()
+				require.Equal(t, account.ID, limits.GetAccountId())
+				require.Equal(t, int32(5000), limits.GetPerTransferLimit())
+
+				// Usage above the limit leaves nothing rather than a negative remainder.
+				require.Equal(t, int64(12000), limits.GetDaily().GetAmountUsed())
+				require.Equal(t, int64(0), limits.GetDaily().GetAmountRemaining())
+				require.Equal(t, int64(3), limits.GetDaily().GetCountRemaining())
+
+				require.Nil(t, limits.GetMonthly().AmountLimit)
+				require.Nil(t, limits.GetMonthly().AmountRemaining)
+				require.Equal(t, int64(9), limits.GetMonthly().GetCountUsed())
+			},
+		},
+		{
+			name: "BankerAllowed",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+				store.EXPECT().
+					GetTransferAllowance(gomock.Any(), gomock.Eq(account)).
+					Times(1).
+					Return(allowance, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, other.ID, utils.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTransferLimitsResponse, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "PermissionDenied",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+				store.EXPECT().
+					GetTransferAllowance(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, other.ID, other.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTransferLimitsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "AccountNotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(db.Account{}, pgx.ErrNoRows)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTransferLimitsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		res, err := server.GetTransferLimits(testCase.buildContext(t, server.tokenMaker), &pb.GetTransferLimitsRequest{AccountId: account.ID})
+		testCase.checkResponse(t, res, err)
+	}
+}
+
+func TestLowerTransferLimits(t *testing.T) {
+	user, account := randomAccount(t)
+	other, _ := randomUser(t)
+	other.ID = user.ID + 1
+
+	dailyAmount := int32(2000)
+	negative := int32(-1)
+	allowance := randomTransferAllowance(account.ID)
+
+	testCases := []struct {
+		name          string
+		req           *pb.LowerTransferLimitsRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.TokenMaker) context.Context
+		checkResponse func(t *testing.T, res *pb.LowerTransferLimitsResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.LowerTransferLimitsRequest{AccountId: account.ID, DailyAmountLimit: &dailyAmount},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				lowered := allowance
+				lowered.Limits.DailyAmount = pgtype.Int4{Int32: dailyAmount, Valid: true}
+				store.EXPECT().
+					SetTransferLimitsTx(gomock.Any(), gomock.Eq(db.SetTransferLimitsTxParams{
+						AccountID: account.ID,
+						Limits:    db.TransferLimits{DailyAmount: pgtype.Int4{Int32: dailyAmount, Valid: true}},
+						Lower:     true,
+						UpdatedBy: user.ID,
+					})).
+					Times(1).
+					Return(db.SetTransferLimitsTxResult{Previous: allowance.Limits, Allowance: lowered}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.LowerTransferLimitsResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, dailyAmount, res.GetLimits().GetDaily().GetAmountLimit())
+			},
+		},
+		{
+			name: "LimitRaised",
+			req:  &pb.LowerTransferLimitsRequest{AccountId: account.ID, DailyAmountLimit: &dailyAmount},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+				store.EXPECT().
+					SetTransferLimitsTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.SetTransferLimitsTxResult{}, &db.TransferLimitChangeError{
+						Limit:   "daily_amount_limit",
+						Current: pgtype.Int4{Int32: 1000, Valid: true},
+						Lower:   true,
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.LowerTransferLimitsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+
+				details := st.Details()
+				require.Len(t, details, 1)
+				badRequest, ok := details[0].(*errdetails.BadRequest)
+				require.True(t, ok)
+				require.Equal(t, "daily_amount_limit", badRequest.GetFieldViolations()[0].GetField())
+			},
+		},
+		{
+			name: "PermissionDenied",
+			req:  &pb.LowerTransferLimitsRequest{AccountId: account.ID, DailyAmountLimit: &dailyAmount},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+				store.EXPECT().
+					SetTransferLimitsTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, other.ID, other.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.LowerTransferLimitsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "BankerUnauthenticated",
+			req:  &pb.LowerTransferLimitsRequest{AccountId: account.ID, DailyAmountLimit: &dailyAmount},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SetTransferLimitsTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, other.ID, utils.BankerRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.LowerTransferLimitsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name: "InvalidLimits",
+			req:  &pb.LowerTransferLimitsRequest{AccountId: account.ID, PerTransferLimit: &negative},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.LowerTransferLimitsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "NoLimits",
+			req:  &pb.LowerTransferLimitsRequest{AccountId: account.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.LowerTransferLimitsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		res, err := server.LowerTransferLimits(testCase.buildContext(t, server.tokenMaker), testCase.req)
+		testCase.checkResponse(t, res, err)
+	}
+}
+
+func TestRaiseTransferLimits(t *testing.T) {
+	user, account := randomAccount(t)
+	banker, _ := randomUser(t)
+	banker.ID = user.ID + 1
+	banker.Role = utils.BankerRole
+
+	perTransfer := int32(20000)
+	allowance := randomTransferAllowance(account.ID)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.TokenMaker) context.Context
+		checkResponse func(t *testing.T, res *pb.RaiseTransferLimitsResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				raised := allowance
+				raised.Limits.PerTransfer = pgtype.Int4{Int32: perTransfer, Valid: true}
+				store.EXPECT().
+					SetTransferLimitsTx(gomock.Any(), gomock.Eq(db.SetTransferLimitsTxParams{
+						AccountID: account.ID,
+						Limits:    db.TransferLimits{PerTransfer: pgtype.Int4{Int32: perTransfer, Valid: true}},
+						UpdatedBy: banker.ID,
+					})).
+					Times(1).
+					Return(db.SetTransferLimitsTxResult{Previous: allowance.Limits, Allowance: raised}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.RaiseTransferLimitsResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, perTransfer, res.GetLimits().GetPerTransferLimit())
+			},
+		},
+		{
+			name: "CustomerUnauthenticated",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SetTransferLimitsTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.RaiseTransferLimitsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+				store.EXPECT().
+					SetTransferLimitsTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.SetTransferLimitsTxResult{}, pgx.ErrTxClosed)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.RaiseTransferLimitsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		req := &pb.RaiseTransferLimitsRequest{AccountId: account.ID, PerTransferLimit: &perTransfer}
+		res, err := server.RaiseTransferLimits(testCase.buildContext(t, server.tokenMaker), req)
+		testCase.checkResponse(t, res, err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 	mockdb "github.com/valkyraycho/bank_project/db/mock"
 	db "github.com/valkyraycho/bank_project/db/sqlc"
@@ -14,6 +15,7 @@ import (
 	"github.com/valkyraycho/bank_project/token"
 	"github.com/valkyraycho/bank_project/utils"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -29,6 +31,9 @@ func TestCreateTransfer(t *testing.T) {
 		FromAccountID: fromAccount.ID,
 		ToAccountID:   toAccount.ID,
 		Amount:        amount,
+		Memo:          "March rent",
+		Reference:     pgtype.Text{String: "RENT-03", Valid: true},
+		Status:        db.TransferStatusCompleted,
 		CreatedAt:     time.Now(),
 	}
 
@@ -36,6 +41,7 @@ func TestCreateTransfer(t *testing.T) {
 		ID:        utils.RandomInt(1, 100),
 		AccountID: fromAccount.ID,
 		Amount:    -amount,
+		Category:  pgtype.Text{String: "housing", Valid: true},
 		CreatedAt: time.Now(),
 	}
 
@@ -60,6 +66,9 @@ func TestCreateTransfer(t *testing.T) {
 				ToAccountId:   toAccount.ID,
 				Amount:        amount,
 				Currency:      utils.CAD,
+				Memo:          "March rent",
+				Reference:     "RENT-03",
+				Category:      "housing",
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -72,11 +81,20 @@ func TestCreateTransfer(t *testing.T) {
 					Times(1).
 					Return(toAccount, nil)
 
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(toAccount.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
 				store.EXPECT().
 					TransferTx(gomock.Any(), gomock.Eq(db.TransferTxParams{
 						FromAccountID: fromAccount.ID,
 						ToAccountID:   toAccount.ID,
 						Amount:        amount,
+						Memo:          "March rent",
+						Reference:     "RENT-03",
+						SenderID:      pgtype.Int4{Int32: fromUser.ID, Valid: true},
+						Category:      "housing",
 					})).
 					Times(1).
 					Return(db.TransferTxResult{
@@ -97,6 +115,123 @@ func TestCreateTransfer(t *testing.T) {
 				require.Equal(t, transfer.FromAccountID, createdTransfer.FromAccountId)
 				require.Equal(t, transfer.ToAccountID, createdTransfer.ToAccountId)
 				require.Equal(t, transfer.Amount, createdTransfer.Amount)
+				require.Equal(t, pb.TransferStatus_TRANSFER_STATUS_COMPLETED, createdTransfer.GetStatus())
+				require.Equal(t, "March rent", createdTransfer.Memo)
+				require.Equal(t, "RENT-03", createdTransfer.Reference)
+				require.Equal(t, "housing", res.GetFromEntry().GetCategory())
+			},
+		},
+		{
+			name: "DuplicateReference",
+			req: &pb.CreateTransferRequest{
+				FromAccountId: fromAccount.ID,
+				ToAccountId:   toAccount.ID,
+				Amount:        amount,
+				Currency:      utils.CAD,
+				Reference:     "RENT-03",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
+					Times(1).
+					Return(fromAccount, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).
+					Times(1).
+					Return(toAccount, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(toAccount.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferTxResult{}, db.ErrUniqueViolation)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, fromUser.ID, fromUser.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.AlreadyExists, st.Code())
+			},
+		},
+		{
+			name: "InvalidReferenceAndCategory",
+			req: &pb.CreateTransferRequest{
+				FromAccountId: fromAccount.ID,
+				ToAccountId:   toAccount.ID,
+				Amount:        amount,
+				Currency:      utils.CAD,
+				Reference:     "rent#03",
+				Category:      "Housing!",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, fromUser.ID, fromUser.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+				require.Len(t, st.Details()[0].(*errdetails.BadRequest).GetFieldViolations(), 2)
+			},
+		},
+		{
+			name: "OKWithFee",
+			req: &pb.CreateTransferRequest{
+				FromAccountId: fromAccount.ID,
+				ToAccountId:   toAccount.ID,
+				Amount:        amount,
+				Currency:      utils.CAD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
+					Times(1).
+					Return(fromAccount, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).
+					Times(1).
+					Return(toAccount, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(toAccount.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferTxResult{
+						Transfer:    transfer,
+						FromAccount: fromAccount,
+						ToAccount:   toAccount,
+						FromEntry:   fromEntry,
+						ToEntry:     toEntry,
+						Fee:         &db.Fee{AccountID: fromAccount.ID, Kind: db.FeeKindTransfer, Amount: 25},
+						FeeEntry:    &db.Entry{AccountID: fromAccount.ID, Amount: -25},
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, fromUser.ID, fromUser.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int32(25), res.GetFee())
+				require.Equal(t, int32(-25), res.GetFeeEntry().GetAmount())
+				require.Equal(t, fromAccount.ID, res.GetFeeEntry().GetAccountId())
 			},
 		},
 		{
@@ -183,6 +318,11 @@ func TestCreateTransfer(t *testing.T) {
 					Times(1).
 					Return(toAccount, nil)
 
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(toAccount.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
 				store.EXPECT().
 					TransferTx(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -514,6 +654,330 @@ func TestCreateTransfer(t *testing.T) {
 				require.Equal(t, codes.InvalidArgument, st.Code())
 			},
 		},
+		{
+			name: "ToAccountFrozen",
+			req: &pb.CreateTransferRequest{
+				FromAccountId: fromAccount.ID,
+				ToAccountId:   toAccount.ID,
+				Amount:        amount,
+				Currency:      utils.CAD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				frozenAccount := toAccount
+				frozenAccount.Status = db.AccountStatusFrozen
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
+					Times(1).
+					Return(fromAccount, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).
+					Times(1).
+					Return(frozenAccount, nil)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, fromUser.ID, fromUser.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "ToAccountInternal",
+			req: &pb.CreateTransferRequest{
+				FromAccountId: fromAccount.ID,
+				ToAccountId:   toAccount.ID,
+				Amount:        amount,
+				Currency:      utils.CAD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				internalAccount := toAccount
+				internalAccount.ProductCode = "internal"
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
+					Times(1).
+					Return(fromAccount, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).
+					Times(1).
+					Return(internalAccount, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq("internal")).
+					Times(1).
+					Return(db.AccountProduct{Code: "internal", Internal: true}, nil)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, fromUser.ID, fromUser.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "ProductRuleRefused",
+			req: &pb.CreateTransferRequest{
+				FromAccountId: fromAccount.ID,
+				ToAccountId:   toAccount.ID,
+				Amount:        amount,
+				Currency:      utils.CAD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
+					Times(1).
+					Return(fromAccount, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).
+					Times(1).
+					Return(toAccount, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(toAccount.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferTxResult{}, &db.ProductRuleError{ProductCode: "savings", Rule: db.ErrWithdrawalLimitReached})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, fromUser.ID, fromUser.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "TransferLimitExceeded",
+			req: &pb.CreateTransferRequest{
+				FromAccountId: fromAccount.ID,
+				ToAccountId:   toAccount.ID,
+				Amount:        amount,
+				Currency:      utils.CAD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
+					Times(1).
+					Return(fromAccount, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).
+					Times(1).
+					Return(toAccount, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(toAccount.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferTxResult{}, &db.TransferLimitError{AccountID: fromAccount.ID, Rule: db.ErrDailyAmountLimitExceeded})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, fromUser.ID, fromUser.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "OwnerPendingScreeningReview",
+			req: &pb.CreateTransferRequest{
+				FromAccountId: fromAccount.ID,
+				ToAccountId:   toAccount.ID,
+				Amount:        amount,
+				Currency:      utils.CAD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
+					Times(1).
+					Return(fromAccount, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).
+					Times(1).
+					Return(toAccount, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(toAccount.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferTxResult{}, &db.OwnerScreeningError{AccountID: toAccount.ID, Status: db.ScreeningStatusPendingReview})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, fromUser.ID, fromUser.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "HeldForReview",
+			req: &pb.CreateTransferRequest{
+				FromAccountId: fromAccount.ID,
+				ToAccountId:   toAccount.ID,
+				Amount:        amount,
+				Currency:      utils.CAD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
+					Times(1).
+					Return(fromAccount, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).
+					Times(1).
+					Return(toAccount, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(toAccount.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
+				held := transfer
+				held.Status = db.TransferStatusHeld
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferTxResult{
+						Transfer:    held,
+						FromAccount: fromAccount,
+						ToAccount:   toAccount,
+						Alert:       &db.FraudAlert{ID: 1, TransferID: held.ID, Decision: db.FraudDecisionHold},
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, fromUser.ID, fromUser.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, pb.TransferStatus_TRANSFER_STATUS_HELD, res.GetTransfer().GetStatus())
+				require.Nil(t, res.GetFromEntry())
+				require.Nil(t, res.GetToEntry())
+				require.Equal(t, fromAccount.Balance, res.GetFromAccount().GetBalance())
+			},
+		},
+		{
+			name: "BlockedByScreening",
+			req: &pb.CreateTransferRequest{
+				FromAccountId: fromAccount.ID,
+				ToAccountId:   toAccount.ID,
+				Amount:        amount,
+				Currency:      utils.CAD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
+					Times(1).
+					Return(fromAccount, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).
+					Times(1).
+					Return(toAccount, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(toAccount.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
+				blocked := transfer
+				blocked.Status = db.TransferStatusBlocked
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferTxResult{
+						Transfer: blocked,
+						Alert:    &db.FraudAlert{ID: 1, TransferID: blocked.ID, Decision: db.FraudDecisionBlock},
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, fromUser.ID, fromUser.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "AccountFrozenDuringTransfer",
+			req: &pb.CreateTransferRequest{
+				FromAccountId: fromAccount.ID,
+				ToAccountId:   toAccount.ID,
+				Amount:        amount,
+				Currency:      utils.CAD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
+					Times(1).
+					Return(fromAccount, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).
+					Times(1).
+					Return(toAccount, nil)
+
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq(toAccount.ProductCode)).
+					Times(1).
+					Return(randomAccountProduct(), nil)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferTxResult{}, &db.AccountNotActiveError{AccountID: fromAccount.ID, Status: db.AccountStatusFrozen})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, fromUser.ID, fromUser.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -528,3 +992,156 @@ func TestCreateTransfer(t *testing.T) {
 		testCase.checkResponse(t, res, err)
 	}
 }
+
+func TestPreviewTransferFee(t *testing.T) {
+	user, account := randomAccount(t)
+	otherUser, _ := randomAccount(t)
+
+	testCases := []struct {
+		name          string
+		req           *pb.PreviewTransferFeeRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.TokenMaker) context.Context
+		checkResponse func(t *testing.T, res *pb.PreviewTransferFeeResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.PreviewTransferFeeRequest{
+				FromAccountId: account.ID,
+				Amount:        1000,
+				Currency:      account.Currency,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					QuoteTransferFee(gomock.Any(), gomock.Eq(account), gomock.Eq(int32(1000))).
+					Times(1).
+					Return(int32(26), nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.PreviewTransferFeeResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int32(26), res.GetFee())
+				require.Equal(t, int64(1026), res.GetTotalDebit())
+			},
+		},
+		{
+			name: "InvalidAmount",
+			req: &pb.PreviewTransferFeeRequest{
+				FromAccountId: account.ID,
+				Amount:        -1,
+				Currency:      account.Currency,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.PreviewTransferFeeResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "PermissionDenied",
+			req: &pb.PreviewTransferFeeRequest{
+				FromAccountId: account.ID,
+				Amount:        1000,
+				Currency:      account.Currency,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					QuoteTransferFee(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, otherUser.ID, otherUser.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.PreviewTransferFeeResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "AccountNotFound",
+			req: &pb.PreviewTransferFeeRequest{
+				FromAccountId: account.ID,
+				Amount:        1000,
+				Currency:      account.Currency,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(db.Account{}, pgx.ErrNoRows)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.PreviewTransferFeeResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name: "InternalError",
+			req: &pb.PreviewTransferFeeRequest{
+				FromAccountId: account.ID,
+				Amount:        1000,
+				Currency:      account.Currency,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					QuoteTransferFee(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int32(0), sql.ErrConnDone)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.PreviewTransferFeeResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		res, err := server.PreviewTransferFee(testCase.buildContext(t, server.tokenMaker), testCase.req)
+		testCase.checkResponse(t, res, err)
+	}
+}
@@ -2,12 +2,14 @@ package api
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/metrics"
 	"github.com/valkyraycho/bank_project/pb"
 	"github.com/valkyraycho/bank_project/utils"
 	"github.com/valkyraycho/bank_project/validator"
@@ -24,16 +26,19 @@ func (s *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb
 		return nil, invalidArgumentsError(violations)
 	}
 
-	hashedPassword, err := utils.HashPassword(req.GetPassword())
+	hashedPassword, err := hashPassword(ctx, req.GetPassword())
 
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to hash password: %s", err)
 	}
-	user, err := s.store.CreateUser(ctx, db.CreateUserParams{
-		Username:       req.GetUsername(),
-		Email:          req.GetEmail(),
-		FullName:       req.GetFullName(),
-		HashedPassword: hashedPassword,
+	result, err := s.store.CreateUserTx(ctx, db.CreateUserTxParams{
+		CreateUserParams: db.CreateUserParams{
+			Username:       req.GetUsername(),
+			Email:          req.GetEmail(),
+			FullName:       req.GetFullName(),
+			HashedPassword: hashedPassword,
+		},
+		Screener: s.userScreener(),
 	})
 
 	if err != nil {
@@ -44,6 +49,9 @@ func (s *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb
 		}
 		return nil, status.Errorf(codes.Internal, "failed to create user: %s", err)
 	}
+
+	user := result.User
+	auditTarget(ctx, "user", user.ID, nil, auditUserFields(user))
 	return &pb.CreateUserResponse{User: convertUser(user)}, nil
 }
 
@@ -77,12 +85,15 @@ func (s *Server) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (*pb.L
 	user, err := s.store.GetUser(ctx, req.Username)
 	if err != nil {
 		if err == pgx.ErrNoRows {
+			metrics.FailedLogins.WithLabelValues("user_not_found").Inc()
 			return nil, status.Errorf(codes.NotFound, "user not found: %s", err)
 		}
 		return nil, status.Errorf(codes.Internal, "failed to find user: %s", err)
 	}
+	auditTarget(ctx, "user", user.ID, nil, nil)
 
-	if err := utils.VerifyPassword(req.Password, user.HashedPassword); err != nil {
+	if err := verifyPassword(ctx, req.Password, user.HashedPassword); err != nil {
+		metrics.FailedLogins.WithLabelValues("incorrect_password").Inc()
 		return nil, status.Errorf(codes.NotFound, "incorrect password: %s", err)
 	}
 
@@ -110,6 +121,12 @@ func (s *Server) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (*pb.L
 		return nil, status.Errorf(codes.NotFound, "failed to create session: %s", err)
 	}
 
+	auditActor(ctx, user.ID, user.Role)
+	auditTarget(ctx, "session", session.ID, nil, map[string]any{
+		"user_id":    session.UserID,
+		"expires_at": session.ExpiresAt.UTC().Format(time.RFC3339Nano),
+	})
+
 	return &pb.LoginUserResponse{
 		User:                  convertUser(user),
 		SessionId:             session.ID.String(),
@@ -148,6 +165,14 @@ func (s *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb
 		return nil, status.Error(codes.PermissionDenied, "no permission to update other user's info")
 	}
 
+	before, err := s.store.GetUserByID(ctx, req.GetId())
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "user not found: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to retrieve user: %s", err)
+	}
+
 	args := db.UpdateUserParams{
 		ID: req.GetId(),
 		Username: pgtype.Text{
@@ -165,7 +190,7 @@ func (s *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb
 	}
 
 	if req.Password != nil {
-		hashedPassword, err := utils.HashPassword(req.GetPassword())
+		hashedPassword, err := hashPassword(ctx, req.GetPassword())
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to hash password: %s", err)
 		}
@@ -180,13 +205,19 @@ func (s *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb
 		}
 	}
 
-	user, err := s.store.UpdateUser(ctx, args)
+	result, err := s.store.UpdateUserTx(ctx, db.UpdateUserTxParams{
+		UpdateUserParams: args,
+		Screener:         s.userScreener(),
+	})
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return nil, status.Errorf(codes.NotFound, "user not found: %s", err)
 		}
 		return nil, status.Errorf(codes.Internal, "failed to update user: %s", err)
 	}
+
+	user := result.User
+	auditTarget(ctx, "user", user.ID, auditUserFields(before), auditUserFields(user))
 	return &pb.UpdateUserResponse{User: convertUser(user)}, nil
 }
 
@@ -219,6 +250,59 @@ func validateUpdateUserRequest(req *pb.UpdateUserRequest) []*errdetails.BadReque
 	return violations
 }
 
+// ChangeUserRole never changes the role directly: it asks another banker to
+// approve the change.
+func (s *Server) ChangeUserRole(ctx context.Context, req *pb.ChangeUserRoleRequest) (*pb.ChangeUserRoleResponse, error) {
+	payload, err := s.authorizeUser(ctx, []string{utils.BankerRole})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+	}
+
+	violations := validateChangeUserRoleRequest(req)
+	if len(violations) > 0 {
+		return nil, invalidArgumentsError(violations)
+	}
+
+	user, err := s.store.GetUserByID(ctx, req.GetId())
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "user not found: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to retrieve user: %s", err)
+	}
+
+	if user.Role == req.GetRole() {
+		return nil, status.Errorf(codes.FailedPrecondition, "user is already a %s", user.Role)
+	}
+
+	request, err := s.store.RequestApprovalTx(ctx, db.RequestApprovalTxParams{
+		Action:      db.ApprovalActionChangeUserRole,
+		Payload:     db.ChangeUserRoleApproval{UserID: user.ID, Role: req.GetRole()},
+		Summary:     fmt.Sprintf("change role of %s from %s to %s", user.Username, user.Role, req.GetRole()),
+		RequestedBy: payload.UserID,
+		ExpiresAt:   time.Now().Add(s.cfg.ApprovalTTL),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to request approval: %s", err)
+	}
+
+	auditTarget(ctx, "approval_request", request.ID, nil, auditApprovalFields(request))
+	return &pb.ChangeUserRoleResponse{ApprovalRequest: convertApprovalRequest(request)}, nil
+}
+
+func validateChangeUserRoleRequest(req *pb.ChangeUserRoleRequest) []*errdetails.BadRequest_FieldViolation {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+
+	if err := validator.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	if err := validator.ValidateRole(req.GetRole()); err != nil {
+		violations = append(violations, fieldViolation("role", err))
+	}
+	return violations
+}
+
 func convertUser(user db.User) *pb.User {
 	return &pb.User{
 		Id:                user.ID,
@@ -21,13 +21,13 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-type eqCreateUserParamsMatcher struct {
+type eqCreateUserTxParamsMatcher struct {
 	args     db.CreateUserParams
 	password string
 }
 
-func (matcher eqCreateUserParamsMatcher) Matches(x any) bool {
-	args, ok := x.(db.CreateUserParams)
+func (matcher eqCreateUserTxParamsMatcher) Matches(x any) bool {
+	args, ok := x.(db.CreateUserTxParams)
 	if !ok {
 		return false
 	}
@@ -36,18 +36,18 @@ func (matcher eqCreateUserParamsMatcher) Matches(x any) bool {
 	}
 	matcher.args.HashedPassword = args.HashedPassword
 
-	if !reflect.DeepEqual(matcher.args, args) {
+	if !reflect.DeepEqual(matcher.args, args.CreateUserParams) {
 		return false
 	}
 	return true
 }
 
-func (matcher eqCreateUserParamsMatcher) String() string {
+func (matcher eqCreateUserTxParamsMatcher) String() string {
 	return fmt.Sprintf("matches arg %v and password %v", matcher.args, matcher.password)
 }
 
-func eqCreateUserParams(args db.CreateUserParams, password string) gomock.Matcher {
-	return eqCreateUserParamsMatcher{args: args, password: password}
+func eqCreateUserTxParams(args db.CreateUserParams, password string) gomock.Matcher {
+	return eqCreateUserTxParamsMatcher{args: args, password: password}
 }
 
 func TestCreateUser(t *testing.T) {
@@ -69,13 +69,13 @@ func TestCreateUser(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					CreateUser(gomock.Any(), eqCreateUserParams(db.CreateUserParams{
+					CreateUserTx(gomock.Any(), eqCreateUserTxParams(db.CreateUserParams{
 						Username: user.Username,
 						FullName: user.FullName,
 						Email:    user.Email,
 					}, password)).
 					Times(1).
-					Return(user, nil)
+					Return(db.CreateUserTxResult{User: user}, nil)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreateUserResponse, err error) {
 				require.NoError(t, err)
@@ -97,9 +97,9 @@ func TestCreateUser(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					CreateUser(gomock.Any(), gomock.Any()).
+					CreateUserTx(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(db.User{}, sql.ErrConnDone)
+					Return(db.CreateUserTxResult{}, sql.ErrConnDone)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreateUserResponse, err error) {
 				require.Error(t, err)
@@ -118,9 +118,9 @@ func TestCreateUser(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					CreateUser(gomock.Any(), gomock.Any()).
+					CreateUserTx(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(db.User{}, db.ErrUniqueViolation)
+					Return(db.CreateUserTxResult{}, db.ErrUniqueViolation)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreateUserResponse, err error) {
 				require.Error(t, err)
@@ -139,7 +139,7 @@ func TestCreateUser(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					CreateUser(gomock.Any(), gomock.Any()).
+					CreateUserTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreateUserResponse, err error) {
@@ -159,7 +159,7 @@ func TestCreateUser(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					CreateUser(gomock.Any(), gomock.Any()).
+					CreateUserTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreateUserResponse, err error) {
@@ -179,7 +179,7 @@ func TestCreateUser(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					CreateUser(gomock.Any(), gomock.Any()).
+					CreateUserTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreateUserResponse, err error) {
@@ -199,7 +199,7 @@ func TestCreateUser(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					CreateUser(gomock.Any(), gomock.Any()).
+					CreateUserTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreateUserResponse, err error) {
@@ -430,23 +430,29 @@ func TestUpdateUser(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					UpdateUser(gomock.Any(), gomock.Eq(db.UpdateUserParams{
-						ID: user.ID,
-						Username: pgtype.Text{
-							String: newName,
-							Valid:  true,
-						},
-						Email: pgtype.Text{
-							String: newEmail,
-							Valid:  true,
-						},
-						FullName: pgtype.Text{
-							String: newName,
-							Valid:  true,
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				store.EXPECT().
+					UpdateUserTx(gomock.Any(), gomock.Eq(db.UpdateUserTxParams{
+						UpdateUserParams: db.UpdateUserParams{
+							ID: user.ID,
+							Username: pgtype.Text{
+								String: newName,
+								Valid:  true,
+							},
+							Email: pgtype.Text{
+								String: newEmail,
+								Valid:  true,
+							},
+							FullName: pgtype.Text{
+								String: newName,
+								Valid:  true,
+							},
 						},
 					})).
 					Times(1).
-					Return(db.User{
+					Return(db.UpdateUserTxResult{User: db.User{
 						ID:                user.ID,
 						Username:          newName,
 						HashedPassword:    user.HashedPassword,
@@ -454,7 +460,7 @@ func TestUpdateUser(t *testing.T) {
 						Email:             newEmail,
 						PasswordChangedAt: user.PasswordChangedAt,
 						CreatedAt:         user.CreatedAt,
-					}, nil)
+					}}, nil)
 			},
 			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
 				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
@@ -479,9 +485,13 @@ func TestUpdateUser(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					UpdateUser(gomock.Any(), gomock.Any()).
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
 					Times(1).
-					Return(db.User{}, sql.ErrConnDone)
+					Return(user, nil)
+				store.EXPECT().
+					UpdateUserTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.UpdateUserTxResult{}, sql.ErrConnDone)
 			},
 			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
 				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
@@ -503,9 +513,12 @@ func TestUpdateUser(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					UpdateUser(gomock.Any(), gomock.Any()).
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
 					Times(1).
 					Return(db.User{}, pgx.ErrNoRows)
+				store.EXPECT().
+					UpdateUserTx(gomock.Any(), gomock.Any()).
+					Times(0)
 			},
 			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
 				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
@@ -527,7 +540,7 @@ func TestUpdateUser(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					UpdateUser(gomock.Any(), gomock.Any()).
+					UpdateUserTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
@@ -550,7 +563,7 @@ func TestUpdateUser(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					UpdateUser(gomock.Any(), gomock.Any()).
+					UpdateUserTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
@@ -573,7 +586,7 @@ func TestUpdateUser(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					UpdateUser(gomock.Any(), gomock.Any()).
+					UpdateUserTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
@@ -596,7 +609,7 @@ func TestUpdateUser(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					UpdateUser(gomock.Any(), gomock.Any()).
+					UpdateUserTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
@@ -619,7 +632,7 @@ func TestUpdateUser(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					UpdateUser(gomock.Any(), gomock.Any()).
+					UpdateUserTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
@@ -643,7 +656,7 @@ func TestUpdateUser(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					UpdateUser(gomock.Any(), gomock.Any()).
+					UpdateUserTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
@@ -666,7 +679,7 @@ func TestUpdateUser(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					UpdateUser(gomock.Any(), gomock.Any()).
+					UpdateUserTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
@@ -695,6 +708,153 @@ func TestUpdateUser(t *testing.T) {
 	}
 }
 
+func TestChangeUserRole(t *testing.T) {
+	user, _ := randomUser(t)
+	banker, _ := randomUser(t)
+	banker.Role = utils.BankerRole
+
+	testCases := []struct {
+		name          string
+		req           *pb.ChangeUserRoleRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.TokenMaker) context.Context
+		checkResponse func(t *testing.T, res *pb.ChangeUserRoleResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.ChangeUserRoleRequest{Id: user.ID, Role: utils.BankerRole},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+
+				store.EXPECT().
+					UpdateUserRole(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					RequestApprovalTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, args db.RequestApprovalTxParams) (db.ApprovalRequest, error) {
+						require.Equal(t, db.ApprovalActionChangeUserRole, args.Action)
+						require.Equal(t, db.ChangeUserRoleApproval{UserID: user.ID, Role: utils.BankerRole}, args.Payload)
+						require.Equal(t, banker.ID, args.RequestedBy)
+						return db.ApprovalRequest{
+							ID:          1,
+							Action:      args.Action,
+							Payload:     []byte(fmt.Sprintf(`{"user_id": %d, "role": "banker"}`, user.ID)),
+							Status:      db.ApprovalStatusPending,
+							RequestedBy: args.RequestedBy,
+						}, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ChangeUserRoleResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, pb.ApprovalStatus_APPROVAL_STATUS_PENDING, res.GetApprovalRequest().GetStatus())
+				require.Equal(t, "banker", res.GetApprovalRequest().GetPayload().AsMap()["role"])
+			},
+		},
+		{
+			name: "SameRole",
+			req:  &pb.ChangeUserRoleRequest{Id: user.ID, Role: utils.CustomerRole},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+
+				store.EXPECT().
+					RequestApprovalTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ChangeUserRoleResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "UserNotFound",
+			req:  &pb.ChangeUserRoleRequest{Id: user.ID, Role: utils.BankerRole},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(db.User{}, pgx.ErrNoRows)
+
+				store.EXPECT().
+					RequestApprovalTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ChangeUserRoleResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name: "InvalidRole",
+			req:  &pb.ChangeUserRoleRequest{Id: user.ID, Role: "admin"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.ID, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ChangeUserRoleResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "CustomerNotAllowed",
+			req:  &pb.ChangeUserRoleRequest{Id: user.ID, Role: utils.BankerRole},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.TokenMaker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.ID, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ChangeUserRoleResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		server := NewTestServer(t, store)
+		res, err := server.ChangeUserRole(testCase.buildContext(t, server.tokenMaker), testCase.req)
+		testCase.checkResponse(t, res, err)
+	}
+}
+
 func randomUser(t *testing.T) (db.User, string) {
 	password := utils.RandomString(8)
 	hashedPassword, err := utils.HashPassword(password)
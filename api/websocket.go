@@ -0,0 +1,159 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/telemetry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"nhooyr.io/websocket"
+)
+
+const (
+	webSocketSubprotocol   = "bank.stream.v1"
+	webSocketBearerPrefix  = "bearer."
+	webSocketCloseCodeBase = 4000
+)
+
+var webSocketMarshaler = protojson.MarshalOptions{EmitUnpopulated: true, UseProtoNames: true}
+
+// WebSocketBridge serves every server-streaming BankService RPC at
+// /v1/ws/{method}. The client sends the request as a single JSON message and
+// receives one JSON message per streamed response. Browsers cannot set an
+// Authorization header on WebSockets, so the access token may instead be
+// offered as a "bearer.<token>" subprotocol next to "bank.stream.v1". When the
+// RPC returns, the connection is closed with code 4000 plus the gRPC status
+// code and the status message as reason.
+func (s *Server) WebSocketBridge() http.Handler {
+	return s.webSocketBridge(&pb.BankService_ServiceDesc, s)
+}
+
+func (s *Server) webSocketBridge(desc *grpc.ServiceDesc, srv any) http.Handler {
+	streams := map[string]grpc.StreamDesc{}
+	for _, stream := range desc.Streams {
+		if stream.ServerStreams && !stream.ClientStreams {
+			streams[stream.StreamName] = stream
+		}
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		stream, ok := streams[r.PathValue("method")]
+		if !ok {
+			http.Error(w, "unknown streaming method", http.StatusNotFound)
+			return
+		}
+		fullMethod := fmt.Sprintf("/%s/%s", desc.ServiceName, stream.StreamName)
+
+		authHeader := r.Header.Get(authorizationHeader)
+		if authHeader == "" {
+			authHeader = webSocketBearerToken(r)
+		}
+		if authHeader != "" {
+			if _, err := s.verifyAuthorizationHeader(authHeader); err != nil {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+		}
+
+		conn, err := websocket.Accept(w, r, s.webSocketAcceptOptions())
+		if err != nil {
+			return
+		}
+		defer conn.CloseNow()
+
+		md := metadata.Pairs(userAgentHeader, r.UserAgent())
+		if authHeader != "" {
+			md.Set(authorizationHeader, authHeader)
+		}
+		ctx := metadata.NewIncomingContext(withGatewayClient(r.Context(), r), md)
+		telemetry.UpdateLogger(ctx, func(c zerolog.Context) zerolog.Context {
+			return c.Str("grpc_method", fullMethod)
+		})
+
+		_, request, err := conn.Read(ctx)
+		if err != nil {
+			return
+		}
+		// Nothing but control frames is expected after the request;
+		// CloseRead handles them and cancels ctx once the client goes away.
+		ctx = conn.CloseRead(ctx)
+
+		err = stream.Handler(srv, &webSocketServerStream{ctx: ctx, conn: conn, request: request})
+		st := status.Convert(err)
+		reason := st.Message()
+		if len(reason) > 120 {
+			reason = reason[:120]
+		}
+		conn.Close(websocket.StatusCode(webSocketCloseCodeBase+int(st.Code())), reason)
+	})
+}
+
+func (s *Server) webSocketAcceptOptions() *websocket.AcceptOptions {
+	options := &websocket.AcceptOptions{Subprotocols: []string{webSocketSubprotocol}}
+	if s.cors.allowAnyOrigin {
+		options.InsecureSkipVerify = true
+		return options
+	}
+	for origin := range s.cors.allowedOrigins {
+		if u, err := url.Parse(origin); err == nil && u.Host != "" {
+			options.OriginPatterns = append(options.OriginPatterns, u.Host)
+		}
+	}
+	return options
+}
+
+func webSocketBearerToken(r *http.Request) string {
+	for _, protocol := range strings.Split(r.Header.Get("Sec-WebSocket-Protocol"), ",") {
+		if token, ok := strings.CutPrefix(strings.TrimSpace(protocol), webSocketBearerPrefix); ok {
+			return authorizationBearer + " " + token
+		}
+	}
+	return ""
+}
+
+// webSocketServerStream lets a generated stream handler talk to a WebSocket
+// connection. It yields exactly one request message, read from the first
+// WebSocket message.
+type webSocketServerStream struct {
+	ctx      context.Context
+	conn     *websocket.Conn
+	request  []byte
+	received bool
+}
+
+func (stream *webSocketServerStream) Context() context.Context { return stream.ctx }
+
+func (stream *webSocketServerStream) SetHeader(metadata.MD) error  { return nil }
+func (stream *webSocketServerStream) SendHeader(metadata.MD) error { return nil }
+func (stream *webSocketServerStream) SetTrailer(metadata.MD)       {}
+
+func (stream *webSocketServerStream) SendMsg(m any) error {
+	data, err := webSocketMarshaler.Marshal(m.(proto.Message))
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to marshal message: %s", err)
+	}
+	return stream.conn.Write(stream.ctx, websocket.MessageText, data)
+}
+
+func (stream *webSocketServerStream) RecvMsg(m any) error {
+	if stream.received {
+		return io.EOF
+	}
+	stream.received = true
+
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(stream.request, m.(proto.Message)); err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to unmarshal request: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/valkyraycho/bank_project/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"nhooyr.io/websocket"
+)
+
+func newWebSocketTestServer(t *testing.T, server *Server, desc *grpc.ServiceDesc, srv any) string {
+	mux := http.NewServeMux()
+	mux.Handle("GET /v1/ws/{method}", server.webSocketBridge(desc, srv))
+	httpServer := httptest.NewServer(mux)
+	t.Cleanup(httpServer.Close)
+	return "ws" + strings.TrimPrefix(httpServer.URL, "http") + "/v1/ws/"
+}
+
+func TestWebSocketBridgeHealthWatch(t *testing.T) {
+	server := NewTestServer(t, nil)
+	healthServer := health.NewServer()
+	url := newWebSocketTestServer(t, server, &healthpb.Health_ServiceDesc, healthServer)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, res, err := websocket.Dial(ctx, url+"Watch", &websocket.DialOptions{Subprotocols: []string{webSocketSubprotocol}})
+	require.NoError(t, err)
+	defer conn.CloseNow()
+	require.Equal(t, webSocketSubprotocol, res.Header.Get("Sec-WebSocket-Protocol"))
+
+	require.NoError(t, conn.Write(ctx, websocket.MessageText, []byte(`{"service":""}`)))
+
+	readStatus := func() healthpb.HealthCheckResponse_ServingStatus {
+		_, data, err := conn.Read(ctx)
+		require.NoError(t, err)
+		var msg healthpb.HealthCheckResponse
+		require.NoError(t, protojson.Unmarshal(data, &msg))
+		return msg.GetStatus()
+	}
+
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, readStatus())
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, readStatus())
+}
+
+func TestWebSocketBridge(t *testing.T) {
+	server := NewTestServer(t, nil)
+	accessToken, _, err := server.tokenMaker.CreateToken(1, utils.CustomerRole, time.Minute)
+	require.NoError(t, err)
+
+	desc := &grpc.ServiceDesc{
+		ServiceName: "test.Streams",
+		Streams: []grpc.StreamDesc{
+			{
+				StreamName:    "Whoami",
+				ServerStreams: true,
+				Handler: func(srv any, stream grpc.ServerStream) error {
+					if _, err := srv.(*Server).authorizeUser(stream.Context(), []string{utils.CustomerRole}); err != nil {
+						return status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+					}
+					var req healthpb.HealthCheckRequest
+					if err := stream.RecvMsg(&req); err != nil {
+						return err
+					}
+					return stream.SendMsg(&healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING})
+				},
+			},
+			{
+				StreamName:    "Upload",
+				ClientStreams: true,
+				Handler:       func(srv any, stream grpc.ServerStream) error { return nil },
+			},
+		},
+	}
+	url := newWebSocketTestServer(t, server, desc, server)
+
+	testCases := []struct {
+		name          string
+		method        string
+		subprotocols  []string
+		header        http.Header
+		checkResponse func(t *testing.T, conn *websocket.Conn, res *http.Response, err error)
+	}{
+		{
+			name:         "BearerSubprotocol",
+			method:       "Whoami",
+			subprotocols: []string{webSocketSubprotocol, webSocketBearerPrefix + accessToken},
+			checkResponse: func(t *testing.T, conn *websocket.Conn, res *http.Response, err error) {
+				require.NoError(t, err)
+				require.Equal(t, webSocketSubprotocol, res.Header.Get("Sec-WebSocket-Protocol"))
+				checkWebSocketStream(t, conn, codes.OK)
+			},
+		},
+		{
+			name:   "AuthorizationHeader",
+			method: "Whoami",
+			header: http.Header{"Authorization": []string{"Bearer " + accessToken}},
+			checkResponse: func(t *testing.T, conn *websocket.Conn, res *http.Response, err error) {
+				require.NoError(t, err)
+				checkWebSocketStream(t, conn, codes.OK)
+			},
+		},
+		{
+			name:   "NoAuthorization",
+			method: "Whoami",
+			checkResponse: func(t *testing.T, conn *websocket.Conn, res *http.Response, err error) {
+				require.NoError(t, err)
+				checkWebSocketStream(t, conn, codes.Unauthenticated)
+			},
+		},
+		{
+			name:         "InvalidToken",
+			method:       "Whoami",
+			subprotocols: []string{webSocketSubprotocol, webSocketBearerPrefix + "invalid"},
+			checkResponse: func(t *testing.T, conn *websocket.Conn, res *http.Response, err error) {
+				require.Error(t, err)
+				require.Equal(t, http.StatusUnauthorized, res.StatusCode)
+			},
+		},
+		{
+			name:   "ClientStreamingMethod",
+			method: "Upload",
+			checkResponse: func(t *testing.T, conn *websocket.Conn, res *http.Response, err error) {
+				require.Error(t, err)
+				require.Equal(t, http.StatusNotFound, res.StatusCode)
+			},
+		},
+		{
+			name:   "UnknownMethod",
+			method: "Unknown",
+			checkResponse: func(t *testing.T, conn *websocket.Conn, res *http.Response, err error) {
+				require.Error(t, err)
+				require.Equal(t, http.StatusNotFound, res.StatusCode)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		conn, res, err := websocket.Dial(ctx, url+testCase.method, &websocket.DialOptions{
+			Subprotocols: testCase.subprotocols,
+			HTTPHeader:   testCase.header,
+		})
+		testCase.checkResponse(t, conn, res, err)
+		if conn != nil {
+			conn.CloseNow()
+		}
+		cancel()
+	}
+}
+
+func checkWebSocketStream(t *testing.T, conn *websocket.Conn, code codes.Code) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	require.NoError(t, conn.Write(ctx, websocket.MessageText, []byte(`{"service":"bank"}`)))
+	if code == codes.OK {
+		_, data, err := conn.Read(ctx)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"status":"SERVING"}`, string(data))
+	}
+
+	_, _, err := conn.Read(ctx)
+	var closeErr websocket.CloseError
+	require.True(t, errors.As(err, &closeErr), err)
+	require.Equal(t, websocket.StatusCode(webSocketCloseCodeBase+int(code)), closeErr.Code)
+}
@@ -0,0 +1,72 @@
+package approvals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/telemetry"
+)
+
+const batchSize = 100
+
+// Expirer expires approval requests nobody decided on in time and cancels
+// their actions. Expiring is guarded by the request status.
+type Expirer struct {
+	store db.Store
+	now   func() time.Time
+}
+
+func NewExpirer(store db.Store) *Expirer {
+	return &Expirer{
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// ExpireDue expires every pending request past its deadline and returns how
+// many it expired. Requests decided while the run was going on are skipped.
+func (expirer *Expirer) ExpireDue(ctx context.Context) (int, error) {
+	expired := 0
+	for {
+		ids, err := expirer.store.ListExpiredApprovalRequests(ctx, db.ListExpiredApprovalRequestsParams{
+			Now:       expirer.now(),
+			BatchSize: batchSize,
+		})
+		if err != nil {
+			return expired, fmt.Errorf("cannot list expired approval requests: %w", err)
+		}
+
+		for _, id := range ids {
+			_, err := expirer.store.ExpireApprovalTx(ctx, id)
+			switch {
+			case err == nil:
+				expired++
+			case errors.Is(err, db.ErrApprovalNotPending), errors.Is(err, db.ErrApprovalNotExpired):
+			default:
+				return expired, fmt.Errorf("cannot expire approval request %d: %w", id, err)
+			}
+		}
+
+		if len(ids) < batchSize {
+			return expired, nil
+		}
+	}
+}
+
+// RunOnce expires due requests.
+func (expirer *Expirer) RunOnce(ctx context.Context) {
+	logger := telemetry.Logger(ctx)
+
+	expired, err := expirer.ExpireDue(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("approval expiry run failed")
+		return
+	}
+
+	if expired > 0 {
+		logger.Info().Int("expired", expired).Msg("approval expiry run")
+	}
+}
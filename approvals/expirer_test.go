@@ -0,0 +1,92 @@
+package approvals
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestExpirer(t *testing.T, now time.Time) (*Expirer, *mockdb.MockStore) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	expirer := NewExpirer(store)
+	expirer.now = func() time.Time { return now }
+	return expirer, store
+}
+
+func TestExpireDue(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	expirer, store := newTestExpirer(t, now)
+
+	store.EXPECT().
+		ListExpiredApprovalRequests(gomock.Any(), gomock.Eq(db.ListExpiredApprovalRequestsParams{Now: now, BatchSize: batchSize})).
+		Return([]int64{1, 2, 3}, nil)
+
+	store.EXPECT().
+		ExpireApprovalTx(gomock.Any(), gomock.Eq(int64(1))).
+		Return(db.ApprovalRequest{ID: 1, Status: db.ApprovalStatusExpired}, nil)
+	store.EXPECT().
+		ExpireApprovalTx(gomock.Any(), gomock.Eq(int64(2))).
+		Return(db.ApprovalRequest{}, db.ErrApprovalNotPending)
+	store.EXPECT().
+		ExpireApprovalTx(gomock.Any(), gomock.Eq(int64(3))).
+		Return(db.ApprovalRequest{ID: 3, Status: db.ApprovalStatusExpired}, nil)
+
+	expired, err := expirer.ExpireDue(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, expired)
+}
+
+func TestExpireDueFetchesFullBatchesAgain(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	expirer, store := newTestExpirer(t, now)
+
+	batch := make([]int64, batchSize)
+	for i := range batch {
+		batch[i] = int64(i + 1)
+	}
+
+	gomock.InOrder(
+		store.EXPECT().
+			ListExpiredApprovalRequests(gomock.Any(), gomock.Any()).
+			Return(batch, nil),
+		store.EXPECT().
+			ListExpiredApprovalRequests(gomock.Any(), gomock.Any()).
+			Return([]int64{}, nil),
+	)
+	store.EXPECT().
+		ExpireApprovalTx(gomock.Any(), gomock.Any()).
+		Times(batchSize).
+		Return(db.ApprovalRequest{}, nil)
+
+	expired, err := expirer.ExpireDue(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, batchSize, expired)
+}
+
+func TestExpireDueStopsOnStoreError(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	expirer, store := newTestExpirer(t, now)
+
+	store.EXPECT().
+		ListExpiredApprovalRequests(gomock.Any(), gomock.Any()).
+		Return([]int64{1, 2}, nil)
+
+	store.EXPECT().
+		ExpireApprovalTx(gomock.Any(), gomock.Eq(int64(1))).
+		Return(db.ApprovalRequest{}, sql.ErrConnDone)
+	store.EXPECT().
+		ExpireApprovalTx(gomock.Any(), gomock.Eq(int64(2))).
+		Times(0)
+
+	expired, err := expirer.ExpireDue(context.Background())
+	require.ErrorIs(t, err, sql.ErrConnDone)
+	require.Zero(t, expired)
+}
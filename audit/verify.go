@@ -0,0 +1,67 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+)
+
+const verifyBatchSize = 500
+
+// Break is an audit log entry that doesn't chain to the entry before it or
+// whose contents don't match its hash.
+type Break struct {
+	ID     int64
+	Reason string
+}
+
+type Report struct {
+	Checked int
+	Breaks  []Break
+}
+
+func (report Report) Intact() bool {
+	return len(report.Breaks) == 0
+}
+
+// Verify walks the audit log in order and recomputes the hash chain. Any
+// entry changed, removed or inserted after the fact breaks the chain from
+// that entry on.
+func Verify(ctx context.Context, querier db.Querier) (Report, error) {
+	var report Report
+
+	prevHash := db.AuditLogGenesisHash
+	afterID := int64(0)
+	for {
+		entries, err := querier.ListAuditLogChain(ctx, db.ListAuditLogChainParams{
+			AfterID:   afterID,
+			BatchSize: verifyBatchSize,
+		})
+		if err != nil {
+			return report, fmt.Errorf("cannot list audit log entries: %w", err)
+		}
+
+		for _, entry := range entries {
+			report.Checked++
+
+			if entry.PrevHash != prevHash {
+				report.Breaks = append(report.Breaks, Break{ID: entry.ID, Reason: "prev_hash does not match the previous entry"})
+			}
+
+			hash, err := entry.ComputeHash()
+			if err != nil {
+				report.Breaks = append(report.Breaks, Break{ID: entry.ID, Reason: fmt.Sprintf("cannot hash entry: %s", err)})
+			} else if hash != entry.Hash {
+				report.Breaks = append(report.Breaks, Break{ID: entry.ID, Reason: "hash does not match the contents of the entry"})
+			}
+
+			prevHash = entry.Hash
+			afterID = entry.ID
+		}
+
+		if len(entries) < verifyBatchSize {
+			return report, nil
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"go.uber.org/mock/gomock"
+)
+
+// chain builds n correctly chained entries.
+func chain(t *testing.T, n int) []db.AuditLog {
+	entries := []db.AuditLog{}
+	prevHash := db.AuditLogGenesisHash
+	for i := 1; i <= n; i++ {
+		entry := db.AuditLog{
+			ID:         int64(i),
+			ActorID:    pgtype.Int4{Int32: 7, Valid: true},
+			ActorRole:  "banker",
+			Method:     "/pb.BankService/FreezeAccount",
+			TargetType: "account",
+			TargetID:   "42",
+			Before:     []byte(`{"status": "active"}`),
+			After:      []byte(`{"status": "frozen"}`),
+			StatusCode: "OK",
+			PrevHash:   prevHash,
+			CreatedAt:  time.Date(2024, 3, 1, 12, 0, i, 0, time.UTC),
+		}
+
+		var err error
+		entry.Hash, err = entry.ComputeHash()
+		require.NoError(t, err)
+
+		entries = append(entries, entry)
+		prevHash = entry.Hash
+	}
+	return entries
+}
+
+func TestVerify(t *testing.T) {
+	testCases := []struct {
+		name          string
+		entries       func(t *testing.T) []db.AuditLog
+		checkResponse func(t *testing.T, report Report)
+	}{
+		{
+			name:    "Intact",
+			entries: func(t *testing.T) []db.AuditLog { return chain(t, 3) },
+			checkResponse: func(t *testing.T, report Report) {
+				require.True(t, report.Intact())
+				require.Equal(t, 3, report.Checked)
+			},
+		},
+		{
+			name: "ContentChanged",
+			entries: func(t *testing.T) []db.AuditLog {
+				entries := chain(t, 3)
+				entries[1].After = []byte(`{"status": "closed"}`)
+				return entries
+			},
+			checkResponse: func(t *testing.T, report Report) {
+				require.Equal(t, []Break{{ID: 2, Reason: "hash does not match the contents of the entry"}}, report.Breaks)
+			},
+		},
+		{
+			name: "EntryRemoved",
+			entries: func(t *testing.T) []db.AuditLog {
+				entries := chain(t, 3)
+				return append(entries[:1], entries[2:]...)
+			},
+			checkResponse: func(t *testing.T, report Report) {
+				require.Equal(t, []Break{{ID: 3, Reason: "prev_hash does not match the previous entry"}}, report.Breaks)
+			},
+		},
+		{
+			name: "JSONReformatted",
+			entries: func(t *testing.T) []db.AuditLog {
+				entries := chain(t, 2)
+				entries[0].Before = []byte(`{ "status":"active" }`)
+				return entries
+			},
+			checkResponse: func(t *testing.T, report Report) {
+				require.True(t, report.Intact())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		store.EXPECT().
+			ListAuditLogChain(gomock.Any(), gomock.Eq(db.ListAuditLogChainParams{AfterID: 0, BatchSize: verifyBatchSize})).
+			Times(1).
+			Return(testCase.entries(t), nil)
+
+		report, err := Verify(context.Background(), store)
+		require.NoError(t, err)
+		testCase.checkResponse(t, report)
+	}
+}
+
+func TestVerifyStoreError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		ListAuditLogChain(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(nil, sql.ErrConnDone)
+
+	_, err := Verify(context.Background(), store)
+	require.ErrorIs(t, err, sql.ErrConnDone)
+}
@@ -0,0 +1,50 @@
+// Command backfill-fees charges the maintenance fees of every month that has
+// ended since a given month, for months the fee engine missed.
+//
+//	go run ./cmd/backfill-fees -from 2024-01
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/fees"
+	"github.com/valkyraycho/bank_project/utils"
+)
+
+func main() {
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+
+	from := flag.String("from", "", "first month to charge, YYYY-MM")
+	flag.Parse()
+
+	fromMonth, err := time.Parse("2006-01", *from)
+	if err != nil {
+		log.Fatal().Msgf("invalid -from: %s", err)
+	}
+
+	cfg, err := utils.LoadConfig(".")
+	if err != nil {
+		log.Fatal().Msgf("cannot load environment variables: %s", err)
+	}
+
+	ctx := context.Background()
+	connPool, err := pgxpool.New(ctx, cfg.DBSource)
+	if err != nil {
+		log.Fatal().Msgf("cannot connect to db: %s", err)
+	}
+	defer connPool.Close()
+
+	engine := fees.NewEngine(db.NewStore(connPool))
+	charged, err := engine.Backfill(ctx, fromMonth)
+	if err != nil {
+		log.Fatal().Int("charged", charged).Msgf("backfill failed: %s", err)
+	}
+	log.Info().Int("charged", charged).Msg("backfill finished")
+}
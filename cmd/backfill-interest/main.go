@@ -0,0 +1,56 @@
+// Command backfill-interest accrues interest for days the interest engine
+// missed and posts every month that has ended since.
+//
+//	go run ./cmd/backfill-interest -from 2024-01-01 -to 2024-01-31
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/interest"
+	"github.com/valkyraycho/bank_project/utils"
+)
+
+func main() {
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+
+	yesterday := time.Now().UTC().AddDate(0, 0, -1).Format(time.DateOnly)
+	from := flag.String("from", "", "first day to accrue, YYYY-MM-DD")
+	to := flag.String("to", yesterday, "last day to accrue, YYYY-MM-DD")
+	flag.Parse()
+
+	fromDay, err := time.Parse(time.DateOnly, *from)
+	if err != nil {
+		log.Fatal().Msgf("invalid -from: %s", err)
+	}
+	toDay, err := time.Parse(time.DateOnly, *to)
+	if err != nil {
+		log.Fatal().Msgf("invalid -to: %s", err)
+	}
+
+	cfg, err := utils.LoadConfig(".")
+	if err != nil {
+		log.Fatal().Msgf("cannot load environment variables: %s", err)
+	}
+
+	ctx := context.Background()
+	connPool, err := pgxpool.New(ctx, cfg.DBSource)
+	if err != nil {
+		log.Fatal().Msgf("cannot connect to db: %s", err)
+	}
+	defer connPool.Close()
+
+	engine := interest.NewEngine(db.NewStore(connPool))
+	accrued, posted, err := engine.Backfill(ctx, fromDay, toDay)
+	if err != nil {
+		log.Fatal().Int("accrued", accrued).Int("posted", posted).Msgf("backfill failed: %s", err)
+	}
+	log.Info().Int("accrued", accrued).Int("posted", posted).Msg("backfill finished")
+}
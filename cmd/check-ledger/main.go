@@ -0,0 +1,63 @@
+// Command check-ledger verifies the double-entry invariants of the books:
+// every account balance equals the sum of its entries and every journal sums
+// to zero per currency. It exits with status 1 when a check fails.
+//
+//	go run ./cmd/check-ledger
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/ledger"
+	"github.com/valkyraycho/bank_project/utils"
+)
+
+func main() {
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+
+	cfg, err := utils.LoadConfig(".")
+	if err != nil {
+		log.Fatal().Msgf("cannot load environment variables: %s", err)
+	}
+
+	ctx := context.Background()
+	connPool, err := pgxpool.New(ctx, cfg.DBSource)
+	if err != nil {
+		log.Fatal().Msgf("cannot connect to db: %s", err)
+	}
+	defer connPool.Close()
+
+	report, err := ledger.Check(ctx, db.New(connPool))
+	if err != nil {
+		log.Fatal().Msgf("ledger check failed: %s", err)
+	}
+
+	for _, mismatch := range report.AccountMismatches {
+		log.Error().
+			Int32("account_id", mismatch.AccountID).
+			Str("currency", mismatch.Currency).
+			Int32("balance", mismatch.Balance).
+			Int64("entries_total", mismatch.EntriesTotal).
+			Msg("account balance does not match its entries")
+	}
+	for _, journal := range report.UnbalancedJournals {
+		log.Error().
+			Int64("journal_id", journal.JournalID).
+			Str("currency", journal.Currency).
+			Int64("total", journal.Total).
+			Msg("journal does not balance")
+	}
+
+	if !report.Balanced() {
+		log.Fatal().
+			Int("account_mismatches", len(report.AccountMismatches)).
+			Int("unbalanced_journals", len(report.UnbalancedJournals)).
+			Msg("ledger is not balanced")
+	}
+	log.Info().Msg("ledger is balanced")
+}
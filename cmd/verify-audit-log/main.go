@@ -0,0 +1,51 @@
+// Command verify-audit-log recomputes the hash chain of the audit log and
+// reports every entry that was changed, removed or inserted after the fact.
+// It exits with status 1 when the chain is broken.
+//
+//	go run ./cmd/verify-audit-log
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/valkyraycho/bank_project/audit"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/utils"
+)
+
+func main() {
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+
+	cfg, err := utils.LoadConfig(".")
+	if err != nil {
+		log.Fatal().Msgf("cannot load environment variables: %s", err)
+	}
+
+	ctx := context.Background()
+	connPool, err := pgxpool.New(ctx, cfg.DBSource)
+	if err != nil {
+		log.Fatal().Msgf("cannot connect to db: %s", err)
+	}
+	defer connPool.Close()
+
+	report, err := audit.Verify(ctx, db.New(connPool))
+	if err != nil {
+		log.Fatal().Msgf("audit log verification failed: %s", err)
+	}
+
+	for _, brk := range report.Breaks {
+		log.Error().Int64("entry_id", brk.ID).Msg(brk.Reason)
+	}
+
+	if !report.Intact() {
+		log.Fatal().
+			Int("checked", report.Checked).
+			Int("breaks", len(report.Breaks)).
+			Msg("audit log chain is broken")
+	}
+	log.Info().Int("checked", report.Checked).Msg("audit log chain is intact")
+}
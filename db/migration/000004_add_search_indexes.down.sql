@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS "users_created_at_id_idx";
+DROP INDEX IF EXISTS "users_lower_idx";
+DROP INDEX IF EXISTS "users_username_idx";
+DROP INDEX IF EXISTS "accounts_created_at_id_idx";
+DROP INDEX IF EXISTS "accounts_balance_id_idx";
+DROP INDEX IF EXISTS "accounts_currency_id_idx";
\ No newline at end of file
@@ -0,0 +1,11 @@
+CREATE INDEX ON "accounts" ("currency", "id");
+
+CREATE INDEX ON "accounts" ("balance", "id");
+
+CREATE INDEX ON "accounts" ("created_at", "id");
+
+CREATE INDEX ON "users" ("username" varchar_pattern_ops);
+
+CREATE INDEX ON "users" (lower("email") varchar_pattern_ops);
+
+CREATE INDEX ON "users" ("created_at", "id");
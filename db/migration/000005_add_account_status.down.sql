@@ -0,0 +1,7 @@
+ALTER TABLE "accounts"
+  DROP COLUMN IF EXISTS "status_changed_at",
+  DROP COLUMN IF EXISTS "status_changed_by",
+  DROP COLUMN IF EXISTS "status_reason",
+  DROP COLUMN IF EXISTS "status";
+
+DROP TYPE IF EXISTS "account_status";
\ No newline at end of file
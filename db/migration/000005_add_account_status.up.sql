@@ -0,0 +1,15 @@
+CREATE TYPE "account_status" AS ENUM (
+  'active',
+  'frozen',
+  'closed'
+);
+
+ALTER TABLE "accounts"
+  ADD COLUMN "status" account_status NOT NULL DEFAULT 'active',
+  ADD COLUMN "status_reason" varchar NOT NULL DEFAULT '',
+  ADD COLUMN "status_changed_by" int,
+  ADD COLUMN "status_changed_at" timestamptz;
+
+ALTER TABLE "accounts" ADD FOREIGN KEY ("status_changed_by") REFERENCES "users" ("id");
+
+CREATE INDEX ON "accounts" ("status");
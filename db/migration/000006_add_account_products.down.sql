@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS "transfers_from_account_id_created_at_idx";
+
+DROP INDEX IF EXISTS "accounts_owner_product_currency_key";
+
+CREATE UNIQUE INDEX ON "accounts" ("owner_id", "currency");
+
+ALTER TABLE "accounts" DROP COLUMN IF EXISTS "product_code";
+
+DROP TABLE IF EXISTS "account_products";
+
+DROP TYPE IF EXISTS "account_type";
\ No newline at end of file
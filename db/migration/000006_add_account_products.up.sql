@@ -0,0 +1,37 @@
+CREATE TYPE "account_type" AS ENUM (
+  'checking',
+  'savings',
+  'business'
+);
+
+CREATE TABLE "account_products" (
+  "code" varchar PRIMARY KEY,
+  "name" varchar NOT NULL,
+  "type" account_type NOT NULL,
+  "allowed_currencies" varchar[] NOT NULL,
+  "min_balance" int NOT NULL DEFAULT 0,
+  "overdraft_limit" int NOT NULL DEFAULT 0,
+  "monthly_withdrawal_limit" int,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON COLUMN "account_products"."overdraft_limit" IS 'how far below zero the balance may go, 0 when the product is not eligible for overdraft';
+
+COMMENT ON COLUMN "account_products"."monthly_withdrawal_limit" IS 'outgoing transfers allowed per calendar month, NULL for unlimited';
+
+INSERT INTO "account_products" ("code", "name", "type", "allowed_currencies", "min_balance", "overdraft_limit", "monthly_withdrawal_limit") VALUES
+  ('checking', 'Everyday Checking', 'checking', '{USD,EUR,CAD}', 0, 500, NULL),
+  ('savings', 'High Yield Savings', 'savings', '{USD,EUR,CAD}', 0, 0, 6),
+  ('business', 'Business Checking', 'business', '{USD,EUR,CAD}', 0, 10000, NULL);
+
+ALTER TABLE "accounts" ADD COLUMN "product_code" varchar NOT NULL DEFAULT 'checking';
+
+ALTER TABLE "accounts" ALTER COLUMN "product_code" DROP DEFAULT;
+
+ALTER TABLE "accounts" ADD FOREIGN KEY ("product_code") REFERENCES "account_products" ("code");
+
+DROP INDEX IF EXISTS "accounts_owner_id_currency_idx";
+
+CREATE UNIQUE INDEX "accounts_owner_product_currency_key" ON "accounts" ("owner_id", "product_code", "currency") WHERE "status" <> 'closed';
+
+CREATE INDEX ON "transfers" ("from_account_id", "created_at");
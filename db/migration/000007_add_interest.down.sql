@@ -0,0 +1,30 @@
+DROP INDEX IF EXISTS "entries_account_id_created_at_idx";
+
+DROP TABLE IF EXISTS "interest_postings";
+
+DROP TABLE IF EXISTS "interest_accruals";
+
+DROP TABLE IF EXISTS "interest_tiers";
+
+DELETE FROM "entries"
+WHERE "account_id" IN (SELECT "account_id" FROM "system_accounts" WHERE "purpose" = 'interest_expense');
+
+DELETE FROM "transfers"
+WHERE "from_account_id" IN (SELECT "account_id" FROM "system_accounts" WHERE "purpose" = 'interest_expense')
+   OR "to_account_id" IN (SELECT "account_id" FROM "system_accounts" WHERE "purpose" = 'interest_expense');
+
+WITH "removed" AS (
+  DELETE FROM "system_accounts" WHERE "purpose" = 'interest_expense'
+  RETURNING "account_id"
+)
+DELETE FROM "accounts" WHERE "id" IN (SELECT "account_id" FROM "removed");
+
+DROP TABLE IF EXISTS "system_accounts";
+
+DELETE FROM "accounts" WHERE "product_code" = 'internal';
+
+DELETE FROM "users" WHERE "username" = 'bank_system';
+
+DELETE FROM "account_products" WHERE "code" = 'internal';
+
+ALTER TABLE "account_products" DROP COLUMN IF EXISTS "internal";
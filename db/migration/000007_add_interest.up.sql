@@ -0,0 +1,79 @@
+ALTER TABLE "account_products" ADD COLUMN "internal" boolean NOT NULL DEFAULT false;
+
+COMMENT ON COLUMN "account_products"."internal" IS 'bank-owned ledger accounts, never offered to customers';
+
+INSERT INTO "account_products" ("code", "name", "type", "allowed_currencies", "min_balance", "overdraft_limit", "monthly_withdrawal_limit", "internal") VALUES
+  ('internal', 'Bank Internal Ledger', 'business', '{USD,EUR,CAD}', 0, 2147483647, NULL, true);
+
+INSERT INTO "users" ("username", "hashed_password", "full_name", "email", "role") VALUES
+  ('bank_system', '!', 'Bank System', 'system@bank.internal', 'system');
+
+CREATE TABLE "system_accounts" (
+  "purpose" varchar NOT NULL,
+  "currency" varchar NOT NULL,
+  "account_id" int UNIQUE NOT NULL,
+  PRIMARY KEY ("purpose", "currency")
+);
+
+ALTER TABLE "system_accounts" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+WITH "created" AS (
+  INSERT INTO "accounts" ("owner_id", "balance", "currency", "product_code")
+  SELECT "users"."id", 0, "currency", 'internal'
+  FROM "users", unnest(ARRAY['USD', 'EUR', 'CAD']) AS "currency"
+  WHERE "users"."username" = 'bank_system'
+  RETURNING "id", "currency"
+)
+INSERT INTO "system_accounts" ("purpose", "currency", "account_id")
+SELECT 'interest_expense', "currency", "id" FROM "created";
+
+CREATE TABLE "interest_tiers" (
+  "product_code" varchar NOT NULL,
+  "min_balance" int NOT NULL,
+  "apr_bps" int NOT NULL,
+  PRIMARY KEY ("product_code", "min_balance")
+);
+
+COMMENT ON COLUMN "interest_tiers"."apr_bps" IS 'annual rate in basis points earned by the part of the balance above min_balance';
+
+ALTER TABLE "interest_tiers" ADD FOREIGN KEY ("product_code") REFERENCES "account_products" ("code");
+
+INSERT INTO "interest_tiers" ("product_code", "min_balance", "apr_bps") VALUES
+  ('savings', 0, 150),
+  ('savings', 10000, 250),
+  ('savings', 100000, 350);
+
+CREATE TABLE "interest_accruals" (
+  "account_id" int NOT NULL,
+  "accrual_date" date NOT NULL,
+  "end_of_day_balance" int NOT NULL,
+  "amount_micros" bigint NOT NULL,
+  "posting_period" date,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("account_id", "accrual_date")
+);
+
+COMMENT ON COLUMN "interest_accruals"."amount_micros" IS 'interest earned that day in millionths of the currency minor unit';
+
+COMMENT ON COLUMN "interest_accruals"."posting_period" IS 'period of the posting that paid this accrual, NULL until paid';
+
+ALTER TABLE "interest_accruals" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+CREATE INDEX ON "interest_accruals" ("accrual_date") WHERE "posting_period" IS NULL;
+
+CREATE TABLE "interest_postings" (
+  "account_id" int NOT NULL,
+  "period" date NOT NULL,
+  "amount" int NOT NULL,
+  "transfer_id" int,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("account_id", "period")
+);
+
+COMMENT ON COLUMN "interest_postings"."period" IS 'first day of the month the interest was earned in';
+
+ALTER TABLE "interest_postings" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "interest_postings" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+CREATE INDEX ON "entries" ("account_id", "created_at");
@@ -0,0 +1,18 @@
+DROP TABLE IF EXISTS "fees";
+
+DROP TABLE IF EXISTS "fee_rules";
+
+DROP TYPE IF EXISTS "fee_kind";
+
+DELETE FROM "entries"
+WHERE "account_id" IN (SELECT "account_id" FROM "system_accounts" WHERE "purpose" = 'fee_revenue');
+
+WITH "removed" AS (
+  DELETE FROM "system_accounts" WHERE "purpose" = 'fee_revenue'
+  RETURNING "account_id"
+)
+DELETE FROM "accounts" WHERE "id" IN (SELECT "account_id" FROM "removed");
+
+DROP INDEX IF EXISTS "accounts_owner_product_currency_key";
+
+CREATE UNIQUE INDEX "accounts_owner_product_currency_key" ON "accounts" ("owner_id", "product_code", "currency") WHERE "status" <> 'closed';
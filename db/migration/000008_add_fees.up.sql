@@ -0,0 +1,77 @@
+CREATE TYPE "fee_kind" AS ENUM (
+  'transfer',
+  'maintenance'
+);
+
+CREATE TABLE "fee_rules" (
+  "id" serial PRIMARY KEY,
+  "kind" fee_kind NOT NULL,
+  "product_code" varchar,
+  "currency" varchar,
+  "flat_amount" int NOT NULL DEFAULT 0,
+  "percent_bps" int NOT NULL DEFAULT 0,
+  "min_amount" int NOT NULL DEFAULT 0,
+  "max_amount" int,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  CHECK ("flat_amount" >= 0 AND "percent_bps" >= 0 AND "min_amount" >= 0),
+  CHECK ("max_amount" IS NULL OR "max_amount" >= "min_amount")
+);
+
+COMMENT ON COLUMN "fee_rules"."product_code" IS 'NULL applies to every product';
+
+COMMENT ON COLUMN "fee_rules"."currency" IS 'NULL applies to every currency';
+
+COMMENT ON COLUMN "fee_rules"."percent_bps" IS 'share of the transfer amount in basis points, added to flat_amount';
+
+COMMENT ON COLUMN "fee_rules"."max_amount" IS 'cap on the fee, NULL for no cap';
+
+ALTER TABLE "fee_rules" ADD FOREIGN KEY ("product_code") REFERENCES "account_products" ("code");
+
+CREATE UNIQUE INDEX "fee_rules_scope_key" ON "fee_rules" ("kind", COALESCE("product_code", ''), COALESCE("currency", ''));
+
+INSERT INTO "fee_rules" ("kind", "product_code", "currency", "flat_amount", "percent_bps", "min_amount", "max_amount") VALUES
+  ('transfer', 'savings', NULL, 50, 0, 0, NULL),
+  ('transfer', 'business', NULL, 25, 10, 0, 500),
+  ('transfer', 'business', 'EUR', 30, 10, 0, 600),
+  ('maintenance', 'checking', NULL, 500, 0, 0, NULL),
+  ('maintenance', 'business', NULL, 1500, 0, 0, NULL);
+
+CREATE TABLE "fees" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" int NOT NULL,
+  "kind" fee_kind NOT NULL,
+  "amount" int NOT NULL,
+  "transfer_id" int,
+  "period" date,
+  "entry_id" int,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON COLUMN "fees"."transfer_id" IS 'transfer the fee was charged on, set for transfer fees';
+
+COMMENT ON COLUMN "fees"."period" IS 'first day of the month charged for, set for maintenance fees';
+
+ALTER TABLE "fees" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "fees" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "fees" ADD FOREIGN KEY ("entry_id") REFERENCES "entries" ("id");
+
+CREATE INDEX ON "fees" ("transfer_id");
+
+CREATE UNIQUE INDEX "fees_maintenance_period_key" ON "fees" ("account_id", "period") WHERE "kind" = 'maintenance';
+
+DROP INDEX IF EXISTS "accounts_owner_product_currency_key";
+
+CREATE UNIQUE INDEX "accounts_owner_product_currency_key" ON "accounts" ("owner_id", "product_code", "currency")
+WHERE "status" <> 'closed' AND "product_code" <> 'internal';
+
+WITH "created" AS (
+  INSERT INTO "accounts" ("owner_id", "balance", "currency", "product_code")
+  SELECT "users"."id", 0, "currency", 'internal'
+  FROM "users", unnest(ARRAY['USD', 'EUR', 'CAD']) AS "currency"
+  WHERE "users"."username" = 'bank_system'
+  RETURNING "id", "currency"
+)
+INSERT INTO "system_accounts" ("purpose", "currency", "account_id")
+SELECT 'fee_revenue', "currency", "id" FROM "created";
@@ -0,0 +1,14 @@
+ALTER TABLE "accounts" ALTER COLUMN "balance" DROP DEFAULT;
+
+ALTER TABLE "entries" DROP COLUMN IF EXISTS "journal_id";
+
+DROP TABLE IF EXISTS "journals";
+
+DELETE FROM "entries"
+WHERE "account_id" IN (SELECT "account_id" FROM "system_accounts" WHERE "purpose" IN ('cash', 'suspense'));
+
+WITH "removed" AS (
+  DELETE FROM "system_accounts" WHERE "purpose" IN ('cash', 'suspense')
+  RETURNING "account_id"
+)
+DELETE FROM "accounts" WHERE "id" IN (SELECT "account_id" FROM "removed");
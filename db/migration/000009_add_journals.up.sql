@@ -0,0 +1,81 @@
+CREATE TABLE "journals" (
+  "id" bigserial PRIMARY KEY,
+  "kind" varchar NOT NULL,
+  "transfer_id" int,
+  "description" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON TABLE "journals" IS 'a balanced set of entries: per currency, the amounts of its entries sum to zero';
+
+ALTER TABLE "journals" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+CREATE INDEX ON "journals" ("transfer_id");
+
+ALTER TABLE "entries" ADD COLUMN "journal_id" bigint;
+
+ALTER TABLE "entries" ADD FOREIGN KEY ("journal_id") REFERENCES "journals" ("id");
+
+CREATE INDEX ON "entries" ("journal_id");
+
+WITH "created" AS (
+  INSERT INTO "accounts" ("owner_id", "balance", "currency", "product_code")
+  SELECT "users"."id", 0, "currency", 'internal'
+  FROM "users", unnest(ARRAY['USD', 'EUR', 'CAD']) AS "currency"
+  WHERE "users"."username" = 'bank_system'
+  RETURNING "id", "currency"
+)
+INSERT INTO "system_accounts" ("purpose", "currency", "account_id")
+SELECT 'cash', "currency", "id" FROM "created";
+
+WITH "created" AS (
+  INSERT INTO "accounts" ("owner_id", "balance", "currency", "product_code")
+  SELECT "users"."id", 0, "currency", 'internal'
+  FROM "users", unnest(ARRAY['USD', 'EUR', 'CAD']) AS "currency"
+  WHERE "users"."username" = 'bank_system'
+  RETURNING "id", "currency"
+)
+INSERT INTO "system_accounts" ("purpose", "currency", "account_id")
+SELECT 'suspense', "currency", "id" FROM "created";
+
+-- Balances that no entry explains, such as accounts created with a balance,
+-- get an opening entry offset against the suspense account of the currency.
+INSERT INTO "entries" ("account_id", "amount")
+SELECT "accounts"."id", "accounts"."balance" - COALESCE(sum("entries"."amount"), 0)
+FROM "accounts"
+LEFT JOIN "entries" ON "entries"."account_id" = "accounts"."id"
+GROUP BY "accounts"."id"
+HAVING "accounts"."balance" <> COALESCE(sum("entries"."amount"), 0);
+
+INSERT INTO "entries" ("account_id", "amount")
+SELECT "system_accounts"."account_id", -"totals"."amount"
+FROM (
+  SELECT "accounts"."currency", sum("entries"."amount") AS "amount"
+  FROM "entries"
+  JOIN "accounts" ON "accounts"."id" = "entries"."account_id"
+  GROUP BY "accounts"."currency"
+) AS "totals"
+JOIN "system_accounts" ON "system_accounts"."currency" = "totals"."currency" AND "system_accounts"."purpose" = 'suspense'
+WHERE "totals"."amount" <> 0;
+
+UPDATE "accounts"
+SET "balance" = (SELECT COALESCE(sum("amount"), 0) FROM "entries" WHERE "entries"."account_id" = "accounts"."id")
+WHERE "id" IN (SELECT "account_id" FROM "system_accounts" WHERE "purpose" = 'suspense');
+
+-- Entries written before journals existed are grouped into one journal per
+-- currency.
+INSERT INTO "journals" ("kind", "description")
+SELECT DISTINCT 'migration', 'entries recorded before journals, ' || "accounts"."currency"
+FROM "entries"
+JOIN "accounts" ON "accounts"."id" = "entries"."account_id";
+
+UPDATE "entries"
+SET "journal_id" = "journals"."id"
+FROM "accounts", "journals"
+WHERE "accounts"."id" = "entries"."account_id"
+  AND "journals"."kind" = 'migration'
+  AND "journals"."description" = 'entries recorded before journals, ' || "accounts"."currency";
+
+ALTER TABLE "entries" ALTER COLUMN "journal_id" SET NOT NULL;
+
+ALTER TABLE "accounts" ALTER COLUMN "balance" SET DEFAULT 0;
@@ -0,0 +1,16 @@
+DROP TABLE IF EXISTS "cash_movements";
+
+DROP TYPE IF EXISTS "cash_movement_status";
+
+DROP TYPE IF EXISTS "cash_movement_channel";
+
+DROP TYPE IF EXISTS "cash_movement_direction";
+
+DELETE FROM "entries"
+WHERE "account_id" IN (SELECT "account_id" FROM "system_accounts" WHERE "purpose" = 'settlement');
+
+WITH "removed" AS (
+  DELETE FROM "system_accounts" WHERE "purpose" = 'settlement'
+  RETURNING "account_id"
+)
+DELETE FROM "accounts" WHERE "id" IN (SELECT "account_id" FROM "removed");
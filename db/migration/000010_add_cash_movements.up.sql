@@ -0,0 +1,60 @@
+CREATE TYPE "cash_movement_direction" AS ENUM (
+  'deposit',
+  'withdrawal'
+);
+
+CREATE TYPE "cash_movement_channel" AS ENUM (
+  'cash_desk',
+  'wire'
+);
+
+CREATE TYPE "cash_movement_status" AS ENUM (
+  'pending_approval',
+  'completed',
+  'rejected'
+);
+
+CREATE TABLE "cash_movements" (
+  "id" bigserial PRIMARY KEY,
+  "direction" cash_movement_direction NOT NULL,
+  "channel" cash_movement_channel NOT NULL,
+  "account_id" int NOT NULL,
+  "amount" int NOT NULL CHECK ("amount" > 0),
+  "external_reference" varchar NOT NULL,
+  "memo" varchar NOT NULL DEFAULT '',
+  "status" cash_movement_status NOT NULL,
+  "requested_by" int NOT NULL,
+  "decided_by" int,
+  "decision_reason" varchar NOT NULL DEFAULT '',
+  "decided_at" timestamptz,
+  "journal_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON COLUMN "cash_movements"."external_reference" IS 'id of the movement outside the bank, such as a teller receipt or wire reference';
+
+COMMENT ON COLUMN "cash_movements"."decided_by" IS 'banker who approved or rejected a movement above the approval threshold';
+
+ALTER TABLE "cash_movements" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "cash_movements" ADD FOREIGN KEY ("requested_by") REFERENCES "users" ("id");
+
+ALTER TABLE "cash_movements" ADD FOREIGN KEY ("decided_by") REFERENCES "users" ("id");
+
+ALTER TABLE "cash_movements" ADD FOREIGN KEY ("journal_id") REFERENCES "journals" ("id");
+
+CREATE UNIQUE INDEX "cash_movements_channel_external_reference_key" ON "cash_movements" ("channel", "external_reference");
+
+CREATE INDEX ON "cash_movements" ("account_id", "created_at");
+
+CREATE INDEX ON "cash_movements" ("status") WHERE "status" = 'pending_approval';
+
+WITH "created" AS (
+  INSERT INTO "accounts" ("owner_id", "balance", "currency", "product_code")
+  SELECT "users"."id", 0, "currency", 'internal'
+  FROM "users", unnest(ARRAY['USD', 'EUR', 'CAD']) AS "currency"
+  WHERE "users"."username" = 'bank_system'
+  RETURNING "id", "currency"
+)
+INSERT INTO "system_accounts" ("purpose", "currency", "account_id")
+SELECT 'settlement', "currency", "id" FROM "created";
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS "approval_events";
+
+DROP TABLE IF EXISTS "approval_requests";
+
+DROP TYPE IF EXISTS "approval_event_kind";
+
+DROP TYPE IF EXISTS "approval_status";
@@ -0,0 +1,74 @@
+CREATE TYPE "approval_status" AS ENUM (
+  'pending',
+  'approved',
+  'rejected',
+  'expired'
+);
+
+CREATE TYPE "approval_event_kind" AS ENUM (
+  'requested',
+  'approved',
+  'rejected',
+  'expired'
+);
+
+CREATE TABLE "approval_requests" (
+  "id" bigserial PRIMARY KEY,
+  "action" varchar NOT NULL,
+  "payload" jsonb NOT NULL,
+  "summary" varchar NOT NULL DEFAULT '',
+  "status" approval_status NOT NULL DEFAULT 'pending',
+  "requested_by" int NOT NULL,
+  "decided_by" int,
+  "decision_reason" varchar NOT NULL DEFAULT '',
+  "expires_at" timestamptz NOT NULL,
+  "decided_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  CHECK ("decided_by" IS NULL OR "decided_by" <> "requested_by")
+);
+
+COMMENT ON COLUMN "approval_requests"."payload" IS 'arguments of the action, run as is once approved';
+
+COMMENT ON COLUMN "approval_requests"."decided_by" IS 'banker who approved or rejected, never the requester; NULL when expired';
+
+ALTER TABLE "approval_requests" ADD FOREIGN KEY ("requested_by") REFERENCES "users" ("id");
+
+ALTER TABLE "approval_requests" ADD FOREIGN KEY ("decided_by") REFERENCES "users" ("id");
+
+CREATE INDEX ON "approval_requests" ("status", "created_at");
+
+CREATE INDEX ON "approval_requests" ("expires_at") WHERE "status" = 'pending';
+
+CREATE TABLE "approval_events" (
+  "id" bigserial PRIMARY KEY,
+  "request_id" bigint NOT NULL,
+  "event" approval_event_kind NOT NULL,
+  "actor_id" int,
+  "reason" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON COLUMN "approval_events"."actor_id" IS 'NULL for events raised by the system, such as expiry';
+
+ALTER TABLE "approval_events" ADD FOREIGN KEY ("request_id") REFERENCES "approval_requests" ("id");
+
+ALTER TABLE "approval_events" ADD FOREIGN KEY ("actor_id") REFERENCES "users" ("id");
+
+CREATE INDEX ON "approval_events" ("request_id");
+
+-- Cash movements already waiting for approval move to the generic requests.
+WITH "created" AS (
+  INSERT INTO "approval_requests" ("action", "payload", "summary", "requested_by", "expires_at", "created_at")
+  SELECT
+    'cash_movement',
+    jsonb_build_object('cash_movement_id', "id"),
+    "direction" || ' of ' || "amount" || ' on account ' || "account_id",
+    "requested_by",
+    now() + interval '1 day',
+    "created_at"
+  FROM "cash_movements"
+  WHERE "status" = 'pending_approval'
+  RETURNING "id", "requested_by", "created_at"
+)
+INSERT INTO "approval_events" ("request_id", "event", "actor_id", "created_at")
+SELECT "id", 'requested', "requested_by", "created_at" FROM "created";
@@ -0,0 +1,44 @@
+CREATE TABLE "audit_log" (
+  "id" bigserial PRIMARY KEY,
+  "actor_id" int,
+  "actor_role" varchar NOT NULL DEFAULT '',
+  "method" varchar NOT NULL,
+  "target_type" varchar NOT NULL DEFAULT '',
+  "target_id" varchar NOT NULL DEFAULT '',
+  "before" jsonb,
+  "after" jsonb,
+  "client_ip" varchar NOT NULL DEFAULT '',
+  "user_agent" varchar NOT NULL DEFAULT '',
+  "status_code" varchar NOT NULL,
+  "prev_hash" varchar NOT NULL,
+  "hash" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL
+);
+
+COMMENT ON COLUMN "audit_log"."actor_id" IS 'no foreign key, so entries outlive deleted users';
+
+COMMENT ON COLUMN "audit_log"."prev_hash" IS 'hash of the previous entry, all zeros for the first one';
+
+COMMENT ON COLUMN "audit_log"."hash" IS 'hex SHA-256 of prev_hash and the contents of the entry';
+
+CREATE UNIQUE INDEX "audit_log_prev_hash_key" ON "audit_log" ("prev_hash");
+
+CREATE UNIQUE INDEX "audit_log_hash_key" ON "audit_log" ("hash");
+
+CREATE INDEX ON "audit_log" ("actor_id", "id");
+
+CREATE INDEX ON "audit_log" ("target_type", "target_id", "id");
+
+CREATE FUNCTION "audit_log_append_only"() RETURNS trigger AS $$
+BEGIN
+  RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "audit_log_no_update_or_delete"
+BEFORE UPDATE OR DELETE ON "audit_log"
+FOR EACH ROW EXECUTE FUNCTION "audit_log_append_only"();
+
+CREATE TRIGGER "audit_log_no_truncate"
+BEFORE TRUNCATE ON "audit_log"
+FOR EACH STATEMENT EXECUTE FUNCTION "audit_log_append_only"();
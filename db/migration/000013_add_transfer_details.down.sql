@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS "category_rules";
+
+ALTER TABLE "entries" DROP COLUMN IF EXISTS "category";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "sender_id";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "reference";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "memo";
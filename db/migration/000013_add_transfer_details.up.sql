@@ -0,0 +1,40 @@
+ALTER TABLE "transfers" ADD COLUMN "memo" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "transfers" ADD COLUMN "reference" varchar;
+
+ALTER TABLE "transfers" ADD COLUMN "sender_id" int;
+
+COMMENT ON COLUMN "transfers"."reference" IS 'end-to-end reference supplied by the sender, unique per sender';
+
+COMMENT ON COLUMN "transfers"."sender_id" IS 'user who made the transfer, NULL for transfers made by the bank';
+
+ALTER TABLE "transfers" ADD FOREIGN KEY ("sender_id") REFERENCES "users" ("id");
+
+CREATE UNIQUE INDEX ON "transfers" ("sender_id", "reference");
+
+ALTER TABLE "entries" ADD COLUMN "category" varchar;
+
+COMMENT ON COLUMN "entries"."category" IS 'budgeting category chosen by the account owner or set by one of their category rules';
+
+CREATE INDEX ON "entries" ("account_id", "category");
+
+CREATE TABLE "category_rules" (
+  "id" serial PRIMARY KEY,
+  "user_id" int NOT NULL,
+  "category" varchar NOT NULL,
+  "memo_contains" varchar,
+  "counterparty_account_id" int,
+  "priority" int NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  CHECK ("memo_contains" IS NOT NULL OR "counterparty_account_id" IS NOT NULL)
+);
+
+COMMENT ON COLUMN "category_rules"."memo_contains" IS 'matches transfers whose memo contains it, ignoring case';
+
+COMMENT ON COLUMN "category_rules"."priority" IS 'rules are tried in ascending priority, then creation order; the first match wins';
+
+ALTER TABLE "category_rules" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id");
+
+ALTER TABLE "category_rules" ADD FOREIGN KEY ("counterparty_account_id") REFERENCES "accounts" ("id");
+
+CREATE INDEX ON "category_rules" ("user_id", "priority", "id");
@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS "account_limits";
+
+ALTER TABLE "account_products" DROP COLUMN IF EXISTS "monthly_count_limit";
+
+ALTER TABLE "account_products" DROP COLUMN IF EXISTS "monthly_amount_limit";
+
+ALTER TABLE "account_products" DROP COLUMN IF EXISTS "daily_count_limit";
+
+ALTER TABLE "account_products" DROP COLUMN IF EXISTS "daily_amount_limit";
+
+ALTER TABLE "account_products" DROP COLUMN IF EXISTS "per_transfer_limit";
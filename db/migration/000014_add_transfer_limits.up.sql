@@ -0,0 +1,56 @@
+ALTER TABLE "account_products" ADD COLUMN "per_transfer_limit" int;
+
+ALTER TABLE "account_products" ADD COLUMN "daily_amount_limit" int;
+
+ALTER TABLE "account_products" ADD COLUMN "daily_count_limit" int;
+
+ALTER TABLE "account_products" ADD COLUMN "monthly_amount_limit" int;
+
+ALTER TABLE "account_products" ADD COLUMN "monthly_count_limit" int;
+
+COMMENT ON COLUMN "account_products"."per_transfer_limit" IS 'default largest single outgoing transfer, NULL for unlimited';
+
+COMMENT ON COLUMN "account_products"."daily_amount_limit" IS 'default total of outgoing transfers per calendar day, NULL for unlimited';
+
+COMMENT ON COLUMN "account_products"."monthly_amount_limit" IS 'default total of outgoing transfers per calendar month, NULL for unlimited';
+
+UPDATE "account_products" SET
+  "per_transfer_limit" = 500000,
+  "daily_amount_limit" = 1000000,
+  "daily_count_limit" = 50,
+  "monthly_amount_limit" = 5000000,
+  "monthly_count_limit" = 500
+WHERE "code" = 'checking';
+
+UPDATE "account_products" SET
+  "per_transfer_limit" = 1000000,
+  "daily_amount_limit" = 1000000,
+  "monthly_amount_limit" = 2500000
+WHERE "code" = 'savings';
+
+UPDATE "account_products" SET
+  "per_transfer_limit" = 5000000,
+  "daily_amount_limit" = 10000000,
+  "monthly_amount_limit" = 100000000
+WHERE "code" = 'business';
+
+CREATE TABLE "account_limits" (
+  "account_id" int PRIMARY KEY,
+  "per_transfer_limit" int,
+  "daily_amount_limit" int,
+  "daily_count_limit" int,
+  "monthly_amount_limit" int,
+  "monthly_count_limit" int,
+  "updated_by" int NOT NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  CHECK ("per_transfer_limit" >= 0 AND "daily_amount_limit" >= 0 AND "daily_count_limit" >= 0
+    AND "monthly_amount_limit" >= 0 AND "monthly_count_limit" >= 0)
+);
+
+COMMENT ON TABLE "account_limits" IS 'per-account overrides of the transfer limits of the account product';
+
+COMMENT ON COLUMN "account_limits"."per_transfer_limit" IS 'NULL falls back to the product default, as do the other limits';
+
+ALTER TABLE "account_limits" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "account_limits" ADD FOREIGN KEY ("updated_by") REFERENCES "users" ("id");
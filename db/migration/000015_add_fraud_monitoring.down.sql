@@ -0,0 +1,15 @@
+DROP INDEX IF EXISTS "sessions_user_id_created_at_idx";
+
+DROP TABLE IF EXISTS "fraud_alerts";
+
+DROP INDEX IF EXISTS "transfers_id_idx";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "screened_at";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "status";
+
+DROP TYPE IF EXISTS "fraud_alert_status";
+
+DROP TYPE IF EXISTS "fraud_decision";
+
+DROP TYPE IF EXISTS "transfer_status";
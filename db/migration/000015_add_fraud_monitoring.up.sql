@@ -0,0 +1,61 @@
+CREATE TYPE "transfer_status" AS ENUM (
+  'completed',
+  'held',
+  'rejected',
+  'blocked'
+);
+
+CREATE TYPE "fraud_decision" AS ENUM (
+  'allow',
+  'hold',
+  'block'
+);
+
+CREATE TYPE "fraud_alert_status" AS ENUM (
+  'open',
+  'dismissed',
+  'confirmed'
+);
+
+ALTER TABLE "transfers" ADD COLUMN "status" transfer_status NOT NULL DEFAULT 'completed';
+
+ALTER TABLE "transfers" ADD COLUMN "screened_at" timestamptz;
+
+COMMENT ON COLUMN "transfers"."status" IS 'held transfers move no money until a banker dismisses their fraud alert; rejected and blocked ones never do';
+
+COMMENT ON COLUMN "transfers"."screened_at" IS 'when the batch fraud monitor last screened the transfer, NULL until it has';
+
+CREATE INDEX ON "transfers" ("id") WHERE "screened_at" IS NULL;
+
+CREATE TABLE "fraud_alerts" (
+  "id" bigserial PRIMARY KEY,
+  "transfer_id" int NOT NULL,
+  "account_id" int NOT NULL,
+  "score" int NOT NULL,
+  "decision" fraud_decision NOT NULL,
+  "hits" jsonb NOT NULL,
+  "source" varchar NOT NULL,
+  "status" fraud_alert_status NOT NULL DEFAULT 'open',
+  "resolved_by" int,
+  "resolution_reason" varchar NOT NULL DEFAULT '',
+  "resolved_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON COLUMN "fraud_alerts"."account_id" IS 'account the screened transfer was sent from';
+
+COMMENT ON COLUMN "fraud_alerts"."hits" IS 'rules that matched, with their score and reason';
+
+COMMENT ON COLUMN "fraud_alerts"."source" IS 'realtime when raised before the transfer committed, batch when raised afterwards';
+
+ALTER TABLE "fraud_alerts" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "fraud_alerts" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "fraud_alerts" ADD FOREIGN KEY ("resolved_by") REFERENCES "users" ("id");
+
+CREATE UNIQUE INDEX ON "fraud_alerts" ("transfer_id");
+
+CREATE INDEX ON "fraud_alerts" ("status", "id");
+
+CREATE INDEX ON "sessions" ("user_id", "created_at");
@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS "screening_matches";
+
+ALTER TABLE "users" DROP COLUMN IF EXISTS "screened_at";
+
+ALTER TABLE "users" DROP COLUMN IF EXISTS "screening_status";
+
+DROP TYPE IF EXISTS "screening_match_status";
+
+DROP TYPE IF EXISTS "screening_status";
@@ -0,0 +1,48 @@
+CREATE TYPE "screening_status" AS ENUM (
+  'clear',
+  'pending_review',
+  'blocked'
+);
+
+CREATE TYPE "screening_match_status" AS ENUM (
+  'pending',
+  'cleared',
+  'confirmed'
+);
+
+ALTER TABLE "users" ADD COLUMN "screening_status" screening_status NOT NULL DEFAULT 'clear';
+
+ALTER TABLE "users" ADD COLUMN "screened_at" timestamptz;
+
+COMMENT ON COLUMN "users"."screening_status" IS 'users pending review or blocked can neither send nor receive transfers';
+
+COMMENT ON COLUMN "users"."screened_at" IS 'when the full name was last screened against the sanctions list, NULL until it has';
+
+CREATE TABLE "screening_matches" (
+  "id" bigserial PRIMARY KEY,
+  "user_id" int NOT NULL,
+  "entry_id" varchar NOT NULL,
+  "entry_name" varchar NOT NULL,
+  "matched_name" varchar NOT NULL,
+  "score" double precision NOT NULL,
+  "source" varchar NOT NULL,
+  "status" screening_match_status NOT NULL DEFAULT 'pending',
+  "reviewed_by" int,
+  "review_reason" varchar NOT NULL DEFAULT '',
+  "reviewed_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON COLUMN "screening_matches"."entry_id" IS 'id of the sanctions list entry the user matched';
+
+COMMENT ON COLUMN "screening_matches"."matched_name" IS 'name or alias of the entry that matched the full name of the user';
+
+COMMENT ON COLUMN "screening_matches"."source" IS 'create_user, update_user or rescreen';
+
+ALTER TABLE "screening_matches" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id");
+
+ALTER TABLE "screening_matches" ADD FOREIGN KEY ("reviewed_by") REFERENCES "users" ("id");
+
+CREATE UNIQUE INDEX ON "screening_matches" ("user_id", "entry_id");
+
+CREATE INDEX ON "screening_matches" ("status", "id");
@@ -0,0 +1,3 @@
+ALTER TABLE "interest_postings" ADD COLUMN "remainder_micros" bigint NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN "interest_postings"."remainder_micros" IS 'fraction of the minor unit left unpaid, carried into the next posting or written off once the account is closed';
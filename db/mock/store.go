@@ -12,6 +12,7 @@ package mockdb
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/google/uuid"
 	db "github.com/valkyraycho/bank_project/db/sqlc"
@@ -57,6 +58,140 @@ func (mr *MockStoreMockRecorder) AddAccountBalance(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccountBalance", reflect.TypeOf((*MockStore)(nil).AddAccountBalance), ctx, arg)
 }
 
+// AppendAuditLogTx mocks base method.
+func (m *MockStore) AppendAuditLogTx(ctx context.Context, args db.AppendAuditLogTxParams) (db.AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendAuditLogTx", ctx, args)
+	ret0, _ := ret[0].(db.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppendAuditLogTx indicates an expected call of AppendAuditLogTx.
+func (mr *MockStoreMockRecorder) AppendAuditLogTx(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendAuditLogTx", reflect.TypeOf((*MockStore)(nil).AppendAuditLogTx), ctx, args)
+}
+
+// BlockUserSessions mocks base method.
+func (m *MockStore) BlockUserSessions(ctx context.Context, userID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockUserSessions", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockUserSessions indicates an expected call of BlockUserSessions.
+func (mr *MockStoreMockRecorder) BlockUserSessions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockUserSessions", reflect.TypeOf((*MockStore)(nil).BlockUserSessions), ctx, userID)
+}
+
+// CashMovementTx mocks base method.
+func (m *MockStore) CashMovementTx(ctx context.Context, args db.CashMovementTxParams) (db.CashMovementTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CashMovementTx", ctx, args)
+	ret0, _ := ret[0].(db.CashMovementTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CashMovementTx indicates an expected call of CashMovementTx.
+func (mr *MockStoreMockRecorder) CashMovementTx(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CashMovementTx", reflect.TypeOf((*MockStore)(nil).CashMovementTx), ctx, args)
+}
+
+// ChargeMaintenanceFeeTx mocks base method.
+func (m *MockStore) ChargeMaintenanceFeeTx(ctx context.Context, args db.ChargeMaintenanceFeeTxParams) (db.ChargeMaintenanceFeeTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChargeMaintenanceFeeTx", ctx, args)
+	ret0, _ := ret[0].(db.ChargeMaintenanceFeeTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChargeMaintenanceFeeTx indicates an expected call of ChargeMaintenanceFeeTx.
+func (mr *MockStoreMockRecorder) ChargeMaintenanceFeeTx(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChargeMaintenanceFeeTx", reflect.TypeOf((*MockStore)(nil).ChargeMaintenanceFeeTx), ctx, args)
+}
+
+// CloseAccountTx mocks base method.
+func (m *MockStore) CloseAccountTx(ctx context.Context, args db.CloseAccountTxParams) (db.CloseAccountTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseAccountTx", ctx, args)
+	ret0, _ := ret[0].(db.CloseAccountTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseAccountTx indicates an expected call of CloseAccountTx.
+func (mr *MockStoreMockRecorder) CloseAccountTx(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAccountTx", reflect.TypeOf((*MockStore)(nil).CloseAccountTx), ctx, args)
+}
+
+// CompleteCashMovement mocks base method.
+func (m *MockStore) CompleteCashMovement(ctx context.Context, arg db.CompleteCashMovementParams) (db.CashMovement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteCashMovement", ctx, arg)
+	ret0, _ := ret[0].(db.CashMovement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteCashMovement indicates an expected call of CompleteCashMovement.
+func (mr *MockStoreMockRecorder) CompleteCashMovement(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteCashMovement", reflect.TypeOf((*MockStore)(nil).CompleteCashMovement), ctx, arg)
+}
+
+// CountMonthlyWithdrawals mocks base method.
+func (m *MockStore) CountMonthlyWithdrawals(ctx context.Context, accountID int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountMonthlyWithdrawals", ctx, accountID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountMonthlyWithdrawals indicates an expected call of CountMonthlyWithdrawals.
+func (mr *MockStoreMockRecorder) CountMonthlyWithdrawals(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountMonthlyWithdrawals", reflect.TypeOf((*MockStore)(nil).CountMonthlyWithdrawals), ctx, accountID)
+}
+
+// CountStructuredTransfers mocks base method.
+func (m *MockStore) CountStructuredTransfers(ctx context.Context, arg db.CountStructuredTransfersParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountStructuredTransfers", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountStructuredTransfers indicates an expected call of CountStructuredTransfers.
+func (mr *MockStoreMockRecorder) CountStructuredTransfers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountStructuredTransfers", reflect.TypeOf((*MockStore)(nil).CountStructuredTransfers), ctx, arg)
+}
+
+// CountTransfersToPayee mocks base method.
+func (m *MockStore) CountTransfersToPayee(ctx context.Context, arg db.CountTransfersToPayeeParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTransfersToPayee", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTransfersToPayee indicates an expected call of CountTransfersToPayee.
+func (mr *MockStoreMockRecorder) CountTransfersToPayee(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTransfersToPayee", reflect.TypeOf((*MockStore)(nil).CountTransfersToPayee), ctx, arg)
+}
+
 // CreateAccount mocks base method.
 func (m *MockStore) CreateAccount(ctx context.Context, arg db.CreateAccountParams) (db.Account, error) {
 	m.ctrl.T.Helper()
@@ -72,6 +207,81 @@ func (mr *MockStoreMockRecorder) CreateAccount(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockStore)(nil).CreateAccount), ctx, arg)
 }
 
+// CreateApprovalEvent mocks base method.
+func (m *MockStore) CreateApprovalEvent(ctx context.Context, arg db.CreateApprovalEventParams) (db.ApprovalEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateApprovalEvent", ctx, arg)
+	ret0, _ := ret[0].(db.ApprovalEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateApprovalEvent indicates an expected call of CreateApprovalEvent.
+func (mr *MockStoreMockRecorder) CreateApprovalEvent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApprovalEvent", reflect.TypeOf((*MockStore)(nil).CreateApprovalEvent), ctx, arg)
+}
+
+// CreateApprovalRequest mocks base method.
+func (m *MockStore) CreateApprovalRequest(ctx context.Context, arg db.CreateApprovalRequestParams) (db.ApprovalRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateApprovalRequest", ctx, arg)
+	ret0, _ := ret[0].(db.ApprovalRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateApprovalRequest indicates an expected call of CreateApprovalRequest.
+func (mr *MockStoreMockRecorder) CreateApprovalRequest(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApprovalRequest", reflect.TypeOf((*MockStore)(nil).CreateApprovalRequest), ctx, arg)
+}
+
+// CreateAuditLogEntry mocks base method.
+func (m *MockStore) CreateAuditLogEntry(ctx context.Context, arg db.CreateAuditLogEntryParams) (db.AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuditLogEntry", ctx, arg)
+	ret0, _ := ret[0].(db.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAuditLogEntry indicates an expected call of CreateAuditLogEntry.
+func (mr *MockStoreMockRecorder) CreateAuditLogEntry(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditLogEntry", reflect.TypeOf((*MockStore)(nil).CreateAuditLogEntry), ctx, arg)
+}
+
+// CreateCashMovement mocks base method.
+func (m *MockStore) CreateCashMovement(ctx context.Context, arg db.CreateCashMovementParams) (db.CashMovement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCashMovement", ctx, arg)
+	ret0, _ := ret[0].(db.CashMovement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCashMovement indicates an expected call of CreateCashMovement.
+func (mr *MockStoreMockRecorder) CreateCashMovement(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCashMovement", reflect.TypeOf((*MockStore)(nil).CreateCashMovement), ctx, arg)
+}
+
+// CreateCategoryRule mocks base method.
+func (m *MockStore) CreateCategoryRule(ctx context.Context, arg db.CreateCategoryRuleParams) (db.CategoryRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategoryRule", ctx, arg)
+	ret0, _ := ret[0].(db.CategoryRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategoryRule indicates an expected call of CreateCategoryRule.
+func (mr *MockStoreMockRecorder) CreateCategoryRule(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategoryRule", reflect.TypeOf((*MockStore)(nil).CreateCategoryRule), ctx, arg)
+}
+
 // CreateEntry mocks base method.
 func (m *MockStore) CreateEntry(ctx context.Context, arg db.CreateEntryParams) (db.Entry, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +297,96 @@ func (mr *MockStoreMockRecorder) CreateEntry(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockStore)(nil).CreateEntry), ctx, arg)
 }
 
+// CreateFee mocks base method.
+func (m *MockStore) CreateFee(ctx context.Context, arg db.CreateFeeParams) (db.Fee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFee", ctx, arg)
+	ret0, _ := ret[0].(db.Fee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFee indicates an expected call of CreateFee.
+func (mr *MockStoreMockRecorder) CreateFee(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFee", reflect.TypeOf((*MockStore)(nil).CreateFee), ctx, arg)
+}
+
+// CreateFraudAlert mocks base method.
+func (m *MockStore) CreateFraudAlert(ctx context.Context, arg db.CreateFraudAlertParams) (db.FraudAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFraudAlert", ctx, arg)
+	ret0, _ := ret[0].(db.FraudAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFraudAlert indicates an expected call of CreateFraudAlert.
+func (mr *MockStoreMockRecorder) CreateFraudAlert(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFraudAlert", reflect.TypeOf((*MockStore)(nil).CreateFraudAlert), ctx, arg)
+}
+
+// CreateInterestAccrual mocks base method.
+func (m *MockStore) CreateInterestAccrual(ctx context.Context, arg db.CreateInterestAccrualParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInterestAccrual", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInterestAccrual indicates an expected call of CreateInterestAccrual.
+func (mr *MockStoreMockRecorder) CreateInterestAccrual(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInterestAccrual", reflect.TypeOf((*MockStore)(nil).CreateInterestAccrual), ctx, arg)
+}
+
+// CreateInterestPosting mocks base method.
+func (m *MockStore) CreateInterestPosting(ctx context.Context, arg db.CreateInterestPostingParams) (db.InterestPosting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInterestPosting", ctx, arg)
+	ret0, _ := ret[0].(db.InterestPosting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInterestPosting indicates an expected call of CreateInterestPosting.
+func (mr *MockStoreMockRecorder) CreateInterestPosting(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInterestPosting", reflect.TypeOf((*MockStore)(nil).CreateInterestPosting), ctx, arg)
+}
+
+// CreateJournal mocks base method.
+func (m *MockStore) CreateJournal(ctx context.Context, arg db.CreateJournalParams) (db.Journal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJournal", ctx, arg)
+	ret0, _ := ret[0].(db.Journal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateJournal indicates an expected call of CreateJournal.
+func (mr *MockStoreMockRecorder) CreateJournal(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJournal", reflect.TypeOf((*MockStore)(nil).CreateJournal), ctx, arg)
+}
+
+// CreateScreeningMatch mocks base method.
+func (m *MockStore) CreateScreeningMatch(ctx context.Context, arg db.CreateScreeningMatchParams) (db.ScreeningMatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScreeningMatch", ctx, arg)
+	ret0, _ := ret[0].(db.ScreeningMatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScreeningMatch indicates an expected call of CreateScreeningMatch.
+func (mr *MockStoreMockRecorder) CreateScreeningMatch(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScreeningMatch", reflect.TypeOf((*MockStore)(nil).CreateScreeningMatch), ctx, arg)
+}
+
 // CreateSession mocks base method.
 func (m *MockStore) CreateSession(ctx context.Context, arg db.CreateSessionParams) (db.Session, error) {
 	m.ctrl.T.Helper()
@@ -132,6 +432,51 @@ func (mr *MockStoreMockRecorder) CreateUser(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), ctx, arg)
 }
 
+// CreateUserTx mocks base method.
+func (m *MockStore) CreateUserTx(ctx context.Context, args db.CreateUserTxParams) (db.CreateUserTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserTx", ctx, args)
+	ret0, _ := ret[0].(db.CreateUserTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserTx indicates an expected call of CreateUserTx.
+func (mr *MockStoreMockRecorder) CreateUserTx(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserTx", reflect.TypeOf((*MockStore)(nil).CreateUserTx), ctx, args)
+}
+
+// DecideApprovalRequest mocks base method.
+func (m *MockStore) DecideApprovalRequest(ctx context.Context, arg db.DecideApprovalRequestParams) (db.ApprovalRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecideApprovalRequest", ctx, arg)
+	ret0, _ := ret[0].(db.ApprovalRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecideApprovalRequest indicates an expected call of DecideApprovalRequest.
+func (mr *MockStoreMockRecorder) DecideApprovalRequest(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecideApprovalRequest", reflect.TypeOf((*MockStore)(nil).DecideApprovalRequest), ctx, arg)
+}
+
+// DecideApprovalTx mocks base method.
+func (m *MockStore) DecideApprovalTx(ctx context.Context, args db.DecideApprovalTxParams) (db.ApprovalRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecideApprovalTx", ctx, args)
+	ret0, _ := ret[0].(db.ApprovalRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecideApprovalTx indicates an expected call of DecideApprovalTx.
+func (mr *MockStoreMockRecorder) DecideApprovalTx(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecideApprovalTx", reflect.TypeOf((*MockStore)(nil).DecideApprovalTx), ctx, args)
+}
+
 // DeleteAccount mocks base method.
 func (m *MockStore) DeleteAccount(ctx context.Context, id int32) error {
 	m.ctrl.T.Helper()
@@ -146,6 +491,35 @@ func (mr *MockStoreMockRecorder) DeleteAccount(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockStore)(nil).DeleteAccount), ctx, id)
 }
 
+// DeleteCategoryRule mocks base method.
+func (m *MockStore) DeleteCategoryRule(ctx context.Context, arg db.DeleteCategoryRuleParams) (db.CategoryRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategoryRule", ctx, arg)
+	ret0, _ := ret[0].(db.CategoryRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCategoryRule indicates an expected call of DeleteCategoryRule.
+func (mr *MockStoreMockRecorder) DeleteCategoryRule(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategoryRule", reflect.TypeOf((*MockStore)(nil).DeleteCategoryRule), ctx, arg)
+}
+
+// DeleteIdleRateLimitBuckets mocks base method.
+func (m *MockStore) DeleteIdleRateLimitBuckets(ctx context.Context, idleSince time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIdleRateLimitBuckets", ctx, idleSince)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIdleRateLimitBuckets indicates an expected call of DeleteIdleRateLimitBuckets.
+func (mr *MockStoreMockRecorder) DeleteIdleRateLimitBuckets(ctx, idleSince any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIdleRateLimitBuckets", reflect.TypeOf((*MockStore)(nil).DeleteIdleRateLimitBuckets), ctx, idleSince)
+}
+
 // DeleteUser mocks base method.
 func (m *MockStore) DeleteUser(ctx context.Context, id int32) error {
 	m.ctrl.T.Helper()
@@ -160,6 +534,36 @@ func (mr *MockStoreMockRecorder) DeleteUser(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockStore)(nil).DeleteUser), ctx, id)
 }
 
+// ExpireApprovalTx mocks base method.
+func (m *MockStore) ExpireApprovalTx(ctx context.Context, id int64) (db.ApprovalRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireApprovalTx", ctx, id)
+	ret0, _ := ret[0].(db.ApprovalRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireApprovalTx indicates an expected call of ExpireApprovalTx.
+func (mr *MockStoreMockRecorder) ExpireApprovalTx(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireApprovalTx", reflect.TypeOf((*MockStore)(nil).ExpireApprovalTx), ctx, id)
+}
+
+// FindFeeRule mocks base method.
+func (m *MockStore) FindFeeRule(ctx context.Context, arg db.FindFeeRuleParams) (db.FeeRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFeeRule", ctx, arg)
+	ret0, _ := ret[0].(db.FeeRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFeeRule indicates an expected call of FindFeeRule.
+func (mr *MockStoreMockRecorder) FindFeeRule(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFeeRule", reflect.TypeOf((*MockStore)(nil).FindFeeRule), ctx, arg)
+}
+
 // GetAccount mocks base method.
 func (m *MockStore) GetAccount(ctx context.Context, id int32) (db.Account, error) {
 	m.ctrl.T.Helper()
@@ -175,86 +579,551 @@ func (mr *MockStoreMockRecorder) GetAccount(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockStore)(nil).GetAccount), ctx, id)
 }
 
-// GetEntry mocks base method.
-func (m *MockStore) GetEntry(ctx context.Context, id int32) (db.Entry, error) {
+// GetAccountForUpdate mocks base method.
+func (m *MockStore) GetAccountForUpdate(ctx context.Context, id int32) (db.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEntry", ctx, id)
-	ret0, _ := ret[0].(db.Entry)
+	ret := m.ctrl.Call(m, "GetAccountForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetEntry indicates an expected call of GetEntry.
-func (mr *MockStoreMockRecorder) GetEntry(ctx, id any) *gomock.Call {
+// GetAccountForUpdate indicates an expected call of GetAccountForUpdate.
+func (mr *MockStoreMockRecorder) GetAccountForUpdate(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockStore)(nil).GetEntry), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountForUpdate", reflect.TypeOf((*MockStore)(nil).GetAccountForUpdate), ctx, id)
 }
 
-// GetSession mocks base method.
-func (m *MockStore) GetSession(ctx context.Context, id uuid.UUID) (db.Session, error) {
+// GetAccountLimits mocks base method.
+func (m *MockStore) GetAccountLimits(ctx context.Context, accountID int32) (db.AccountLimit, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSession", ctx, id)
-	ret0, _ := ret[0].(db.Session)
+	ret := m.ctrl.Call(m, "GetAccountLimits", ctx, accountID)
+	ret0, _ := ret[0].(db.AccountLimit)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSession indicates an expected call of GetSession.
-func (mr *MockStoreMockRecorder) GetSession(ctx, id any) *gomock.Call {
+// GetAccountLimits indicates an expected call of GetAccountLimits.
+func (mr *MockStoreMockRecorder) GetAccountLimits(ctx, accountID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockStore)(nil).GetSession), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountLimits", reflect.TypeOf((*MockStore)(nil).GetAccountLimits), ctx, accountID)
 }
 
-// GetTransfer mocks base method.
-func (m *MockStore) GetTransfer(ctx context.Context, id int32) (db.Transfer, error) {
+// GetAccountProduct mocks base method.
+func (m *MockStore) GetAccountProduct(ctx context.Context, code string) (db.AccountProduct, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransfer", ctx, id)
-	ret0, _ := ret[0].(db.Transfer)
+	ret := m.ctrl.Call(m, "GetAccountProduct", ctx, code)
+	ret0, _ := ret[0].(db.AccountProduct)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTransfer indicates an expected call of GetTransfer.
-func (mr *MockStoreMockRecorder) GetTransfer(ctx, id any) *gomock.Call {
+// GetAccountProduct indicates an expected call of GetAccountProduct.
+func (mr *MockStoreMockRecorder) GetAccountProduct(ctx, code any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockStore)(nil).GetTransfer), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountProduct", reflect.TypeOf((*MockStore)(nil).GetAccountProduct), ctx, code)
 }
 
-// GetUser mocks base method.
-func (m *MockStore) GetUser(ctx context.Context, username string) (db.User, error) {
+// GetApprovalRequest mocks base method.
+func (m *MockStore) GetApprovalRequest(ctx context.Context, id int64) (db.ApprovalRequest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", ctx, username)
-	ret0, _ := ret[0].(db.User)
+	ret := m.ctrl.Call(m, "GetApprovalRequest", ctx, id)
+	ret0, _ := ret[0].(db.ApprovalRequest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockStoreMockRecorder) GetUser(ctx, username any) *gomock.Call {
+// GetApprovalRequest indicates an expected call of GetApprovalRequest.
+func (mr *MockStoreMockRecorder) GetApprovalRequest(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), ctx, username)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApprovalRequest", reflect.TypeOf((*MockStore)(nil).GetApprovalRequest), ctx, id)
 }
 
-// ListAccount mocks base method.
-func (m *MockStore) ListAccount(ctx context.Context, arg db.ListAccountParams) ([]db.Account, error) {
+// GetApprovalRequestForUpdate mocks base method.
+func (m *MockStore) GetApprovalRequestForUpdate(ctx context.Context, id int64) (db.ApprovalRequest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAccount", ctx, arg)
-	ret0, _ := ret[0].([]db.Account)
+	ret := m.ctrl.Call(m, "GetApprovalRequestForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.ApprovalRequest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListAccount indicates an expected call of ListAccount.
-func (mr *MockStoreMockRecorder) ListAccount(ctx, arg any) *gomock.Call {
+// GetApprovalRequestForUpdate indicates an expected call of GetApprovalRequestForUpdate.
+func (mr *MockStoreMockRecorder) GetApprovalRequestForUpdate(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccount", reflect.TypeOf((*MockStore)(nil).ListAccount), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApprovalRequestForUpdate", reflect.TypeOf((*MockStore)(nil).GetApprovalRequestForUpdate), ctx, id)
 }
 
-// ListEntries mocks base method.
-func (m *MockStore) ListEntries(ctx context.Context, arg db.ListEntriesParams) ([]db.Entry, error) {
+// GetCashMovement mocks base method.
+func (m *MockStore) GetCashMovement(ctx context.Context, id int64) (db.CashMovement, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListEntries", ctx, arg)
-	ret0, _ := ret[0].([]db.Entry)
+	ret := m.ctrl.Call(m, "GetCashMovement", ctx, id)
+	ret0, _ := ret[0].(db.CashMovement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCashMovement indicates an expected call of GetCashMovement.
+func (mr *MockStoreMockRecorder) GetCashMovement(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCashMovement", reflect.TypeOf((*MockStore)(nil).GetCashMovement), ctx, id)
+}
+
+// GetCashMovementForUpdate mocks base method.
+func (m *MockStore) GetCashMovementForUpdate(ctx context.Context, id int64) (db.CashMovement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCashMovementForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.CashMovement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCashMovementForUpdate indicates an expected call of GetCashMovementForUpdate.
+func (mr *MockStoreMockRecorder) GetCashMovementForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCashMovementForUpdate", reflect.TypeOf((*MockStore)(nil).GetCashMovementForUpdate), ctx, id)
+}
+
+// GetEndOfDayBalance mocks base method.
+func (m *MockStore) GetEndOfDayBalance(ctx context.Context, arg db.GetEndOfDayBalanceParams) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEndOfDayBalance", ctx, arg)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEndOfDayBalance indicates an expected call of GetEndOfDayBalance.
+func (mr *MockStoreMockRecorder) GetEndOfDayBalance(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEndOfDayBalance", reflect.TypeOf((*MockStore)(nil).GetEndOfDayBalance), ctx, arg)
+}
+
+// GetEntry mocks base method.
+func (m *MockStore) GetEntry(ctx context.Context, id int32) (db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntry", ctx, id)
+	ret0, _ := ret[0].(db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntry indicates an expected call of GetEntry.
+func (mr *MockStoreMockRecorder) GetEntry(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockStore)(nil).GetEntry), ctx, id)
+}
+
+// GetFraudAlert mocks base method.
+func (m *MockStore) GetFraudAlert(ctx context.Context, id int64) (db.FraudAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFraudAlert", ctx, id)
+	ret0, _ := ret[0].(db.FraudAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFraudAlert indicates an expected call of GetFraudAlert.
+func (mr *MockStoreMockRecorder) GetFraudAlert(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFraudAlert", reflect.TypeOf((*MockStore)(nil).GetFraudAlert), ctx, id)
+}
+
+// GetFraudAlertForUpdate mocks base method.
+func (m *MockStore) GetFraudAlertForUpdate(ctx context.Context, id int64) (db.FraudAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFraudAlertForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.FraudAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFraudAlertForUpdate indicates an expected call of GetFraudAlertForUpdate.
+func (mr *MockStoreMockRecorder) GetFraudAlertForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFraudAlertForUpdate", reflect.TypeOf((*MockStore)(nil).GetFraudAlertForUpdate), ctx, id)
+}
+
+// GetInterestPosting mocks base method.
+func (m *MockStore) GetInterestPosting(ctx context.Context, arg db.GetInterestPostingParams) (db.InterestPosting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInterestPosting", ctx, arg)
+	ret0, _ := ret[0].(db.InterestPosting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInterestPosting indicates an expected call of GetInterestPosting.
+func (mr *MockStoreMockRecorder) GetInterestPosting(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInterestPosting", reflect.TypeOf((*MockStore)(nil).GetInterestPosting), ctx, arg)
+}
+
+// GetInterestRemainder mocks base method.
+func (m *MockStore) GetInterestRemainder(ctx context.Context, arg db.GetInterestRemainderParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInterestRemainder", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInterestRemainder indicates an expected call of GetInterestRemainder.
+func (mr *MockStoreMockRecorder) GetInterestRemainder(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInterestRemainder", reflect.TypeOf((*MockStore)(nil).GetInterestRemainder), ctx, arg)
+}
+
+// GetJournal mocks base method.
+func (m *MockStore) GetJournal(ctx context.Context, id int64) (db.Journal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJournal", ctx, id)
+	ret0, _ := ret[0].(db.Journal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJournal indicates an expected call of GetJournal.
+func (mr *MockStoreMockRecorder) GetJournal(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJournal", reflect.TypeOf((*MockStore)(nil).GetJournal), ctx, id)
+}
+
+// GetLastAuditLogHash mocks base method.
+func (m *MockStore) GetLastAuditLogHash(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastAuditLogHash", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastAuditLogHash indicates an expected call of GetLastAuditLogHash.
+func (mr *MockStoreMockRecorder) GetLastAuditLogHash(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastAuditLogHash", reflect.TypeOf((*MockStore)(nil).GetLastAuditLogHash), ctx)
+}
+
+// GetOutgoingTransferTotals mocks base method.
+func (m *MockStore) GetOutgoingTransferTotals(ctx context.Context, accountID int32) (db.GetOutgoingTransferTotalsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutgoingTransferTotals", ctx, accountID)
+	ret0, _ := ret[0].(db.GetOutgoingTransferTotalsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutgoingTransferTotals indicates an expected call of GetOutgoingTransferTotals.
+func (mr *MockStoreMockRecorder) GetOutgoingTransferTotals(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutgoingTransferTotals", reflect.TypeOf((*MockStore)(nil).GetOutgoingTransferTotals), ctx, accountID)
+}
+
+// GetOutgoingTransferVelocity mocks base method.
+func (m *MockStore) GetOutgoingTransferVelocity(ctx context.Context, arg db.GetOutgoingTransferVelocityParams) (db.GetOutgoingTransferVelocityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutgoingTransferVelocity", ctx, arg)
+	ret0, _ := ret[0].(db.GetOutgoingTransferVelocityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutgoingTransferVelocity indicates an expected call of GetOutgoingTransferVelocity.
+func (mr *MockStoreMockRecorder) GetOutgoingTransferVelocity(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutgoingTransferVelocity", reflect.TypeOf((*MockStore)(nil).GetOutgoingTransferVelocity), ctx, arg)
+}
+
+// GetSession mocks base method.
+func (m *MockStore) GetSession(ctx context.Context, id uuid.UUID) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", ctx, id)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockStoreMockRecorder) GetSession(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockStore)(nil).GetSession), ctx, id)
+}
+
+// GetSystemAccountID mocks base method.
+func (m *MockStore) GetSystemAccountID(ctx context.Context, arg db.GetSystemAccountIDParams) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSystemAccountID", ctx, arg)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSystemAccountID indicates an expected call of GetSystemAccountID.
+func (mr *MockStoreMockRecorder) GetSystemAccountID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemAccountID", reflect.TypeOf((*MockStore)(nil).GetSystemAccountID), ctx, arg)
+}
+
+// GetTransfer mocks base method.
+func (m *MockStore) GetTransfer(ctx context.Context, id int32) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransfer", ctx, id)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransfer indicates an expected call of GetTransfer.
+func (mr *MockStoreMockRecorder) GetTransfer(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockStore)(nil).GetTransfer), ctx, id)
+}
+
+// GetTransferAllowance mocks base method.
+func (m *MockStore) GetTransferAllowance(ctx context.Context, account db.Account) (db.TransferAllowance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferAllowance", ctx, account)
+	ret0, _ := ret[0].(db.TransferAllowance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferAllowance indicates an expected call of GetTransferAllowance.
+func (mr *MockStoreMockRecorder) GetTransferAllowance(ctx, account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferAllowance", reflect.TypeOf((*MockStore)(nil).GetTransferAllowance), ctx, account)
+}
+
+// GetTransferForUpdate mocks base method.
+func (m *MockStore) GetTransferForUpdate(ctx context.Context, id int32) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferForUpdate indicates an expected call of GetTransferForUpdate.
+func (mr *MockStoreMockRecorder) GetTransferForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferForUpdate", reflect.TypeOf((*MockStore)(nil).GetTransferForUpdate), ctx, id)
+}
+
+// GetUser mocks base method.
+func (m *MockStore) GetUser(ctx context.Context, username string) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, username)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockStoreMockRecorder) GetUser(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), ctx, username)
+}
+
+// GetUserByID mocks base method.
+func (m *MockStore) GetUserByID(ctx context.Context, id int32) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockStoreMockRecorder) GetUserByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockStore)(nil).GetUserByID), ctx, id)
+}
+
+// GetUserForUpdate mocks base method.
+func (m *MockStore) GetUserForUpdate(ctx context.Context, id int32) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserForUpdate indicates an expected call of GetUserForUpdate.
+func (mr *MockStoreMockRecorder) GetUserForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserForUpdate", reflect.TypeOf((*MockStore)(nil).GetUserForUpdate), ctx, id)
+}
+
+// ListAccount mocks base method.
+func (m *MockStore) ListAccount(ctx context.Context, arg db.ListAccountParams) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccount", ctx, arg)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccount indicates an expected call of ListAccount.
+func (mr *MockStoreMockRecorder) ListAccount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccount", reflect.TypeOf((*MockStore)(nil).ListAccount), ctx, arg)
+}
+
+// ListAccountBalanceMismatches mocks base method.
+func (m *MockStore) ListAccountBalanceMismatches(ctx context.Context) ([]db.ListAccountBalanceMismatchesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountBalanceMismatches", ctx)
+	ret0, _ := ret[0].([]db.ListAccountBalanceMismatchesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountBalanceMismatches indicates an expected call of ListAccountBalanceMismatches.
+func (mr *MockStoreMockRecorder) ListAccountBalanceMismatches(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountBalanceMismatches", reflect.TypeOf((*MockStore)(nil).ListAccountBalanceMismatches), ctx)
+}
+
+// ListAccountHistory mocks base method.
+func (m *MockStore) ListAccountHistory(ctx context.Context, arg db.ListAccountHistoryParams) ([]db.ListAccountHistoryRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountHistory", ctx, arg)
+	ret0, _ := ret[0].([]db.ListAccountHistoryRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountHistory indicates an expected call of ListAccountHistory.
+func (mr *MockStoreMockRecorder) ListAccountHistory(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountHistory", reflect.TypeOf((*MockStore)(nil).ListAccountHistory), ctx, arg)
+}
+
+// ListAccountProducts mocks base method.
+func (m *MockStore) ListAccountProducts(ctx context.Context) ([]db.AccountProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountProducts", ctx)
+	ret0, _ := ret[0].([]db.AccountProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountProducts indicates an expected call of ListAccountProducts.
+func (mr *MockStoreMockRecorder) ListAccountProducts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountProducts", reflect.TypeOf((*MockStore)(nil).ListAccountProducts), ctx)
+}
+
+// ListAccountsDueMaintenanceFee mocks base method.
+func (m *MockStore) ListAccountsDueMaintenanceFee(ctx context.Context, arg db.ListAccountsDueMaintenanceFeeParams) ([]int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountsDueMaintenanceFee", ctx, arg)
+	ret0, _ := ret[0].([]int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountsDueMaintenanceFee indicates an expected call of ListAccountsDueMaintenanceFee.
+func (mr *MockStoreMockRecorder) ListAccountsDueMaintenanceFee(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountsDueMaintenanceFee", reflect.TypeOf((*MockStore)(nil).ListAccountsDueMaintenanceFee), ctx, arg)
+}
+
+// ListAccountsWithUnpostedInterest mocks base method.
+func (m *MockStore) ListAccountsWithUnpostedInterest(ctx context.Context, arg db.ListAccountsWithUnpostedInterestParams) ([]int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountsWithUnpostedInterest", ctx, arg)
+	ret0, _ := ret[0].([]int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountsWithUnpostedInterest indicates an expected call of ListAccountsWithUnpostedInterest.
+func (mr *MockStoreMockRecorder) ListAccountsWithUnpostedInterest(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountsWithUnpostedInterest", reflect.TypeOf((*MockStore)(nil).ListAccountsWithUnpostedInterest), ctx, arg)
+}
+
+// ListApprovalEvents mocks base method.
+func (m *MockStore) ListApprovalEvents(ctx context.Context, requestID int64) ([]db.ApprovalEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListApprovalEvents", ctx, requestID)
+	ret0, _ := ret[0].([]db.ApprovalEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListApprovalEvents indicates an expected call of ListApprovalEvents.
+func (mr *MockStoreMockRecorder) ListApprovalEvents(ctx, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApprovalEvents", reflect.TypeOf((*MockStore)(nil).ListApprovalEvents), ctx, requestID)
+}
+
+// ListApprovalRequests mocks base method.
+func (m *MockStore) ListApprovalRequests(ctx context.Context, arg db.ListApprovalRequestsParams) ([]db.ApprovalRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListApprovalRequests", ctx, arg)
+	ret0, _ := ret[0].([]db.ApprovalRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListApprovalRequests indicates an expected call of ListApprovalRequests.
+func (mr *MockStoreMockRecorder) ListApprovalRequests(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApprovalRequests", reflect.TypeOf((*MockStore)(nil).ListApprovalRequests), ctx, arg)
+}
+
+// ListAuditLog mocks base method.
+func (m *MockStore) ListAuditLog(ctx context.Context, arg db.ListAuditLogParams) ([]db.AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditLog", ctx, arg)
+	ret0, _ := ret[0].([]db.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditLog indicates an expected call of ListAuditLog.
+func (mr *MockStoreMockRecorder) ListAuditLog(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLog", reflect.TypeOf((*MockStore)(nil).ListAuditLog), ctx, arg)
+}
+
+// ListAuditLogChain mocks base method.
+func (m *MockStore) ListAuditLogChain(ctx context.Context, arg db.ListAuditLogChainParams) ([]db.AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditLogChain", ctx, arg)
+	ret0, _ := ret[0].([]db.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditLogChain indicates an expected call of ListAuditLogChain.
+func (mr *MockStoreMockRecorder) ListAuditLogChain(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLogChain", reflect.TypeOf((*MockStore)(nil).ListAuditLogChain), ctx, arg)
+}
+
+// ListCategoryRules mocks base method.
+func (m *MockStore) ListCategoryRules(ctx context.Context, userID int32) ([]db.CategoryRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategoryRules", ctx, userID)
+	ret0, _ := ret[0].([]db.CategoryRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategoryRules indicates an expected call of ListCategoryRules.
+func (mr *MockStoreMockRecorder) ListCategoryRules(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategoryRules", reflect.TypeOf((*MockStore)(nil).ListCategoryRules), ctx, userID)
+}
+
+// ListEntries mocks base method.
+func (m *MockStore) ListEntries(ctx context.Context, arg db.ListEntriesParams) ([]db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntries", ctx, arg)
+	ret0, _ := ret[0].([]db.Entry)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -262,22 +1131,710 @@ func (m *MockStore) ListEntries(ctx context.Context, arg db.ListEntriesParams) (
 // ListEntries indicates an expected call of ListEntries.
 func (mr *MockStoreMockRecorder) ListEntries(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockStore)(nil).ListEntries), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockStore)(nil).ListEntries), ctx, arg)
+}
+
+// ListExpiredApprovalRequests mocks base method.
+func (m *MockStore) ListExpiredApprovalRequests(ctx context.Context, arg db.ListExpiredApprovalRequestsParams) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiredApprovalRequests", ctx, arg)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiredApprovalRequests indicates an expected call of ListExpiredApprovalRequests.
+func (mr *MockStoreMockRecorder) ListExpiredApprovalRequests(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiredApprovalRequests", reflect.TypeOf((*MockStore)(nil).ListExpiredApprovalRequests), ctx, arg)
+}
+
+// ListFraudAlerts mocks base method.
+func (m *MockStore) ListFraudAlerts(ctx context.Context, arg db.ListFraudAlertsParams) ([]db.FraudAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFraudAlerts", ctx, arg)
+	ret0, _ := ret[0].([]db.FraudAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFraudAlerts indicates an expected call of ListFraudAlerts.
+func (mr *MockStoreMockRecorder) ListFraudAlerts(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFraudAlerts", reflect.TypeOf((*MockStore)(nil).ListFraudAlerts), ctx, arg)
+}
+
+// ListInterestBearingAccounts mocks base method.
+func (m *MockStore) ListInterestBearingAccounts(ctx context.Context, dayEnd time.Time) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInterestBearingAccounts", ctx, dayEnd)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInterestBearingAccounts indicates an expected call of ListInterestBearingAccounts.
+func (mr *MockStoreMockRecorder) ListInterestBearingAccounts(ctx, dayEnd any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInterestBearingAccounts", reflect.TypeOf((*MockStore)(nil).ListInterestBearingAccounts), ctx, dayEnd)
+}
+
+// ListInterestTiers mocks base method.
+func (m *MockStore) ListInterestTiers(ctx context.Context) ([]db.InterestTier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInterestTiers", ctx)
+	ret0, _ := ret[0].([]db.InterestTier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInterestTiers indicates an expected call of ListInterestTiers.
+func (mr *MockStoreMockRecorder) ListInterestTiers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInterestTiers", reflect.TypeOf((*MockStore)(nil).ListInterestTiers), ctx)
+}
+
+// ListJournalEntries mocks base method.
+func (m *MockStore) ListJournalEntries(ctx context.Context, journalID int64) ([]db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJournalEntries", ctx, journalID)
+	ret0, _ := ret[0].([]db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJournalEntries indicates an expected call of ListJournalEntries.
+func (mr *MockStoreMockRecorder) ListJournalEntries(ctx, journalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJournalEntries", reflect.TypeOf((*MockStore)(nil).ListJournalEntries), ctx, journalID)
+}
+
+// ListScreeningMatches mocks base method.
+func (m *MockStore) ListScreeningMatches(ctx context.Context, arg db.ListScreeningMatchesParams) ([]db.ScreeningMatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScreeningMatches", ctx, arg)
+	ret0, _ := ret[0].([]db.ScreeningMatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScreeningMatches indicates an expected call of ListScreeningMatches.
+func (mr *MockStoreMockRecorder) ListScreeningMatches(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScreeningMatches", reflect.TypeOf((*MockStore)(nil).ListScreeningMatches), ctx, arg)
+}
+
+// ListSessionIPs mocks base method.
+func (m *MockStore) ListSessionIPs(ctx context.Context, arg db.ListSessionIPsParams) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessionIPs", ctx, arg)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessionIPs indicates an expected call of ListSessionIPs.
+func (mr *MockStoreMockRecorder) ListSessionIPs(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessionIPs", reflect.TypeOf((*MockStore)(nil).ListSessionIPs), ctx, arg)
+}
+
+// ListTransfer mocks base method.
+func (m *MockStore) ListTransfer(ctx context.Context, arg db.ListTransferParams) ([]db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransfer", ctx, arg)
+	ret0, _ := ret[0].([]db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransfer indicates an expected call of ListTransfer.
+func (mr *MockStoreMockRecorder) ListTransfer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfer", reflect.TypeOf((*MockStore)(nil).ListTransfer), ctx, arg)
+}
+
+// ListUnbalancedJournals mocks base method.
+func (m *MockStore) ListUnbalancedJournals(ctx context.Context) ([]db.ListUnbalancedJournalsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUnbalancedJournals", ctx)
+	ret0, _ := ret[0].([]db.ListUnbalancedJournalsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUnbalancedJournals indicates an expected call of ListUnbalancedJournals.
+func (mr *MockStoreMockRecorder) ListUnbalancedJournals(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnbalancedJournals", reflect.TypeOf((*MockStore)(nil).ListUnbalancedJournals), ctx)
+}
+
+// ListUnclearedAccountOwners mocks base method.
+func (m *MockStore) ListUnclearedAccountOwners(ctx context.Context, accountIds []int32) ([]db.ListUnclearedAccountOwnersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUnclearedAccountOwners", ctx, accountIds)
+	ret0, _ := ret[0].([]db.ListUnclearedAccountOwnersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUnclearedAccountOwners indicates an expected call of ListUnclearedAccountOwners.
+func (mr *MockStoreMockRecorder) ListUnclearedAccountOwners(ctx, accountIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnclearedAccountOwners", reflect.TypeOf((*MockStore)(nil).ListUnclearedAccountOwners), ctx, accountIds)
+}
+
+// ListUnscreenedTransfers mocks base method.
+func (m *MockStore) ListUnscreenedTransfers(ctx context.Context, batchSize int32) ([]db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUnscreenedTransfers", ctx, batchSize)
+	ret0, _ := ret[0].([]db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUnscreenedTransfers indicates an expected call of ListUnscreenedTransfers.
+func (mr *MockStoreMockRecorder) ListUnscreenedTransfers(ctx, batchSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnscreenedTransfers", reflect.TypeOf((*MockStore)(nil).ListUnscreenedTransfers), ctx, batchSize)
+}
+
+// ListUsersForScreening mocks base method.
+func (m *MockStore) ListUsersForScreening(ctx context.Context, arg db.ListUsersForScreeningParams) ([]db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsersForScreening", ctx, arg)
+	ret0, _ := ret[0].([]db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsersForScreening indicates an expected call of ListUsersForScreening.
+func (mr *MockStoreMockRecorder) ListUsersForScreening(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersForScreening", reflect.TypeOf((*MockStore)(nil).ListUsersForScreening), ctx, arg)
+}
+
+// LockAuditLog mocks base method.
+func (m *MockStore) LockAuditLog(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAuditLog", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockAuditLog indicates an expected call of LockAuditLog.
+func (mr *MockStoreMockRecorder) LockAuditLog(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAuditLog", reflect.TypeOf((*MockStore)(nil).LockAuditLog), ctx)
+}
+
+// MarkInterestAccrualsPosted mocks base method.
+func (m *MockStore) MarkInterestAccrualsPosted(ctx context.Context, arg db.MarkInterestAccrualsPostedParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkInterestAccrualsPosted", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkInterestAccrualsPosted indicates an expected call of MarkInterestAccrualsPosted.
+func (mr *MockStoreMockRecorder) MarkInterestAccrualsPosted(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkInterestAccrualsPosted", reflect.TypeOf((*MockStore)(nil).MarkInterestAccrualsPosted), ctx, arg)
+}
+
+// MarkSystemTransfersScreened mocks base method.
+func (m *MockStore) MarkSystemTransfersScreened(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSystemTransfersScreened", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkSystemTransfersScreened indicates an expected call of MarkSystemTransfersScreened.
+func (mr *MockStoreMockRecorder) MarkSystemTransfersScreened(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSystemTransfersScreened", reflect.TypeOf((*MockStore)(nil).MarkSystemTransfersScreened), ctx)
+}
+
+// MarkTransferScreened mocks base method.
+func (m *MockStore) MarkTransferScreened(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkTransferScreened", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkTransferScreened indicates an expected call of MarkTransferScreened.
+func (mr *MockStoreMockRecorder) MarkTransferScreened(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkTransferScreened", reflect.TypeOf((*MockStore)(nil).MarkTransferScreened), ctx, id)
+}
+
+// MarkUserScreened mocks base method.
+func (m *MockStore) MarkUserScreened(ctx context.Context, id int32) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUserScreened", ctx, id)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkUserScreened indicates an expected call of MarkUserScreened.
+func (mr *MockStoreMockRecorder) MarkUserScreened(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUserScreened", reflect.TypeOf((*MockStore)(nil).MarkUserScreened), ctx, id)
+}
+
+// MigrationVersion mocks base method.
+func (m *MockStore) MigrationVersion(ctx context.Context) (uint, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrationVersion", ctx)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MigrationVersion indicates an expected call of MigrationVersion.
+func (mr *MockStoreMockRecorder) MigrationVersion(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrationVersion", reflect.TypeOf((*MockStore)(nil).MigrationVersion), ctx)
+}
+
+// Ping mocks base method.
+func (m *MockStore) Ping(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockStoreMockRecorder) Ping(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockStore)(nil).Ping), ctx)
+}
+
+// PostInterestTx mocks base method.
+func (m *MockStore) PostInterestTx(ctx context.Context, args db.PostInterestTxParams) (db.PostInterestTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostInterestTx", ctx, args)
+	ret0, _ := ret[0].(db.PostInterestTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostInterestTx indicates an expected call of PostInterestTx.
+func (mr *MockStoreMockRecorder) PostInterestTx(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostInterestTx", reflect.TypeOf((*MockStore)(nil).PostInterestTx), ctx, args)
+}
+
+// QuoteTransferFee mocks base method.
+func (m *MockStore) QuoteTransferFee(ctx context.Context, fromAccount db.Account, amount int32) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteTransferFee", ctx, fromAccount, amount)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuoteTransferFee indicates an expected call of QuoteTransferFee.
+func (mr *MockStoreMockRecorder) QuoteTransferFee(ctx, fromAccount, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteTransferFee", reflect.TypeOf((*MockStore)(nil).QuoteTransferFee), ctx, fromAccount, amount)
+}
+
+// RecordScreeningMatchesTx mocks base method.
+func (m *MockStore) RecordScreeningMatchesTx(ctx context.Context, args db.RecordScreeningMatchesTxParams) (db.RecordScreeningMatchesTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordScreeningMatchesTx", ctx, args)
+	ret0, _ := ret[0].(db.RecordScreeningMatchesTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordScreeningMatchesTx indicates an expected call of RecordScreeningMatchesTx.
+func (mr *MockStoreMockRecorder) RecordScreeningMatchesTx(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordScreeningMatchesTx", reflect.TypeOf((*MockStore)(nil).RecordScreeningMatchesTx), ctx, args)
 }
 
-// ListTransfer mocks base method.
-func (m *MockStore) ListTransfer(ctx context.Context, arg db.ListTransferParams) ([]db.Transfer, error) {
+// RejectCashMovement mocks base method.
+func (m *MockStore) RejectCashMovement(ctx context.Context, arg db.RejectCashMovementParams) (db.CashMovement, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTransfer", ctx, arg)
-	ret0, _ := ret[0].([]db.Transfer)
+	ret := m.ctrl.Call(m, "RejectCashMovement", ctx, arg)
+	ret0, _ := ret[0].(db.CashMovement)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListTransfer indicates an expected call of ListTransfer.
-func (mr *MockStoreMockRecorder) ListTransfer(ctx, arg any) *gomock.Call {
+// RejectCashMovement indicates an expected call of RejectCashMovement.
+func (mr *MockStoreMockRecorder) RejectCashMovement(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfer", reflect.TypeOf((*MockStore)(nil).ListTransfer), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectCashMovement", reflect.TypeOf((*MockStore)(nil).RejectCashMovement), ctx, arg)
+}
+
+// RequestApprovalTx mocks base method.
+func (m *MockStore) RequestApprovalTx(ctx context.Context, args db.RequestApprovalTxParams) (db.ApprovalRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestApprovalTx", ctx, args)
+	ret0, _ := ret[0].(db.ApprovalRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestApprovalTx indicates an expected call of RequestApprovalTx.
+func (mr *MockStoreMockRecorder) RequestApprovalTx(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestApprovalTx", reflect.TypeOf((*MockStore)(nil).RequestApprovalTx), ctx, args)
+}
+
+// ResolveFraudAlert mocks base method.
+func (m *MockStore) ResolveFraudAlert(ctx context.Context, arg db.ResolveFraudAlertParams) (db.FraudAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveFraudAlert", ctx, arg)
+	ret0, _ := ret[0].(db.FraudAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveFraudAlert indicates an expected call of ResolveFraudAlert.
+func (mr *MockStoreMockRecorder) ResolveFraudAlert(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveFraudAlert", reflect.TypeOf((*MockStore)(nil).ResolveFraudAlert), ctx, arg)
+}
+
+// ResolveFraudAlertTx mocks base method.
+func (m *MockStore) ResolveFraudAlertTx(ctx context.Context, args db.ResolveFraudAlertTxParams) (db.ResolveFraudAlertTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveFraudAlertTx", ctx, args)
+	ret0, _ := ret[0].(db.ResolveFraudAlertTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveFraudAlertTx indicates an expected call of ResolveFraudAlertTx.
+func (mr *MockStoreMockRecorder) ResolveFraudAlertTx(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveFraudAlertTx", reflect.TypeOf((*MockStore)(nil).ResolveFraudAlertTx), ctx, args)
+}
+
+// ResolveScreeningReviewTx mocks base method.
+func (m *MockStore) ResolveScreeningReviewTx(ctx context.Context, args db.ResolveScreeningReviewTxParams) (db.ResolveScreeningReviewTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveScreeningReviewTx", ctx, args)
+	ret0, _ := ret[0].(db.ResolveScreeningReviewTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveScreeningReviewTx indicates an expected call of ResolveScreeningReviewTx.
+func (mr *MockStoreMockRecorder) ResolveScreeningReviewTx(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveScreeningReviewTx", reflect.TypeOf((*MockStore)(nil).ResolveScreeningReviewTx), ctx, args)
+}
+
+// ReviewScreeningMatches mocks base method.
+func (m *MockStore) ReviewScreeningMatches(ctx context.Context, arg db.ReviewScreeningMatchesParams) ([]db.ScreeningMatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewScreeningMatches", ctx, arg)
+	ret0, _ := ret[0].([]db.ScreeningMatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewScreeningMatches indicates an expected call of ReviewScreeningMatches.
+func (mr *MockStoreMockRecorder) ReviewScreeningMatches(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewScreeningMatches", reflect.TypeOf((*MockStore)(nil).ReviewScreeningMatches), ctx, arg)
+}
+
+// SearchAccounts mocks base method.
+func (m *MockStore) SearchAccounts(ctx context.Context, arg db.SearchAccountsParams) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAccounts", ctx, arg)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAccounts indicates an expected call of SearchAccounts.
+func (mr *MockStoreMockRecorder) SearchAccounts(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAccounts", reflect.TypeOf((*MockStore)(nil).SearchAccounts), ctx, arg)
+}
+
+// SearchAccountsByBalance mocks base method.
+func (m *MockStore) SearchAccountsByBalance(ctx context.Context, arg db.SearchAccountsByBalanceParams) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAccountsByBalance", ctx, arg)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAccountsByBalance indicates an expected call of SearchAccountsByBalance.
+func (mr *MockStoreMockRecorder) SearchAccountsByBalance(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAccountsByBalance", reflect.TypeOf((*MockStore)(nil).SearchAccountsByBalance), ctx, arg)
+}
+
+// SearchAccountsByBalanceDesc mocks base method.
+func (m *MockStore) SearchAccountsByBalanceDesc(ctx context.Context, arg db.SearchAccountsByBalanceDescParams) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAccountsByBalanceDesc", ctx, arg)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAccountsByBalanceDesc indicates an expected call of SearchAccountsByBalanceDesc.
+func (mr *MockStoreMockRecorder) SearchAccountsByBalanceDesc(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAccountsByBalanceDesc", reflect.TypeOf((*MockStore)(nil).SearchAccountsByBalanceDesc), ctx, arg)
+}
+
+// SearchAccountsByCreatedAt mocks base method.
+func (m *MockStore) SearchAccountsByCreatedAt(ctx context.Context, arg db.SearchAccountsByCreatedAtParams) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAccountsByCreatedAt", ctx, arg)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAccountsByCreatedAt indicates an expected call of SearchAccountsByCreatedAt.
+func (mr *MockStoreMockRecorder) SearchAccountsByCreatedAt(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAccountsByCreatedAt", reflect.TypeOf((*MockStore)(nil).SearchAccountsByCreatedAt), ctx, arg)
+}
+
+// SearchAccountsByCreatedAtDesc mocks base method.
+func (m *MockStore) SearchAccountsByCreatedAtDesc(ctx context.Context, arg db.SearchAccountsByCreatedAtDescParams) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAccountsByCreatedAtDesc", ctx, arg)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAccountsByCreatedAtDesc indicates an expected call of SearchAccountsByCreatedAtDesc.
+func (mr *MockStoreMockRecorder) SearchAccountsByCreatedAtDesc(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAccountsByCreatedAtDesc", reflect.TypeOf((*MockStore)(nil).SearchAccountsByCreatedAtDesc), ctx, arg)
+}
+
+// SearchAccountsByID mocks base method.
+func (m *MockStore) SearchAccountsByID(ctx context.Context, arg db.SearchAccountsByIDParams) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAccountsByID", ctx, arg)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAccountsByID indicates an expected call of SearchAccountsByID.
+func (mr *MockStoreMockRecorder) SearchAccountsByID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAccountsByID", reflect.TypeOf((*MockStore)(nil).SearchAccountsByID), ctx, arg)
+}
+
+// SearchAccountsByIDDesc mocks base method.
+func (m *MockStore) SearchAccountsByIDDesc(ctx context.Context, arg db.SearchAccountsByIDDescParams) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAccountsByIDDesc", ctx, arg)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAccountsByIDDesc indicates an expected call of SearchAccountsByIDDesc.
+func (mr *MockStoreMockRecorder) SearchAccountsByIDDesc(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAccountsByIDDesc", reflect.TypeOf((*MockStore)(nil).SearchAccountsByIDDesc), ctx, arg)
+}
+
+// SearchUsers mocks base method.
+func (m *MockStore) SearchUsers(ctx context.Context, arg db.SearchUsersParams) ([]db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUsers", ctx, arg)
+	ret0, _ := ret[0].([]db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchUsers indicates an expected call of SearchUsers.
+func (mr *MockStoreMockRecorder) SearchUsers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*MockStore)(nil).SearchUsers), ctx, arg)
+}
+
+// SearchUsersByCreatedAt mocks base method.
+func (m *MockStore) SearchUsersByCreatedAt(ctx context.Context, arg db.SearchUsersByCreatedAtParams) ([]db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUsersByCreatedAt", ctx, arg)
+	ret0, _ := ret[0].([]db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchUsersByCreatedAt indicates an expected call of SearchUsersByCreatedAt.
+func (mr *MockStoreMockRecorder) SearchUsersByCreatedAt(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsersByCreatedAt", reflect.TypeOf((*MockStore)(nil).SearchUsersByCreatedAt), ctx, arg)
+}
+
+// SearchUsersByCreatedAtDesc mocks base method.
+func (m *MockStore) SearchUsersByCreatedAtDesc(ctx context.Context, arg db.SearchUsersByCreatedAtDescParams) ([]db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUsersByCreatedAtDesc", ctx, arg)
+	ret0, _ := ret[0].([]db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchUsersByCreatedAtDesc indicates an expected call of SearchUsersByCreatedAtDesc.
+func (mr *MockStoreMockRecorder) SearchUsersByCreatedAtDesc(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsersByCreatedAtDesc", reflect.TypeOf((*MockStore)(nil).SearchUsersByCreatedAtDesc), ctx, arg)
+}
+
+// SearchUsersByID mocks base method.
+func (m *MockStore) SearchUsersByID(ctx context.Context, arg db.SearchUsersByIDParams) ([]db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUsersByID", ctx, arg)
+	ret0, _ := ret[0].([]db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchUsersByID indicates an expected call of SearchUsersByID.
+func (mr *MockStoreMockRecorder) SearchUsersByID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsersByID", reflect.TypeOf((*MockStore)(nil).SearchUsersByID), ctx, arg)
+}
+
+// SearchUsersByIDDesc mocks base method.
+func (m *MockStore) SearchUsersByIDDesc(ctx context.Context, arg db.SearchUsersByIDDescParams) ([]db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUsersByIDDesc", ctx, arg)
+	ret0, _ := ret[0].([]db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchUsersByIDDesc indicates an expected call of SearchUsersByIDDesc.
+func (mr *MockStoreMockRecorder) SearchUsersByIDDesc(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsersByIDDesc", reflect.TypeOf((*MockStore)(nil).SearchUsersByIDDesc), ctx, arg)
+}
+
+// SearchUsersByUsername mocks base method.
+func (m *MockStore) SearchUsersByUsername(ctx context.Context, arg db.SearchUsersByUsernameParams) ([]db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUsersByUsername", ctx, arg)
+	ret0, _ := ret[0].([]db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchUsersByUsername indicates an expected call of SearchUsersByUsername.
+func (mr *MockStoreMockRecorder) SearchUsersByUsername(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsersByUsername", reflect.TypeOf((*MockStore)(nil).SearchUsersByUsername), ctx, arg)
+}
+
+// SearchUsersByUsernameDesc mocks base method.
+func (m *MockStore) SearchUsersByUsernameDesc(ctx context.Context, arg db.SearchUsersByUsernameDescParams) ([]db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUsersByUsernameDesc", ctx, arg)
+	ret0, _ := ret[0].([]db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchUsersByUsernameDesc indicates an expected call of SearchUsersByUsernameDesc.
+func (mr *MockStoreMockRecorder) SearchUsersByUsernameDesc(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsersByUsernameDesc", reflect.TypeOf((*MockStore)(nil).SearchUsersByUsernameDesc), ctx, arg)
+}
+
+// SetEntryCategory mocks base method.
+func (m *MockStore) SetEntryCategory(ctx context.Context, arg db.SetEntryCategoryParams) (db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEntryCategory", ctx, arg)
+	ret0, _ := ret[0].(db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetEntryCategory indicates an expected call of SetEntryCategory.
+func (mr *MockStoreMockRecorder) SetEntryCategory(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEntryCategory", reflect.TypeOf((*MockStore)(nil).SetEntryCategory), ctx, arg)
+}
+
+// SetFeeEntry mocks base method.
+func (m *MockStore) SetFeeEntry(ctx context.Context, arg db.SetFeeEntryParams) (db.Fee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFeeEntry", ctx, arg)
+	ret0, _ := ret[0].(db.Fee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetFeeEntry indicates an expected call of SetFeeEntry.
+func (mr *MockStoreMockRecorder) SetFeeEntry(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeeEntry", reflect.TypeOf((*MockStore)(nil).SetFeeEntry), ctx, arg)
+}
+
+// SetTransferLimitsTx mocks base method.
+func (m *MockStore) SetTransferLimitsTx(ctx context.Context, args db.SetTransferLimitsTxParams) (db.SetTransferLimitsTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTransferLimitsTx", ctx, args)
+	ret0, _ := ret[0].(db.SetTransferLimitsTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTransferLimitsTx indicates an expected call of SetTransferLimitsTx.
+func (mr *MockStoreMockRecorder) SetTransferLimitsTx(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransferLimitsTx", reflect.TypeOf((*MockStore)(nil).SetTransferLimitsTx), ctx, args)
+}
+
+// SetUserScreeningStatus mocks base method.
+func (m *MockStore) SetUserScreeningStatus(ctx context.Context, arg db.SetUserScreeningStatusParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserScreeningStatus", ctx, arg)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetUserScreeningStatus indicates an expected call of SetUserScreeningStatus.
+func (mr *MockStoreMockRecorder) SetUserScreeningStatus(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserScreeningStatus", reflect.TypeOf((*MockStore)(nil).SetUserScreeningStatus), ctx, arg)
+}
+
+// TakeRateLimitToken mocks base method.
+func (m *MockStore) TakeRateLimitToken(ctx context.Context, arg db.TakeRateLimitTokenParams) (db.TakeRateLimitTokenRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeRateLimitToken", ctx, arg)
+	ret0, _ := ret[0].(db.TakeRateLimitTokenRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakeRateLimitToken indicates an expected call of TakeRateLimitToken.
+func (mr *MockStoreMockRecorder) TakeRateLimitToken(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeRateLimitToken", reflect.TypeOf((*MockStore)(nil).TakeRateLimitToken), ctx, arg)
 }
 
 // TransferTx mocks base method.
@@ -295,19 +1852,49 @@ func (mr *MockStoreMockRecorder) TransferTx(ctx, args any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferTx", reflect.TypeOf((*MockStore)(nil).TransferTx), ctx, args)
 }
 
-// UpdateAccount mocks base method.
-func (m *MockStore) UpdateAccount(ctx context.Context, arg db.UpdateAccountParams) (db.Account, error) {
+// UpdateAccountStatus mocks base method.
+func (m *MockStore) UpdateAccountStatus(ctx context.Context, arg db.UpdateAccountStatusParams) (db.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateAccount", ctx, arg)
+	ret := m.ctrl.Call(m, "UpdateAccountStatus", ctx, arg)
 	ret0, _ := ret[0].(db.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateAccount indicates an expected call of UpdateAccount.
-func (mr *MockStoreMockRecorder) UpdateAccount(ctx, arg any) *gomock.Call {
+// UpdateAccountStatus indicates an expected call of UpdateAccountStatus.
+func (mr *MockStoreMockRecorder) UpdateAccountStatus(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccountStatus", reflect.TypeOf((*MockStore)(nil).UpdateAccountStatus), ctx, arg)
+}
+
+// UpdateInterestPosting mocks base method.
+func (m *MockStore) UpdateInterestPosting(ctx context.Context, arg db.UpdateInterestPostingParams) (db.InterestPosting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInterestPosting", ctx, arg)
+	ret0, _ := ret[0].(db.InterestPosting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateInterestPosting indicates an expected call of UpdateInterestPosting.
+func (mr *MockStoreMockRecorder) UpdateInterestPosting(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInterestPosting", reflect.TypeOf((*MockStore)(nil).UpdateInterestPosting), ctx, arg)
+}
+
+// UpdateTransferStatus mocks base method.
+func (m *MockStore) UpdateTransferStatus(ctx context.Context, arg db.UpdateTransferStatusParams) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransferStatus", ctx, arg)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTransferStatus indicates an expected call of UpdateTransferStatus.
+func (mr *MockStoreMockRecorder) UpdateTransferStatus(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockStore)(nil).UpdateAccount), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransferStatus", reflect.TypeOf((*MockStore)(nil).UpdateTransferStatus), ctx, arg)
 }
 
 // UpdateUser mocks base method.
@@ -324,3 +1911,48 @@ func (mr *MockStoreMockRecorder) UpdateUser(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockStore)(nil).UpdateUser), ctx, arg)
 }
+
+// UpdateUserRole mocks base method.
+func (m *MockStore) UpdateUserRole(ctx context.Context, arg db.UpdateUserRoleParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserRole", ctx, arg)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserRole indicates an expected call of UpdateUserRole.
+func (mr *MockStoreMockRecorder) UpdateUserRole(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserRole", reflect.TypeOf((*MockStore)(nil).UpdateUserRole), ctx, arg)
+}
+
+// UpdateUserTx mocks base method.
+func (m *MockStore) UpdateUserTx(ctx context.Context, args db.UpdateUserTxParams) (db.UpdateUserTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserTx", ctx, args)
+	ret0, _ := ret[0].(db.UpdateUserTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserTx indicates an expected call of UpdateUserTx.
+func (mr *MockStoreMockRecorder) UpdateUserTx(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserTx", reflect.TypeOf((*MockStore)(nil).UpdateUserTx), ctx, args)
+}
+
+// UpsertAccountLimits mocks base method.
+func (m *MockStore) UpsertAccountLimits(ctx context.Context, arg db.UpsertAccountLimitsParams) (db.AccountLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertAccountLimits", ctx, arg)
+	ret0, _ := ret[0].(db.AccountLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertAccountLimits indicates an expected call of UpsertAccountLimits.
+func (mr *MockStoreMockRecorder) UpsertAccountLimits(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAccountLimits", reflect.TypeOf((*MockStore)(nil).UpsertAccountLimits), ctx, arg)
+}
@@ -0,0 +1,22 @@
+-- name: GetAccountProduct :one
+SELECT * FROM account_products
+WHERE code = $1 LIMIT 1;
+
+-- name: ListAccountProducts :many
+SELECT * FROM account_products
+WHERE NOT internal
+ORDER BY code;
+
+-- name: CountMonthlyWithdrawals :one
+SELECT ((
+  SELECT count(*) FROM transfers
+  WHERE from_account_id = sqlc.arg(account_id)
+    AND status = 'completed'
+    AND created_at >= date_trunc('month', now())
+) + (
+  SELECT count(*) FROM cash_movements
+  WHERE account_id = sqlc.arg(account_id)
+    AND direction = 'withdrawal'
+    AND status = 'completed'
+    AND created_at >= date_trunc('month', now())
+))::bigint AS withdrawals;
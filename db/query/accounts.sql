@@ -2,6 +2,11 @@
 SELECT * FROM accounts
 WHERE id = $1 LIMIT 1;
 
+-- name: GetAccountForUpdate :one
+SELECT * FROM accounts
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
 -- name: ListAccount :many
 SELECT * FROM accounts
 WHERE owner_id = $1
@@ -12,19 +17,12 @@ OFFSET $3;
 -- name: CreateAccount :one
 INSERT INTO accounts (
   owner_id,
-  balance,
-  currency
+  currency,
+  product_code
 ) VALUES (
   $1, $2, $3
 )RETURNING *;
 
--- name: UpdateAccount :one
-UPDATE accounts
-SET
-  balance = $2
-WHERE id = $1
-RETURNING *;
-
 -- name: AddAccountBalance :one
 UPDATE accounts
 SET
@@ -32,7 +30,100 @@ SET
 WHERE id = sqlc.arg(id)
 RETURNING *;
 
+-- name: UpdateAccountStatus :one
+UPDATE accounts
+SET
+  status = sqlc.arg(status),
+  status_reason = sqlc.arg(status_reason),
+  status_changed_by = sqlc.arg(status_changed_by),
+  status_changed_at = now()
+WHERE id = sqlc.arg(id) AND status = sqlc.arg(current_status)
+RETURNING *;
 
 -- name: DeleteAccount :exec
 DELETE FROM accounts
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1;
+
+-- name: SearchAccountsByID :many
+SELECT * FROM accounts
+WHERE
+  (sqlc.narg(owner_id)::int IS NULL OR owner_id = sqlc.narg(owner_id)::int)
+  AND (sqlc.narg(currency)::varchar IS NULL OR currency = sqlc.narg(currency)::varchar)
+  AND (sqlc.narg(min_balance)::int IS NULL OR balance >= sqlc.narg(min_balance)::int)
+  AND (sqlc.narg(max_balance)::int IS NULL OR balance <= sqlc.narg(max_balance)::int)
+  AND (sqlc.narg(created_after)::timestamptz IS NULL OR created_at >= sqlc.narg(created_after)::timestamptz)
+  AND (sqlc.narg(created_before)::timestamptz IS NULL OR created_at < sqlc.narg(created_before)::timestamptz)
+  AND product_code NOT IN (SELECT code FROM account_products WHERE internal)
+  AND (sqlc.narg(cursor_id)::int IS NULL OR id > sqlc.narg(cursor_id)::int)
+ORDER BY id
+LIMIT sqlc.arg(page_size);
+
+-- name: SearchAccountsByIDDesc :many
+SELECT * FROM accounts
+WHERE
+  (sqlc.narg(owner_id)::int IS NULL OR owner_id = sqlc.narg(owner_id)::int)
+  AND (sqlc.narg(currency)::varchar IS NULL OR currency = sqlc.narg(currency)::varchar)
+  AND (sqlc.narg(min_balance)::int IS NULL OR balance >= sqlc.narg(min_balance)::int)
+  AND (sqlc.narg(max_balance)::int IS NULL OR balance <= sqlc.narg(max_balance)::int)
+  AND (sqlc.narg(created_after)::timestamptz IS NULL OR created_at >= sqlc.narg(created_after)::timestamptz)
+  AND (sqlc.narg(created_before)::timestamptz IS NULL OR created_at < sqlc.narg(created_before)::timestamptz)
+  AND product_code NOT IN (SELECT code FROM account_products WHERE internal)
+  AND (sqlc.narg(cursor_id)::int IS NULL OR id < sqlc.narg(cursor_id)::int)
+ORDER BY id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: SearchAccountsByBalance :many
+SELECT * FROM accounts
+WHERE
+  (sqlc.narg(owner_id)::int IS NULL OR owner_id = sqlc.narg(owner_id)::int)
+  AND (sqlc.narg(currency)::varchar IS NULL OR currency = sqlc.narg(currency)::varchar)
+  AND (sqlc.narg(min_balance)::int IS NULL OR balance >= sqlc.narg(min_balance)::int)
+  AND (sqlc.narg(max_balance)::int IS NULL OR balance <= sqlc.narg(max_balance)::int)
+  AND (sqlc.narg(created_after)::timestamptz IS NULL OR created_at >= sqlc.narg(created_after)::timestamptz)
+  AND (sqlc.narg(created_before)::timestamptz IS NULL OR created_at < sqlc.narg(created_before)::timestamptz)
+  AND product_code NOT IN (SELECT code FROM account_products WHERE internal)
+  AND (sqlc.narg(cursor_id)::int IS NULL OR (balance, id) > (sqlc.arg(cursor_balance)::int, sqlc.narg(cursor_id)::int))
+ORDER BY balance, id
+LIMIT sqlc.arg(page_size);
+
+-- name: SearchAccountsByBalanceDesc :many
+SELECT * FROM accounts
+WHERE
+  (sqlc.narg(owner_id)::int IS NULL OR owner_id = sqlc.narg(owner_id)::int)
+  AND (sqlc.narg(currency)::varchar IS NULL OR currency = sqlc.narg(currency)::varchar)
+  AND (sqlc.narg(min_balance)::int IS NULL OR balance >= sqlc.narg(min_balance)::int)
+  AND (sqlc.narg(max_balance)::int IS NULL OR balance <= sqlc.narg(max_balance)::int)
+  AND (sqlc.narg(created_after)::timestamptz IS NULL OR created_at >= sqlc.narg(created_after)::timestamptz)
+  AND (sqlc.narg(created_before)::timestamptz IS NULL OR created_at < sqlc.narg(created_before)::timestamptz)
+  AND product_code NOT IN (SELECT code FROM account_products WHERE internal)
+  AND (sqlc.narg(cursor_id)::int IS NULL OR (balance, id) < (sqlc.arg(cursor_balance)::int, sqlc.narg(cursor_id)::int))
+ORDER BY balance DESC, id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: SearchAccountsByCreatedAt :many
+SELECT * FROM accounts
+WHERE
+  (sqlc.narg(owner_id)::int IS NULL OR owner_id = sqlc.narg(owner_id)::int)
+  AND (sqlc.narg(currency)::varchar IS NULL OR currency = sqlc.narg(currency)::varchar)
+  AND (sqlc.narg(min_balance)::int IS NULL OR balance >= sqlc.narg(min_balance)::int)
+  AND (sqlc.narg(max_balance)::int IS NULL OR balance <= sqlc.narg(max_balance)::int)
+  AND (sqlc.narg(created_after)::timestamptz IS NULL OR created_at >= sqlc.narg(created_after)::timestamptz)
+  AND (sqlc.narg(created_before)::timestamptz IS NULL OR created_at < sqlc.narg(created_before)::timestamptz)
+  AND product_code NOT IN (SELECT code FROM account_products WHERE internal)
+  AND (sqlc.narg(cursor_id)::int IS NULL OR (created_at, id) > (sqlc.arg(cursor_created_at)::timestamptz, sqlc.narg(cursor_id)::int))
+ORDER BY created_at, id
+LIMIT sqlc.arg(page_size);
+
+-- name: SearchAccountsByCreatedAtDesc :many
+SELECT * FROM accounts
+WHERE
+  (sqlc.narg(owner_id)::int IS NULL OR owner_id = sqlc.narg(owner_id)::int)
+  AND (sqlc.narg(currency)::varchar IS NULL OR currency = sqlc.narg(currency)::varchar)
+  AND (sqlc.narg(min_balance)::int IS NULL OR balance >= sqlc.narg(min_balance)::int)
+  AND (sqlc.narg(max_balance)::int IS NULL OR balance <= sqlc.narg(max_balance)::int)
+  AND (sqlc.narg(created_after)::timestamptz IS NULL OR created_at >= sqlc.narg(created_after)::timestamptz)
+  AND (sqlc.narg(created_before)::timestamptz IS NULL OR created_at < sqlc.narg(created_before)::timestamptz)
+  AND product_code NOT IN (SELECT code FROM account_products WHERE internal)
+  AND (sqlc.narg(cursor_id)::int IS NULL OR (created_at, id) < (sqlc.arg(cursor_created_at)::timestamptz, sqlc.narg(cursor_id)::int))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(page_size);
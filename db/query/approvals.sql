@@ -0,0 +1,57 @@
+-- name: CreateApprovalRequest :one
+INSERT INTO approval_requests (
+  action,
+  payload,
+  summary,
+  requested_by,
+  expires_at
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: GetApprovalRequest :one
+SELECT * FROM approval_requests
+WHERE id = $1 LIMIT 1;
+
+-- name: GetApprovalRequestForUpdate :one
+SELECT * FROM approval_requests
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListApprovalRequests :many
+SELECT * FROM approval_requests
+WHERE sqlc.narg(status)::approval_status IS NULL OR status = sqlc.narg(status)::approval_status
+ORDER BY id DESC
+LIMIT $1
+OFFSET $2;
+
+-- name: ListExpiredApprovalRequests :many
+SELECT id FROM approval_requests
+WHERE status = 'pending' AND expires_at <= sqlc.arg(now)
+ORDER BY id
+LIMIT sqlc.arg(batch_size);
+
+-- name: DecideApprovalRequest :one
+UPDATE approval_requests
+SET
+  status = sqlc.arg(status),
+  decided_by = sqlc.narg(decided_by),
+  decision_reason = sqlc.arg(decision_reason),
+  decided_at = now()
+WHERE id = sqlc.arg(id) AND status = 'pending'
+RETURNING *;
+
+-- name: CreateApprovalEvent :one
+INSERT INTO approval_events (
+  request_id,
+  event,
+  actor_id,
+  reason
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: ListApprovalEvents :many
+SELECT * FROM approval_events
+WHERE request_id = $1
+ORDER BY id;
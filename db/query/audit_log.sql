@@ -0,0 +1,45 @@
+-- name: LockAuditLog :exec
+SELECT pg_advisory_xact_lock(hashtext('audit_log'));
+
+-- name: GetLastAuditLogHash :one
+SELECT hash FROM audit_log
+ORDER BY id DESC
+LIMIT 1;
+
+-- name: CreateAuditLogEntry :one
+INSERT INTO audit_log (
+  actor_id,
+  actor_role,
+  method,
+  target_type,
+  target_id,
+  before,
+  after,
+  client_ip,
+  user_agent,
+  status_code,
+  prev_hash,
+  hash,
+  created_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+) RETURNING *;
+
+-- name: ListAuditLog :many
+SELECT * FROM audit_log
+WHERE
+  (sqlc.narg(actor_id)::int IS NULL OR actor_id = sqlc.narg(actor_id)::int)
+  AND (sqlc.narg(method)::varchar IS NULL OR method = sqlc.narg(method)::varchar)
+  AND (sqlc.narg(target_type)::varchar IS NULL OR target_type = sqlc.narg(target_type)::varchar)
+  AND (sqlc.narg(target_id)::varchar IS NULL OR target_id = sqlc.narg(target_id)::varchar)
+  AND (sqlc.narg(created_after)::timestamptz IS NULL OR created_at >= sqlc.narg(created_after)::timestamptz)
+  AND (sqlc.narg(created_before)::timestamptz IS NULL OR created_at < sqlc.narg(created_before)::timestamptz)
+  AND (sqlc.narg(before_id)::bigint IS NULL OR id < sqlc.narg(before_id)::bigint)
+ORDER BY id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: ListAuditLogChain :many
+SELECT * FROM audit_log
+WHERE id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg(batch_size);
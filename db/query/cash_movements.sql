@@ -0,0 +1,42 @@
+-- name: CreateCashMovement :one
+INSERT INTO cash_movements (
+  direction,
+  channel,
+  account_id,
+  amount,
+  external_reference,
+  memo,
+  status,
+  requested_by
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING *;
+
+-- name: GetCashMovement :one
+SELECT * FROM cash_movements
+WHERE id = $1 LIMIT 1;
+
+-- name: GetCashMovementForUpdate :one
+SELECT * FROM cash_movements
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: CompleteCashMovement :one
+UPDATE cash_movements
+SET
+  status = 'completed',
+  journal_id = sqlc.arg(journal_id)::bigint,
+  decided_by = sqlc.narg(decided_by),
+  decided_at = CASE WHEN sqlc.narg(decided_by)::int IS NULL THEN NULL ELSE now() END
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: RejectCashMovement :one
+UPDATE cash_movements
+SET
+  status = 'rejected',
+  decided_by = sqlc.narg(decided_by),
+  decision_reason = sqlc.arg(decision_reason),
+  decided_at = now()
+WHERE id = sqlc.arg(id) AND status = 'pending_approval'
+RETURNING *;
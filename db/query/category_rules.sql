@@ -0,0 +1,20 @@
+-- name: CreateCategoryRule :one
+INSERT INTO category_rules (
+  user_id,
+  category,
+  memo_contains,
+  counterparty_account_id,
+  priority
+) VALUES (
+  sqlc.arg(user_id), sqlc.arg(category), sqlc.narg(memo_contains), sqlc.narg(counterparty_account_id), sqlc.arg(priority)
+) RETURNING *;
+
+-- name: ListCategoryRules :many
+SELECT * FROM category_rules
+WHERE user_id = $1
+ORDER BY priority, id;
+
+-- name: DeleteCategoryRule :one
+DELETE FROM category_rules
+WHERE id = sqlc.arg(id) AND user_id = sqlc.arg(user_id)
+RETURNING *;
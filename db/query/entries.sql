@@ -4,10 +4,11 @@ WHERE id = $1 LIMIT 1;
 
 -- name: CreateEntry :one
 INSERT INTO entries (
+  journal_id,
   account_id,
   amount
 ) VALUES (
-  $1, $2
+  $1, $2, $3
 )RETURNING *;
 
 -- name: ListEntries :many
@@ -15,4 +16,37 @@ SELECT * FROM entries
 WHERE account_id = $1
 ORDER BY id
 LIMIT $2
-OFFSET $3;
\ No newline at end of file
+OFFSET $3;
+
+-- name: ListJournalEntries :many
+SELECT * FROM entries
+WHERE journal_id = $1
+ORDER BY id;
+
+-- name: SetEntryCategory :one
+UPDATE entries
+SET category = sqlc.narg(category)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: ListAccountHistory :many
+SELECT
+  entries.id,
+  entries.account_id,
+  entries.amount,
+  entries.category,
+  entries.created_at,
+  journals.kind,
+  transfers.id AS transfer_id,
+  transfers.from_account_id,
+  transfers.to_account_id,
+  transfers.memo,
+  transfers.reference
+FROM entries
+JOIN journals ON journals.id = entries.journal_id
+LEFT JOIN transfers ON transfers.id = journals.transfer_id AND journals.kind = 'transfer'
+WHERE entries.account_id = sqlc.arg(account_id)
+  AND (sqlc.narg(category)::varchar IS NULL OR entries.category = sqlc.narg(category))
+  AND (sqlc.narg(before_id)::int IS NULL OR entries.id < sqlc.narg(before_id))
+ORDER BY entries.id DESC
+LIMIT sqlc.arg(page_size);
\ No newline at end of file
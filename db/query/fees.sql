@@ -0,0 +1,46 @@
+-- name: FindFeeRule :one
+SELECT * FROM fee_rules
+WHERE kind = sqlc.arg(kind)
+  AND (product_code IS NULL OR product_code = sqlc.arg(product_code)::varchar)
+  AND (currency IS NULL OR currency = sqlc.arg(currency)::varchar)
+ORDER BY product_code IS NULL, currency IS NULL
+LIMIT 1;
+
+-- name: CreateFee :one
+INSERT INTO fees (
+  account_id,
+  kind,
+  amount,
+  transfer_id,
+  period
+) VALUES (
+  sqlc.arg(account_id), sqlc.arg(kind), sqlc.arg(amount), sqlc.narg(transfer_id), sqlc.narg(period)::date
+)
+ON CONFLICT (account_id, period) WHERE kind = 'maintenance' DO NOTHING
+RETURNING *;
+
+-- name: SetFeeEntry :one
+UPDATE fees
+SET entry_id = sqlc.arg(entry_id)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: ListAccountsDueMaintenanceFee :many
+SELECT accounts.id FROM accounts
+JOIN account_products ON account_products.code = accounts.product_code
+WHERE accounts.created_at < sqlc.arg(period_end)
+  AND accounts.status = 'active'
+  AND NOT account_products.internal
+  AND EXISTS (
+    SELECT 1 FROM fee_rules
+    WHERE fee_rules.kind = 'maintenance'
+      AND (fee_rules.product_code IS NULL OR fee_rules.product_code = accounts.product_code)
+      AND (fee_rules.currency IS NULL OR fee_rules.currency = accounts.currency)
+  )
+  AND NOT EXISTS (
+    SELECT 1 FROM fees
+    WHERE fees.account_id = accounts.id
+      AND fees.kind = 'maintenance'
+      AND fees.period = sqlc.arg(period)::date
+  )
+ORDER BY accounts.id;
@@ -0,0 +1,92 @@
+-- name: GetOutgoingTransferVelocity :one
+SELECT
+  count(*) AS transfers,
+  COALESCE(sum(amount), 0)::bigint AS total
+FROM transfers
+WHERE from_account_id = sqlc.arg(account_id)
+  AND status IN ('completed', 'held')
+  AND created_at > sqlc.arg(since)
+  AND created_at <= sqlc.arg(until)
+  AND (sqlc.narg(exclude_id)::int IS NULL OR id <> sqlc.narg(exclude_id));
+
+-- name: CountTransfersToPayee :one
+SELECT count(*) FROM transfers
+WHERE from_account_id = sqlc.arg(from_account_id)
+  AND to_account_id = sqlc.arg(to_account_id)
+  AND status = 'completed'
+  AND created_at <= sqlc.arg(until)
+  AND (sqlc.narg(exclude_id)::int IS NULL OR id <> sqlc.narg(exclude_id));
+
+-- name: CountStructuredTransfers :one
+SELECT count(*) FROM transfers
+WHERE from_account_id = sqlc.arg(account_id)
+  AND status IN ('completed', 'held')
+  AND amount >= sqlc.arg(min_amount)
+  AND amount < sqlc.arg(max_amount)
+  AND amount % sqlc.arg(round_to)::int = 0
+  AND created_at > sqlc.arg(since)
+  AND created_at <= sqlc.arg(until)
+  AND (sqlc.narg(exclude_id)::int IS NULL OR id <> sqlc.narg(exclude_id));
+
+-- name: ListSessionIPs :many
+SELECT client_ip FROM sessions
+WHERE user_id = sqlc.arg(user_id)
+  AND created_at > sqlc.arg(since)
+  AND created_at <= sqlc.arg(until)
+ORDER BY created_at DESC;
+
+-- name: CreateFraudAlert :one
+INSERT INTO fraud_alerts (
+  transfer_id,
+  account_id,
+  score,
+  decision,
+  hits,
+  source
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (transfer_id) DO NOTHING
+RETURNING *;
+
+-- name: GetFraudAlert :one
+SELECT * FROM fraud_alerts
+WHERE id = $1 LIMIT 1;
+
+-- name: GetFraudAlertForUpdate :one
+SELECT * FROM fraud_alerts
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListFraudAlerts :many
+SELECT * FROM fraud_alerts
+WHERE sqlc.narg(status)::fraud_alert_status IS NULL OR status = sqlc.narg(status)::fraud_alert_status
+ORDER BY id DESC
+LIMIT $1
+OFFSET $2;
+
+-- name: ResolveFraudAlert :one
+UPDATE fraud_alerts
+SET
+  status = sqlc.arg(status),
+  resolved_by = sqlc.arg(resolved_by),
+  resolution_reason = sqlc.arg(resolution_reason),
+  resolved_at = now()
+WHERE id = sqlc.arg(id) AND status = 'open'
+RETURNING *;
+
+-- name: ListUnscreenedTransfers :many
+SELECT * FROM transfers
+WHERE screened_at IS NULL AND status = 'completed' AND sender_id IS NOT NULL
+ORDER BY id
+LIMIT sqlc.arg(batch_size);
+
+-- name: MarkSystemTransfersScreened :execrows
+UPDATE transfers
+SET screened_at = now()
+WHERE screened_at IS NULL AND status = 'completed' AND sender_id IS NULL;
+
+-- name: MarkTransferScreened :exec
+UPDATE transfers
+SET screened_at = now()
+WHERE id = $1;
@@ -0,0 +1,88 @@
+-- name: ListInterestTiers :many
+SELECT * FROM interest_tiers
+ORDER BY product_code, min_balance;
+
+-- name: ListInterestBearingAccounts :many
+SELECT * FROM accounts
+WHERE created_at < sqlc.arg(day_end)
+  AND (status <> 'closed' OR status_changed_at >= sqlc.arg(day_end))
+  AND EXISTS (
+    SELECT 1 FROM interest_tiers
+    WHERE interest_tiers.product_code = accounts.product_code
+  )
+ORDER BY id;
+
+-- name: GetEndOfDayBalance :one
+SELECT (accounts.balance - COALESCE((
+    SELECT sum(entries.amount) FROM entries
+    WHERE entries.account_id = accounts.id
+      AND entries.created_at >= sqlc.arg(day_end)
+  ), 0))::int AS balance
+FROM accounts
+WHERE accounts.id = sqlc.arg(account_id);
+
+-- name: CreateInterestAccrual :execrows
+INSERT INTO interest_accruals (
+  account_id,
+  accrual_date,
+  end_of_day_balance,
+  amount_micros
+) VALUES (
+  $1, $2, $3, $4
+)
+ON CONFLICT (account_id, accrual_date) DO NOTHING;
+
+-- name: ListAccountsWithUnpostedInterest :many
+SELECT DISTINCT interest_accruals.account_id
+FROM interest_accruals
+WHERE interest_accruals.posting_period IS NULL
+  AND interest_accruals.accrual_date < sqlc.arg(period_end)
+  AND NOT EXISTS (
+    SELECT 1 FROM interest_postings
+    WHERE interest_postings.account_id = interest_accruals.account_id
+      AND interest_postings.period = sqlc.arg(period)
+  )
+ORDER BY interest_accruals.account_id;
+
+-- name: CreateInterestPosting :one
+INSERT INTO interest_postings (
+  account_id,
+  period,
+  amount
+) VALUES (
+  $1, $2, 0
+)
+ON CONFLICT (account_id, period) DO NOTHING
+RETURNING *;
+
+-- name: MarkInterestAccrualsPosted :one
+WITH marked AS (
+  UPDATE interest_accruals
+  SET posting_period = sqlc.arg(period)::date
+  WHERE account_id = sqlc.arg(account_id)
+    AND posting_period IS NULL
+    AND accrual_date < sqlc.arg(period_end)
+  RETURNING amount_micros
+)
+SELECT COALESCE(sum(amount_micros), 0)::bigint AS amount_micros FROM marked;
+
+-- name: GetInterestRemainder :one
+SELECT COALESCE((
+  SELECT remainder_micros FROM interest_postings
+  WHERE account_id = sqlc.arg(account_id) AND period < sqlc.arg(period)
+  ORDER BY period DESC
+  LIMIT 1
+), 0)::bigint AS remainder_micros;
+
+-- name: UpdateInterestPosting :one
+UPDATE interest_postings
+SET
+  amount = sqlc.arg(amount),
+  remainder_micros = sqlc.arg(remainder_micros),
+  transfer_id = sqlc.arg(transfer_id)
+WHERE account_id = sqlc.arg(account_id) AND period = sqlc.arg(period)
+RETURNING *;
+
+-- name: GetInterestPosting :one
+SELECT * FROM interest_postings
+WHERE account_id = $1 AND period = $2 LIMIT 1;
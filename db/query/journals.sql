@@ -0,0 +1,35 @@
+-- name: CreateJournal :one
+INSERT INTO journals (
+  kind,
+  transfer_id,
+  description
+) VALUES (
+  sqlc.arg(kind), sqlc.narg(transfer_id), sqlc.arg(description)
+) RETURNING *;
+
+-- name: GetJournal :one
+SELECT * FROM journals
+WHERE id = $1 LIMIT 1;
+
+-- name: ListAccountBalanceMismatches :many
+SELECT
+  accounts.id AS account_id,
+  accounts.currency,
+  accounts.balance,
+  COALESCE(sum(entries.amount), 0)::bigint AS entries_total
+FROM accounts
+LEFT JOIN entries ON entries.account_id = accounts.id
+GROUP BY accounts.id
+HAVING accounts.balance <> COALESCE(sum(entries.amount), 0)
+ORDER BY accounts.id;
+
+-- name: ListUnbalancedJournals :many
+SELECT
+  entries.journal_id,
+  accounts.currency,
+  sum(entries.amount)::bigint AS total
+FROM entries
+JOIN accounts ON accounts.id = entries.account_id
+GROUP BY entries.journal_id, accounts.currency
+HAVING sum(entries.amount) <> 0
+ORDER BY entries.journal_id, accounts.currency;
@@ -0,0 +1,62 @@
+-- name: GetUserForUpdate :one
+SELECT * FROM users
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListUsersForScreening :many
+SELECT * FROM users
+WHERE id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg(batch_size);
+
+-- name: SetUserScreeningStatus :one
+UPDATE users
+SET screening_status = sqlc.arg(screening_status)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: MarkUserScreened :one
+UPDATE users
+SET screened_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: ListUnclearedAccountOwners :many
+SELECT a.id AS account_id, u.screening_status
+FROM accounts a
+JOIN users u ON u.id = a.owner_id
+WHERE a.id = ANY(sqlc.arg(account_ids)::int[])
+  AND u.screening_status <> 'clear'
+ORDER BY a.id;
+
+-- name: CreateScreeningMatch :one
+INSERT INTO screening_matches (
+  user_id,
+  entry_id,
+  entry_name,
+  matched_name,
+  score,
+  source
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (user_id, entry_id) DO NOTHING
+RETURNING *;
+
+-- name: ListScreeningMatches :many
+SELECT * FROM screening_matches
+WHERE (sqlc.narg(user_id)::int IS NULL OR user_id = sqlc.narg(user_id)::int)
+  AND (sqlc.narg(status)::screening_match_status IS NULL OR status = sqlc.narg(status)::screening_match_status)
+ORDER BY id DESC
+LIMIT $1
+OFFSET $2;
+
+-- name: ReviewScreeningMatches :many
+UPDATE screening_matches
+SET
+  status = sqlc.arg(status),
+  reviewed_by = sqlc.arg(reviewed_by),
+  review_reason = sqlc.arg(review_reason),
+  reviewed_at = now()
+WHERE user_id = sqlc.arg(user_id) AND status = 'pending'
+RETURNING *;
@@ -0,0 +1,44 @@
+-- name: GetAccountLimits :one
+SELECT * FROM account_limits
+WHERE account_id = $1 LIMIT 1;
+
+-- name: UpsertAccountLimits :one
+INSERT INTO account_limits (
+  account_id,
+  per_transfer_limit,
+  daily_amount_limit,
+  daily_count_limit,
+  monthly_amount_limit,
+  monthly_count_limit,
+  updated_by
+) VALUES (
+  sqlc.arg(account_id),
+  sqlc.narg(per_transfer_limit),
+  sqlc.narg(daily_amount_limit),
+  sqlc.narg(daily_count_limit),
+  sqlc.narg(monthly_amount_limit),
+  sqlc.narg(monthly_count_limit),
+  sqlc.arg(updated_by)
+)
+ON CONFLICT (account_id) DO UPDATE SET
+  per_transfer_limit = EXCLUDED.per_transfer_limit,
+  daily_amount_limit = EXCLUDED.daily_amount_limit,
+  daily_count_limit = EXCLUDED.daily_count_limit,
+  monthly_amount_limit = EXCLUDED.monthly_amount_limit,
+  monthly_count_limit = EXCLUDED.monthly_count_limit,
+  updated_by = EXCLUDED.updated_by,
+  updated_at = now()
+RETURNING *;
+
+-- name: GetOutgoingTransferTotals :one
+SELECT
+  COALESCE(-sum(entries.amount) FILTER (WHERE entries.created_at >= date_trunc('day', now())), 0)::bigint AS daily_amount,
+  count(*) FILTER (WHERE entries.created_at >= date_trunc('day', now()))::bigint AS daily_count,
+  COALESCE(-sum(entries.amount), 0)::bigint AS monthly_amount,
+  count(*)::bigint AS monthly_count
+FROM entries
+JOIN journals ON journals.id = entries.journal_id
+WHERE entries.account_id = sqlc.arg(account_id)
+  AND journals.kind = 'transfer'
+  AND entries.amount < 0
+  AND entries.created_at >= date_trunc('month', now());
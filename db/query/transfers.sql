@@ -15,7 +15,22 @@ OFFSET $4;
 INSERT INTO transfers (
   from_account_id,
   to_account_id,
-  amount
+  amount,
+  memo,
+  reference,
+  sender_id,
+  status
 ) VALUES (
-  $1, $2, $3
-)RETURNING *;
\ No newline at end of file
+  sqlc.arg(from_account_id), sqlc.arg(to_account_id), sqlc.arg(amount), sqlc.arg(memo), sqlc.narg(reference), sqlc.narg(sender_id), sqlc.arg(status)
+)RETURNING *;
+
+-- name: GetTransferForUpdate :one
+SELECT * FROM transfers
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: UpdateTransferStatus :one
+UPDATE transfers
+SET status = sqlc.arg(status)
+WHERE id = sqlc.arg(id) AND status = sqlc.arg(current_status)
+RETURNING *;
\ No newline at end of file
@@ -25,4 +25,86 @@ RETURNING *;
 
 -- name: DeleteUser :exec
 DELETE FROM users
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1;
+
+-- name: SearchUsersByID :many
+SELECT * FROM users
+WHERE
+  (sqlc.narg(username_prefix)::varchar IS NULL OR username LIKE sqlc.narg(username_prefix)::varchar || '%')
+  AND (sqlc.narg(email_prefix)::varchar IS NULL OR lower(email) LIKE lower(sqlc.narg(email_prefix)::varchar) || '%')
+  AND (sqlc.narg(role)::varchar IS NULL OR role = sqlc.narg(role)::varchar)
+  AND (sqlc.narg(created_after)::timestamptz IS NULL OR created_at >= sqlc.narg(created_after)::timestamptz)
+  AND (sqlc.narg(created_before)::timestamptz IS NULL OR created_at < sqlc.narg(created_before)::timestamptz)
+  AND (sqlc.narg(cursor_id)::int IS NULL OR id > sqlc.narg(cursor_id)::int)
+ORDER BY id
+LIMIT sqlc.arg(page_size);
+
+-- name: SearchUsersByIDDesc :many
+SELECT * FROM users
+WHERE
+  (sqlc.narg(username_prefix)::varchar IS NULL OR username LIKE sqlc.narg(username_prefix)::varchar || '%')
+  AND (sqlc.narg(email_prefix)::varchar IS NULL OR lower(email) LIKE lower(sqlc.narg(email_prefix)::varchar) || '%')
+  AND (sqlc.narg(role)::varchar IS NULL OR role = sqlc.narg(role)::varchar)
+  AND (sqlc.narg(created_after)::timestamptz IS NULL OR created_at >= sqlc.narg(created_after)::timestamptz)
+  AND (sqlc.narg(created_before)::timestamptz IS NULL OR created_at < sqlc.narg(created_before)::timestamptz)
+  AND (sqlc.narg(cursor_id)::int IS NULL OR id < sqlc.narg(cursor_id)::int)
+ORDER BY id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: SearchUsersByUsername :many
+SELECT * FROM users
+WHERE
+  (sqlc.narg(username_prefix)::varchar IS NULL OR username LIKE sqlc.narg(username_prefix)::varchar || '%')
+  AND (sqlc.narg(email_prefix)::varchar IS NULL OR lower(email) LIKE lower(sqlc.narg(email_prefix)::varchar) || '%')
+  AND (sqlc.narg(role)::varchar IS NULL OR role = sqlc.narg(role)::varchar)
+  AND (sqlc.narg(created_after)::timestamptz IS NULL OR created_at >= sqlc.narg(created_after)::timestamptz)
+  AND (sqlc.narg(created_before)::timestamptz IS NULL OR created_at < sqlc.narg(created_before)::timestamptz)
+  AND (sqlc.narg(cursor_id)::int IS NULL OR (username, id) > (sqlc.arg(cursor_username)::varchar, sqlc.narg(cursor_id)::int))
+ORDER BY username, id
+LIMIT sqlc.arg(page_size);
+
+-- name: SearchUsersByUsernameDesc :many
+SELECT * FROM users
+WHERE
+  (sqlc.narg(username_prefix)::varchar IS NULL OR username LIKE sqlc.narg(username_prefix)::varchar || '%')
+  AND (sqlc.narg(email_prefix)::varchar IS NULL OR lower(email) LIKE lower(sqlc.narg(email_prefix)::varchar) || '%')
+  AND (sqlc.narg(role)::varchar IS NULL OR role = sqlc.narg(role)::varchar)
+  AND (sqlc.narg(created_after)::timestamptz IS NULL OR created_at >= sqlc.narg(created_after)::timestamptz)
+  AND (sqlc.narg(created_before)::timestamptz IS NULL OR created_at < sqlc.narg(created_before)::timestamptz)
+  AND (sqlc.narg(cursor_id)::int IS NULL OR (username, id) < (sqlc.arg(cursor_username)::varchar, sqlc.narg(cursor_id)::int))
+ORDER BY username DESC, id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: SearchUsersByCreatedAt :many
+SELECT * FROM users
+WHERE
+  (sqlc.narg(username_prefix)::varchar IS NULL OR username LIKE sqlc.narg(username_prefix)::varchar || '%')
+  AND (sqlc.narg(email_prefix)::varchar IS NULL OR lower(email) LIKE lower(sqlc.narg(email_prefix)::varchar) || '%')
+  AND (sqlc.narg(role)::varchar IS NULL OR role = sqlc.narg(role)::varchar)
+  AND (sqlc.narg(created_after)::timestamptz IS NULL OR created_at >= sqlc.narg(created_after)::timestamptz)
+  AND (sqlc.narg(created_before)::timestamptz IS NULL OR created_at < sqlc.narg(created_before)::timestamptz)
+  AND (sqlc.narg(cursor_id)::int IS NULL OR (created_at, id) > (sqlc.arg(cursor_created_at)::timestamptz, sqlc.narg(cursor_id)::int))
+ORDER BY created_at, id
+LIMIT sqlc.arg(page_size);
+
+-- name: SearchUsersByCreatedAtDesc :many
+SELECT * FROM users
+WHERE
+  (sqlc.narg(username_prefix)::varchar IS NULL OR username LIKE sqlc.narg(username_prefix)::varchar || '%')
+  AND (sqlc.narg(email_prefix)::varchar IS NULL OR lower(email) LIKE lower(sqlc.narg(email_prefix)::varchar) || '%')
+  AND (sqlc.narg(role)::varchar IS NULL OR role = sqlc.narg(role)::varchar)
+  AND (sqlc.narg(created_after)::timestamptz IS NULL OR created_at >= sqlc.narg(created_after)::timestamptz)
+  AND (sqlc.narg(created_before)::timestamptz IS NULL OR created_at < sqlc.narg(created_before)::timestamptz)
+  AND (sqlc.narg(cursor_id)::int IS NULL OR (created_at, id) < (sqlc.arg(cursor_created_at)::timestamptz, sqlc.narg(cursor_id)::int))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: GetUserByID :one
+SELECT * FROM users
+WHERE id = $1 LIMIT 1;
+
+-- name: UpdateUserRole :one
+UPDATE users
+SET role = sqlc.arg(role)
+WHERE id = sqlc.arg(id)
+RETURNING *;
@@ -0,0 +1,124 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/valkyraycho/bank_project/utils"
+)
+
+func TestListAccountProducts(t *testing.T) {
+	products, err := testStore.ListAccountProducts(context.Background())
+	require.NoError(t, err)
+
+	codes := []string{}
+	for _, product := range products {
+		codes = append(codes, product.Code)
+	}
+	require.Subset(t, codes, []string{"business", "checking", "savings"})
+}
+
+func TestTransferTxEnforcesSavingsWithdrawalLimit(t *testing.T) {
+	user := randomUser(t)
+	to := randomAccount(t)
+
+	savings, err := testStore.CreateAccount(context.Background(), CreateAccountParams{
+		OwnerID:     user.ID,
+		Currency:    to.Currency,
+		ProductCode: "savings",
+	})
+	require.NoError(t, err)
+	savings = fundAccount(t, savings, 1000)
+
+	product, err := testStore.GetAccountProduct(context.Background(), "savings")
+	require.NoError(t, err)
+	require.True(t, product.MonthlyWithdrawalLimit.Valid)
+
+	for i := int32(0); i < product.MonthlyWithdrawalLimit.Int32; i++ {
+		_, err := testStore.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: savings.ID,
+			ToAccountID:   to.ID,
+			Amount:        1,
+		})
+		require.NoError(t, err)
+	}
+
+	_, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: savings.ID,
+		ToAccountID:   to.ID,
+		Amount:        1,
+	})
+	require.ErrorIs(t, err, ErrWithdrawalLimitReached)
+}
+
+func TestResolveFraudAlertTxReleasesHeldTransferAtWithdrawalLimit(t *testing.T) {
+	user := randomUser(t)
+	to := randomAccount(t)
+
+	savings, err := testStore.CreateAccount(context.Background(), CreateAccountParams{
+		OwnerID:     user.ID,
+		Currency:    to.Currency,
+		ProductCode: "savings",
+	})
+	require.NoError(t, err)
+	savings = fundAccount(t, savings, 1000)
+
+	product, err := testStore.GetAccountProduct(context.Background(), "savings")
+	require.NoError(t, err)
+	require.True(t, product.MonthlyWithdrawalLimit.Valid)
+
+	for i := int32(1); i < product.MonthlyWithdrawalLimit.Int32; i++ {
+		_, err := testStore.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: savings.ID,
+			ToAccountID:   to.ID,
+			Amount:        1,
+		})
+		require.NoError(t, err)
+	}
+
+	// The held transfer is the last withdrawal of the month, so it must not
+	// count against itself when it is released.
+	held := screenedTransfer(t, savings, to, FraudDecisionHold)
+	require.Equal(t, TransferStatusHeld, held.Transfer.Status)
+
+	resolved, err := testStore.ResolveFraudAlertTx(context.Background(), ResolveFraudAlertTxParams{
+		ID:         held.Alert.ID,
+		ResolvedBy: to.OwnerID,
+		Reason:     "customer confirmed",
+	})
+	require.NoError(t, err)
+	require.Equal(t, TransferStatusCompleted, resolved.Transfer.Status)
+
+	_, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: savings.ID,
+		ToAccountID:   to.ID,
+		Amount:        1,
+	})
+	require.ErrorIs(t, err, ErrWithdrawalLimitReached)
+}
+
+func TestTransferTxEnforcesBalanceFloor(t *testing.T) {
+	user := randomUser(t)
+	to := randomAccount(t)
+
+	savings, err := testStore.CreateAccount(context.Background(), CreateAccountParams{
+		OwnerID:     user.ID,
+		Currency:    utils.RandomCurrency(),
+		ProductCode: "savings",
+	})
+	require.NoError(t, err)
+	savings = fundAccount(t, savings, 10)
+
+	_, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: savings.ID,
+		ToAccountID:   to.ID,
+		Amount:        11,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+}
+
+func TestBalanceFloor(t *testing.T) {
+	require.Equal(t, int32(100), AccountProduct{MinBalance: 100}.BalanceFloor())
+	require.Equal(t, int32(-500), AccountProduct{MinBalance: 100, OverdraftLimit: 500}.BalanceFloor())
+}
@@ -7,6 +7,9 @@ package db
 
 import (
 	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const addAccountBalance = `-- name: AddAccountBalance :one
@@ -14,7 +17,7 @@ UPDATE accounts
 SET
   balance = balance + $1
 WHERE id = $2
-RETURNING id, owner_id, balance, currency, created_at
+RETURNING id, owner_id, balance, currency, created_at, status, status_reason, status_changed_by, status_changed_at, product_code
 `
 
 type AddAccountBalanceParams struct {
@@ -31,6 +34,11 @@ func (q *Queries) AddAccountBalance(ctx context.Context, arg AddAccountBalancePa
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.Status,
+		&i.StatusReason,
+		&i.StatusChangedBy,
+		&i.StatusChangedAt,
+		&i.ProductCode,
 	)
 	return i, err
 }
@@ -38,21 +46,21 @@ func (q *Queries) AddAccountBalance(ctx context.Context, arg AddAccountBalancePa
 const createAccount = `-- name: CreateAccount :one
 INSERT INTO accounts (
   owner_id,
-  balance,
-  currency
+  currency,
+  product_code
 ) VALUES (
   $1, $2, $3
-)RETURNING id, owner_id, balance, currency, created_at
+)RETURNING id, owner_id, balance, currency, created_at, status, status_reason, status_changed_by, status_changed_at, product_code
 `
 
 type CreateAccountParams struct {
-	OwnerID  int32  `json:"owner_id"`
-	Balance  int32  `json:"balance"`
-	Currency string `json:"currency"`
+	OwnerID     int32  `json:"owner_id"`
+	Currency    string `json:"currency"`
+	ProductCode string `json:"product_code"`
 }
 
 func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error) {
-	row := q.db.QueryRow(ctx, createAccount, arg.OwnerID, arg.Balance, arg.Currency)
+	row := q.db.QueryRow(ctx, createAccount, arg.OwnerID, arg.Currency, arg.ProductCode)
 	var i Account
 	err := row.Scan(
 		&i.ID,
@@ -60,6 +68,11 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (A
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.Status,
+		&i.StatusReason,
+		&i.StatusChangedBy,
+		&i.StatusChangedAt,
+		&i.ProductCode,
 	)
 	return i, err
 }
@@ -75,7 +88,7 @@ func (q *Queries) DeleteAccount(ctx context.Context, id int32) error {
 }
 
 const getAccount = `-- name: GetAccount :one
-SELECT id, owner_id, balance, currency, created_at FROM accounts
+SELECT id, owner_id, balance, currency, created_at, status, status_reason, status_changed_by, status_changed_at, product_code FROM accounts
 WHERE id = $1 LIMIT 1
 `
 
@@ -88,12 +101,41 @@ func (q *Queries) GetAccount(ctx context.Context, id int32) (Account, error) {
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.Status,
+		&i.StatusReason,
+		&i.StatusChangedBy,
+		&i.StatusChangedAt,
+		&i.ProductCode,
+	)
+	return i, err
+}
+
+const getAccountForUpdate = `-- name: GetAccountForUpdate :one
+SELECT id, owner_id, balance, currency, created_at, status, status_reason, status_changed_by, status_changed_at, product_code FROM accounts
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetAccountForUpdate(ctx context.Context, id int32) (Account, error) {
+	row := q.db.QueryRow(ctx, getAccountForUpdate, id)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.Status,
+		&i.StatusReason,
+		&i.StatusChangedBy,
+		&i.StatusChangedAt,
+		&i.ProductCode,
 	)
 	return i, err
 }
 
 const listAccount = `-- name: ListAccount :many
-SELECT id, owner_id, balance, currency, created_at FROM accounts
+SELECT id, owner_id, balance, currency, created_at, status, status_reason, status_changed_by, status_changed_at, product_code FROM accounts
 WHERE owner_id = $1
 ORDER BY id
 LIMIT $2
@@ -121,6 +163,415 @@ func (q *Queries) ListAccount(ctx context.Context, arg ListAccountParams) ([]Acc
 			&i.Balance,
 			&i.Currency,
 			&i.CreatedAt,
+			&i.Status,
+			&i.StatusReason,
+			&i.StatusChangedBy,
+			&i.StatusChangedAt,
+			&i.ProductCode,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchAccountsByBalance = `-- name: SearchAccountsByBalance :many
+SELECT id, owner_id, balance, currency, created_at, status, status_reason, status_changed_by, status_changed_at, product_code FROM accounts
+WHERE
+  ($1::int IS NULL OR owner_id = $1::int)
+  AND ($2::varchar IS NULL OR currency = $2::varchar)
+  AND ($3::int IS NULL OR balance >= $3::int)
+  AND ($4::int IS NULL OR balance <= $4::int)
+  AND ($5::timestamptz IS NULL OR created_at >= $5::timestamptz)
+  AND ($6::timestamptz IS NULL OR created_at < $6::timestamptz)
+  AND product_code NOT IN (SELECT code FROM account_products WHERE internal)
+  AND ($7::int IS NULL OR (balance, id) > ($8::int, $7::int))
+ORDER BY balance, id
+LIMIT $9
+`
+
+type SearchAccountsByBalanceParams struct {
+	OwnerID       pgtype.Int4        `json:"owner_id"`
+	Currency      pgtype.Text        `json:"currency"`
+	MinBalance    pgtype.Int4        `json:"min_balance"`
+	MaxBalance    pgtype.Int4        `json:"max_balance"`
+	CreatedAfter  pgtype.Timestamptz `json:"created_after"`
+	CreatedBefore pgtype.Timestamptz `json:"created_before"`
+	CursorID      pgtype.Int4        `json:"cursor_id"`
+	CursorBalance int32              `json:"cursor_balance"`
+	PageSize      int32              `json:"page_size"`
+}
+
+func (q *Queries) SearchAccountsByBalance(ctx context.Context, arg SearchAccountsByBalanceParams) ([]Account, error) {
+	rows, err := q.db.Query(ctx, searchAccountsByBalance,
+		arg.OwnerID,
+		arg.Currency,
+		arg.MinBalance,
+		arg.MaxBalance,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.CursorID,
+		arg.CursorBalance,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.Status,
+			&i.StatusReason,
+			&i.StatusChangedBy,
+			&i.StatusChangedAt,
+			&i.ProductCode,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchAccountsByBalanceDesc = `-- name: SearchAccountsByBalanceDesc :many
+SELECT id, owner_id, balance, currency, created_at, status, status_reason, status_changed_by, status_changed_at, product_code FROM accounts
+WHERE
+  ($1::int IS NULL OR owner_id = $1::int)
+  AND ($2::varchar IS NULL OR currency = $2::varchar)
+  AND ($3::int IS NULL OR balance >= $3::int)
+  AND ($4::int IS NULL OR balance <= $4::int)
+  AND ($5::timestamptz IS NULL OR created_at >= $5::timestamptz)
+  AND ($6::timestamptz IS NULL OR created_at < $6::timestamptz)
+  AND product_code NOT IN (SELECT code FROM account_products WHERE internal)
+  AND ($7::int IS NULL OR (balance, id) < ($8::int, $7::int))
+ORDER BY balance DESC, id DESC
+LIMIT $9
+`
+
+type SearchAccountsByBalanceDescParams struct {
+	OwnerID       pgtype.Int4        `json:"owner_id"`
+	Currency      pgtype.Text        `json:"currency"`
+	MinBalance    pgtype.Int4        `json:"min_balance"`
+	MaxBalance    pgtype.Int4        `json:"max_balance"`
+	CreatedAfter  pgtype.Timestamptz `json:"created_after"`
+	CreatedBefore pgtype.Timestamptz `json:"created_before"`
+	CursorID      pgtype.Int4        `json:"cursor_id"`
+	CursorBalance int32              `json:"cursor_balance"`
+	PageSize      int32              `json:"page_size"`
+}
+
+func (q *Queries) SearchAccountsByBalanceDesc(ctx context.Context, arg SearchAccountsByBalanceDescParams) ([]Account, error) {
+	rows, err := q.db.Query(ctx, searchAccountsByBalanceDesc,
+		arg.OwnerID,
+		arg.Currency,
+		arg.MinBalance,
+		arg.MaxBalance,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.CursorID,
+		arg.CursorBalance,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.Status,
+			&i.StatusReason,
+			&i.StatusChangedBy,
+			&i.StatusChangedAt,
+			&i.ProductCode,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchAccountsByCreatedAt = `-- name: SearchAccountsByCreatedAt :many
+SELECT id, owner_id, balance, currency, created_at, status, status_reason, status_changed_by, status_changed_at, product_code FROM accounts
+WHERE
+  ($1::int IS NULL OR owner_id = $1::int)
+  AND ($2::varchar IS NULL OR currency = $2::varchar)
+  AND ($3::int IS NULL OR balance >= $3::int)
+  AND ($4::int IS NULL OR balance <= $4::int)
+  AND ($5::timestamptz IS NULL OR created_at >= $5::timestamptz)
+  AND ($6::timestamptz IS NULL OR created_at < $6::timestamptz)
+  AND product_code NOT IN (SELECT code FROM account_products WHERE internal)
+  AND ($7::int IS NULL OR (created_at, id) > ($8::timestamptz, $7::int))
+ORDER BY created_at, id
+LIMIT $9
+`
+
+type SearchAccountsByCreatedAtParams struct {
+	OwnerID         pgtype.Int4        `json:"owner_id"`
+	Currency        pgtype.Text        `json:"currency"`
+	MinBalance      pgtype.Int4        `json:"min_balance"`
+	MaxBalance      pgtype.Int4        `json:"max_balance"`
+	CreatedAfter    pgtype.Timestamptz `json:"created_after"`
+	CreatedBefore   pgtype.Timestamptz `json:"created_before"`
+	CursorID        pgtype.Int4        `json:"cursor_id"`
+	CursorCreatedAt time.Time          `json:"cursor_created_at"`
+	PageSize        int32              `json:"page_size"`
+}
+
+func (q *Queries) SearchAccountsByCreatedAt(ctx context.Context, arg SearchAccountsByCreatedAtParams) ([]Account, error) {
+	rows, err := q.db.Query(ctx, searchAccountsByCreatedAt,
+		arg.OwnerID,
+		arg.Currency,
+		arg.MinBalance,
+		arg.MaxBalance,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.CursorID,
+		arg.CursorCreatedAt,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.Status,
+			&i.StatusReason,
+			&i.StatusChangedBy,
+			&i.StatusChangedAt,
+			&i.ProductCode,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchAccountsByCreatedAtDesc = `-- name: SearchAccountsByCreatedAtDesc :many
+SELECT id, owner_id, balance, currency, created_at, status, status_reason, status_changed_by, status_changed_at, product_code FROM accounts
+WHERE
+  ($1::int IS NULL OR owner_id = $1::int)
+  AND ($2::varchar IS NULL OR currency = $2::varchar)
+  AND ($3::int IS NULL OR balance >= $3::int)
+  AND ($4::int IS NULL OR balance <= $4::int)
+  AND ($5::timestamptz IS NULL OR created_at >= $5::timestamptz)
+  AND ($6::timestamptz IS NULL OR created_at < $6::timestamptz)
+  AND product_code NOT IN (SELECT code FROM account_products WHERE internal)
+  AND ($7::int IS NULL OR (created_at, id) < ($8::timestamptz, $7::int))
+ORDER BY created_at DESC, id DESC
+LIMIT $9
+`
+
+type SearchAccountsByCreatedAtDescParams struct {
+	OwnerID         pgtype.Int4        `json:"owner_id"`
+	Currency        pgtype.Text        `json:"currency"`
+	MinBalance      pgtype.Int4        `json:"min_balance"`
+	MaxBalance      pgtype.Int4        `json:"max_balance"`
+	CreatedAfter    pgtype.Timestamptz `json:"created_after"`
+	CreatedBefore   pgtype.Timestamptz `json:"created_before"`
+	CursorID        pgtype.Int4        `json:"cursor_id"`
+	CursorCreatedAt time.Time          `json:"cursor_created_at"`
+	PageSize        int32              `json:"page_size"`
+}
+
+func (q *Queries) SearchAccountsByCreatedAtDesc(ctx context.Context, arg SearchAccountsByCreatedAtDescParams) ([]Account, error) {
+	rows, err := q.db.Query(ctx, searchAccountsByCreatedAtDesc,
+		arg.OwnerID,
+		arg.Currency,
+		arg.MinBalance,
+		arg.MaxBalance,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.CursorID,
+		arg.CursorCreatedAt,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.Status,
+			&i.StatusReason,
+			&i.StatusChangedBy,
+			&i.StatusChangedAt,
+			&i.ProductCode,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchAccountsByID = `-- name: SearchAccountsByID :many
+SELECT id, owner_id, balance, currency, created_at, status, status_reason, status_changed_by, status_changed_at, product_code FROM accounts
+WHERE
+  ($1::int IS NULL OR owner_id = $1::int)
+  AND ($2::varchar IS NULL OR currency = $2::varchar)
+  AND ($3::int IS NULL OR balance >= $3::int)
+  AND ($4::int IS NULL OR balance <= $4::int)
+  AND ($5::timestamptz IS NULL OR created_at >= $5::timestamptz)
+  AND ($6::timestamptz IS NULL OR created_at < $6::timestamptz)
+  AND product_code NOT IN (SELECT code FROM account_products WHERE internal)
+  AND ($7::int IS NULL OR id > $7::int)
+ORDER BY id
+LIMIT $8
+`
+
+type SearchAccountsByIDParams struct {
+	OwnerID       pgtype.Int4        `json:"owner_id"`
+	Currency      pgtype.Text        `json:"currency"`
+	MinBalance    pgtype.Int4        `json:"min_balance"`
+	MaxBalance    pgtype.Int4        `json:"max_balance"`
+	CreatedAfter  pgtype.Timestamptz `json:"created_after"`
+	CreatedBefore pgtype.Timestamptz `json:"created_before"`
+	CursorID      pgtype.Int4        `json:"cursor_id"`
+	PageSize      int32              `json:"page_size"`
+}
+
+func (q *Queries) SearchAccountsByID(ctx context.Context, arg SearchAccountsByIDParams) ([]Account, error) {
+	rows, err := q.db.Query(ctx, searchAccountsByID,
+		arg.OwnerID,
+		arg.Currency,
+		arg.MinBalance,
+		arg.MaxBalance,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.CursorID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.Status,
+			&i.StatusReason,
+			&i.StatusChangedBy,
+			&i.StatusChangedAt,
+			&i.ProductCode,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchAccountsByIDDesc = `-- name: SearchAccountsByIDDesc :many
+SELECT id, owner_id, balance, currency, created_at, status, status_reason, status_changed_by, status_changed_at, product_code FROM accounts
+WHERE
+  ($1::int IS NULL OR owner_id = $1::int)
+  AND ($2::varchar IS NULL OR currency = $2::varchar)
+  AND ($3::int IS NULL OR balance >= $3::int)
+  AND ($4::int IS NULL OR balance <= $4::int)
+  AND ($5::timestamptz IS NULL OR created_at >= $5::timestamptz)
+  AND ($6::timestamptz IS NULL OR created_at < $6::timestamptz)
+  AND product_code NOT IN (SELECT code FROM account_products WHERE internal)
+  AND ($7::int IS NULL OR id < $7::int)
+ORDER BY id DESC
+LIMIT $8
+`
+
+type SearchAccountsByIDDescParams struct {
+	OwnerID       pgtype.Int4        `json:"owner_id"`
+	Currency      pgtype.Text        `json:"currency"`
+	MinBalance    pgtype.Int4        `json:"min_balance"`
+	MaxBalance    pgtype.Int4        `json:"max_balance"`
+	CreatedAfter  pgtype.Timestamptz `json:"created_after"`
+	CreatedBefore pgtype.Timestamptz `json:"created_before"`
+	CursorID      pgtype.Int4        `json:"cursor_id"`
+	PageSize      int32              `json:"page_size"`
+}
+
+func (q *Queries) SearchAccountsByIDDesc(ctx context.Context, arg SearchAccountsByIDDescParams) ([]Account, error) {
+	rows, err := q.db.Query(ctx, searchAccountsByIDDesc,
+		arg.OwnerID,
+		arg.Currency,
+		arg.MinBalance,
+		arg.MaxBalance,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.CursorID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.Status,
+			&i.StatusReason,
+			&i.StatusChangedBy,
+			&i.StatusChangedAt,
+			&i.ProductCode,
 		); err != nil {
 			return nil, err
 		}
@@ -132,21 +583,33 @@ func (q *Queries) ListAccount(ctx context.Context, arg ListAccountParams) ([]Acc
 	return items, nil
 }
 
-const updateAccount = `-- name: UpdateAccount :one
+const updateAccountStatus = `-- name: UpdateAccountStatus :one
 UPDATE accounts
 SET
-  balance = $2
-WHERE id = $1
-RETURNING id, owner_id, balance, currency, created_at
+  status = $1,
+  status_reason = $2,
+  status_changed_by = $3,
+  status_changed_at = now()
+WHERE id = $4 AND status = $5
+RETURNING id, owner_id, balance, currency, created_at, status, status_reason, status_changed_by, status_changed_at, product_code
 `
 
-type UpdateAccountParams struct {
-	ID      int32 `json:"id"`
-	Balance int32 `json:"balance"`
+type UpdateAccountStatusParams struct {
+	Status          AccountStatus `json:"status"`
+	StatusReason    string        `json:"status_reason"`
+	StatusChangedBy pgtype.Int4   `json:"status_changed_by"`
+	ID              int32         `json:"id"`
+	CurrentStatus   AccountStatus `json:"current_status"`
 }
 
-func (q *Queries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error) {
-	row := q.db.QueryRow(ctx, updateAccount, arg.ID, arg.Balance)
+func (q *Queries) UpdateAccountStatus(ctx context.Context, arg UpdateAccountStatusParams) (Account, error) {
+	row := q.db.QueryRow(ctx, updateAccountStatus,
+		arg.Status,
+		arg.StatusReason,
+		arg.StatusChangedBy,
+		arg.ID,
+		arg.CurrentStatus,
+	)
 	var i Account
 	err := row.Scan(
 		&i.ID,
@@ -154,6 +617,11 @@ func (q *Queries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) (A
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.Status,
+		&i.StatusReason,
+		&i.StatusChangedBy,
+		&i.StatusChangedAt,
+		&i.ProductCode,
 	)
 	return i, err
 }
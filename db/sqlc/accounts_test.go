@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 	"github.com/valkyraycho/bank_project/utils"
 )
@@ -61,49 +62,94 @@ func TestListAccount(t *testing.T) {
 	}
 }
 
-func TestUpdateAccount(t *testing.T) {
+func TestAddAccountBalance(t *testing.T) {
 	account := randomAccount(t)
 
-	args := UpdateAccountParams{
-		ID:      account.ID,
-		Balance: utils.RandomMoney(),
+	args := AddAccountBalanceParams{
+		ID:     account.ID,
+		Amount: utils.RandomMoney(),
 	}
 
-	updatedAccount, err := testStore.UpdateAccount(context.Background(), args)
+	updatedAccount, err := testStore.AddAccountBalance(context.Background(), args)
 
 	require.NoError(t, err)
 	require.NotEmpty(t, updatedAccount)
 	require.Equal(t, args.ID, updatedAccount.ID)
-	require.Equal(t, args.Balance, updatedAccount.Balance)
+	require.Equal(t, account.Balance+args.Amount, updatedAccount.Balance)
 	require.Equal(t, account.Currency, updatedAccount.Currency)
 	require.Equal(t, account.CreatedAt, updatedAccount.CreatedAt)
 }
 
-func TestAddAccountBalance(t *testing.T) {
-	account := randomAccount(t)
+func TestSearchAccountsKeyset(t *testing.T) {
+	user := randomUser(t)
 
-	args := AddAccountBalanceParams{
-		ID:     account.ID,
-		Amount: utils.RandomMoney(),
+	for _, currency := range utils.SupportedCurrencies {
+		account, err := testStore.CreateAccount(context.Background(), CreateAccountParams{
+			OwnerID:     user.ID,
+			Currency:    currency,
+			ProductCode: "checking",
+		})
+		require.NoError(t, err)
+		fundAccount(t, account, utils.RandomMoney())
 	}
 
-	updatedAccount, err := testStore.AddAccountBalance(context.Background(), args)
+	args := SearchAccountsParams{
+		OwnerID:    pgtype.Int4{Int32: user.ID, Valid: true},
+		SortBy:     "balance",
+		Descending: true,
+		PageSize:   2,
+	}
 
+	var seen []Account
+	for {
+		accounts, err := testStore.SearchAccounts(context.Background(), args)
+		require.NoError(t, err)
+		seen = append(seen, accounts...)
+		if len(accounts) < int(args.PageSize) {
+			break
+		}
+
+		last := accounts[len(accounts)-1]
+		args.CursorID = pgtype.Int4{Int32: last.ID, Valid: true}
+		args.CursorBalance = last.Balance
+	}
+
+	require.Len(t, seen, len(utils.SupportedCurrencies))
+	for i := 1; i < len(seen); i++ {
+		require.Equal(t, user.ID, seen[i].OwnerID)
+		require.True(t, seen[i-1].Balance > seen[i].Balance ||
+			(seen[i-1].Balance == seen[i].Balance && seen[i-1].ID > seen[i].ID))
+	}
+}
+
+func TestSearchAccountsSkipsInternalAccounts(t *testing.T) {
+	expenseAccountID, err := testStore.GetSystemAccountID(context.Background(), GetSystemAccountIDParams{
+		Purpose:  SystemAccountInterestExpense,
+		Currency: utils.CAD,
+	})
 	require.NoError(t, err)
-	require.NotEmpty(t, updatedAccount)
-	require.Equal(t, args.ID, updatedAccount.ID)
-	require.Equal(t, account.Balance+args.Amount, updatedAccount.Balance)
-	require.Equal(t, account.Currency, updatedAccount.Currency)
-	require.Equal(t, account.CreatedAt, updatedAccount.CreatedAt)
+
+	expenseAccount, err := testStore.GetAccount(context.Background(), expenseAccountID)
+	require.NoError(t, err)
+
+	for _, sortBy := range []string{"id", "balance", "created_at"} {
+		accounts, err := testStore.SearchAccounts(context.Background(), SearchAccountsParams{
+			OwnerID:  pgtype.Int4{Int32: expenseAccount.OwnerID, Valid: true},
+			SortBy:   sortBy,
+			PageSize: 100,
+		})
+		require.NoError(t, err)
+		require.Empty(t, accounts)
+	}
 }
 
 func randomAccount(t *testing.T) Account {
 	user := randomUser(t)
 
 	args := CreateAccountParams{
-		OwnerID:  user.ID,
-		Currency: utils.RandomCurrency(),
-		Balance:  utils.RandomMoney(),
+		OwnerID:     user.ID,
+		Currency:    utils.RandomCurrency(),
+		ProductCode: "checking",
 	}
 	account, err := testStore.CreateAccount(context.Background(), args)
 
@@ -111,9 +157,33 @@ func randomAccount(t *testing.T) Account {
 	require.NotEmpty(t, account)
 	require.Equal(t, account.OwnerID, args.OwnerID)
 	require.Equal(t, account.Currency, args.Currency)
-	require.Equal(t, account.Balance, args.Balance)
+	require.Zero(t, account.Balance)
 
 	require.NotZero(t, account.CreatedAt)
 	require.NotZero(t, account.ID)
-	return account
+	return fundAccount(t, account, utils.RandomMoney())
+}
+
+// fundAccount credits an account out of the bank cash account of its
+// currency, the only way money enters the books.
+func fundAccount(t *testing.T, account Account, amount int32) Account {
+	if amount == 0 {
+		return account
+	}
+
+	cashAccountID, err := testStore.GetSystemAccountID(context.Background(), GetSystemAccountIDParams{
+		Purpose:  SystemAccountCash,
+		Currency: account.Currency,
+	})
+	require.NoError(t, err)
+
+	result, err := postJournalTx(context.Background(), journalParams{
+		Kind: JournalKindAdjustment,
+		Postings: []Posting{
+			{AccountID: cashAccountID, Amount: -amount},
+			{AccountID: account.ID, Amount: amount},
+		},
+	})
+	require.NoError(t, err)
+	return result.Accounts[account.ID]
 }
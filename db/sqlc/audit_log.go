@@ -0,0 +1,189 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// AuditLogGenesisHash is the prev_hash of the first audit log entry.
+var AuditLogGenesisHash = strings.Repeat("0", sha256.Size*2)
+
+type AppendAuditLogTxParams struct {
+	ActorID    pgtype.Int4 `json:"actor_id"`
+	ActorRole  string      `json:"actor_role"`
+	Method     string      `json:"method"`
+	TargetType string      `json:"target_type"`
+	TargetID   string      `json:"target_id"`
+	// Before and After are marshalled to JSON. Nil is stored as NULL.
+	Before     any    `json:"before"`
+	After      any    `json:"after"`
+	ClientIP   string `json:"client_ip"`
+	UserAgent  string `json:"user_agent"`
+	StatusCode string `json:"status_code"`
+}
+
+// AppendAuditLogTx appends an entry to the audit log, chained to the hash of
+// the last one. Appends are serialized by an advisory lock so the chain never
+// forks.
+func (store *SQLStore) AppendAuditLogTx(ctx context.Context, args AppendAuditLogTxParams) (AuditLog, error) {
+	ctx, span := tracer.Start(ctx, "db.AppendAuditLogTx")
+	defer span.End()
+	span.SetAttributes(attribute.String("audit.method", args.Method))
+
+	var entry AuditLog
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		before, err := marshalAuditValue(args.Before)
+		if err != nil {
+			return err
+		}
+		after, err := marshalAuditValue(args.After)
+		if err != nil {
+			return err
+		}
+
+		if err := q.LockAuditLog(ctx); err != nil {
+			return err
+		}
+
+		prevHash, err := q.GetLastAuditLogHash(ctx)
+		if err == pgx.ErrNoRows {
+			prevHash = AuditLogGenesisHash
+		} else if err != nil {
+			return err
+		}
+
+		entry = AuditLog{
+			ActorID:    args.ActorID,
+			ActorRole:  args.ActorRole,
+			Method:     args.Method,
+			TargetType: args.TargetType,
+			TargetID:   args.TargetID,
+			Before:     before,
+			After:      after,
+			ClientIp:   args.ClientIP,
+			UserAgent:  args.UserAgent,
+			StatusCode: args.StatusCode,
+			PrevHash:   prevHash,
+			// Postgres keeps microseconds, so the hash must not cover more.
+			CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+		}
+		entry.Hash, err = entry.ComputeHash()
+		if err != nil {
+			return err
+		}
+
+		entry, err = q.CreateAuditLogEntry(ctx, CreateAuditLogEntryParams{
+			ActorID:    entry.ActorID,
+			ActorRole:  entry.ActorRole,
+			Method:     entry.Method,
+			TargetType: entry.TargetType,
+			TargetID:   entry.TargetID,
+			Before:     entry.Before,
+			After:      entry.After,
+			ClientIp:   entry.ClientIp,
+			UserAgent:  entry.UserAgent,
+			StatusCode: entry.StatusCode,
+			PrevHash:   entry.PrevHash,
+			Hash:       entry.Hash,
+			CreatedAt:  entry.CreatedAt,
+		})
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return entry, err
+}
+
+// ComputeHash returns the hex SHA-256 of the previous hash and the contents of
+// the entry. JSON columns are hashed in canonical form, since jsonb doesn't
+// keep the text it was given.
+func (entry AuditLog) ComputeHash() (string, error) {
+	before, err := canonicalJSON(entry.Before)
+	if err != nil {
+		return "", err
+	}
+	after, err := canonicalJSON(entry.After)
+	if err != nil {
+		return "", err
+	}
+
+	var actorID *int32
+	if entry.ActorID.Valid {
+		actorID = &entry.ActorID.Int32
+	}
+
+	data, err := json.Marshal(struct {
+		PrevHash   string          `json:"prev_hash"`
+		ActorID    *int32          `json:"actor_id"`
+		ActorRole  string          `json:"actor_role"`
+		Method     string          `json:"method"`
+		TargetType string          `json:"target_type"`
+		TargetID   string          `json:"target_id"`
+		Before     json.RawMessage `json:"before"`
+		After      json.RawMessage `json:"after"`
+		ClientIP   string          `json:"client_ip"`
+		UserAgent  string          `json:"user_agent"`
+		StatusCode string          `json:"status_code"`
+		CreatedAt  string          `json:"created_at"`
+	}{
+		PrevHash:   entry.PrevHash,
+		ActorID:    actorID,
+		ActorRole:  entry.ActorRole,
+		Method:     entry.Method,
+		TargetType: entry.TargetType,
+		TargetID:   entry.TargetID,
+		Before:     before,
+		After:      after,
+		ClientIP:   entry.ClientIp,
+		UserAgent:  entry.UserAgent,
+		StatusCode: entry.StatusCode,
+		CreatedAt:  entry.CreatedAt.UTC().Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func marshalAuditValue(value any) ([]byte, error) {
+	if value == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return canonicalJSON(data)
+}
+
+// canonicalJSON re-encodes data with sorted object keys and no insignificant
+// whitespace. Numbers are kept as written.
+func canonicalJSON(data []byte) (json.RawMessage, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return json.Marshal(value)
+}
@@ -0,0 +1,52 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func appendRandomAuditLogEntry(t *testing.T, actor User) AuditLog {
+	entry, err := testStore.AppendAuditLogTx(context.Background(), AppendAuditLogTxParams{
+		ActorID:    pgtype.Int4{Int32: actor.ID, Valid: true},
+		ActorRole:  actor.Role,
+		Method:     "/pb.BankService/UpdateUser",
+		TargetType: "user",
+		TargetID:   "1",
+		Before:     map[string]any{"email": "old@example.com"},
+		After:      map[string]any{"email": "new@example.com"},
+		ClientIP:   "203.0.113.7",
+		UserAgent:  "test",
+		StatusCode: "OK",
+	})
+	require.NoError(t, err)
+	return entry
+}
+
+func TestAppendAuditLogTxChainsEntries(t *testing.T) {
+	actor := randomUser(t)
+
+	first := appendRandomAuditLogEntry(t, actor)
+	second := appendRandomAuditLogEntry(t, actor)
+
+	require.Equal(t, first.Hash, second.PrevHash)
+	require.NotEqual(t, first.Hash, second.Hash)
+
+	for _, entry := range []AuditLog{first, second} {
+		hash, err := entry.ComputeHash()
+		require.NoError(t, err)
+		require.Equal(t, entry.Hash, hash)
+	}
+}
+
+func TestAuditLogIsAppendOnly(t *testing.T) {
+	entry := appendRandomAuditLogEntry(t, randomUser(t))
+
+	_, err := testStore.(*SQLStore).connPool.Exec(context.Background(), "UPDATE audit_log SET method = 'tampered' WHERE id = $1", entry.ID)
+	require.ErrorContains(t, err, "append-only")
+
+	_, err = testStore.(*SQLStore).connPool.Exec(context.Background(), "DELETE FROM audit_log WHERE id = $1", entry.ID)
+	require.ErrorContains(t, err, "append-only")
+}
@@ -0,0 +1,40 @@
+package db
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Matches reports whether a transfer with memo, to or from counterparty,
+// falls under the rule. A rule with both conditions needs both to hold.
+func (rule CategoryRule) Matches(memo string, counterparty int32) bool {
+	if rule.MemoContains.Valid && !strings.Contains(strings.ToLower(memo), strings.ToLower(rule.MemoContains.String)) {
+		return false
+	}
+	if rule.CounterpartyAccountID.Valid && rule.CounterpartyAccountID.Int32 != counterparty {
+		return false
+	}
+	return rule.MemoContains.Valid || rule.CounterpartyAccountID.Valid
+}
+
+// categorizeEntry files a transfer entry under the first matching category
+// rule of the account owner. The entry is left uncategorized when no rule
+// matches.
+func categorizeEntry(ctx context.Context, q *Queries, entry Entry, ownerID int32, memo string, counterparty int32) (Entry, error) {
+	rules, err := q.ListCategoryRules(ctx, ownerID)
+	if err != nil {
+		return entry, err
+	}
+
+	for _, rule := range rules {
+		if rule.Matches(memo, counterparty) {
+			return q.SetEntryCategory(ctx, SetEntryCategoryParams{
+				ID:       entry.ID,
+				Category: pgtype.Text{String: rule.Category, Valid: true},
+			})
+		}
+	}
+	return entry, nil
+}
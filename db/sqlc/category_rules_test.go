@@ -0,0 +1,123 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategoryRuleMatches(t *testing.T) {
+	memoRule := CategoryRule{MemoContains: pgtype.Text{String: "Rent", Valid: true}}
+	require.True(t, memoRule.Matches("march RENT", 7))
+	require.False(t, memoRule.Matches("groceries", 7))
+
+	counterpartyRule := CategoryRule{CounterpartyAccountID: pgtype.Int4{Int32: 7, Valid: true}}
+	require.True(t, counterpartyRule.Matches("", 7))
+	require.False(t, counterpartyRule.Matches("", 8))
+
+	bothRule := CategoryRule{
+		MemoContains:          pgtype.Text{String: "rent", Valid: true},
+		CounterpartyAccountID: pgtype.Int4{Int32: 7, Valid: true},
+	}
+	require.True(t, bothRule.Matches("rent", 7))
+	require.False(t, bothRule.Matches("rent", 8))
+	require.False(t, bothRule.Matches("gym", 7))
+
+	require.False(t, CategoryRule{}.Matches("rent", 7))
+}
+
+func createTestCategoryRule(t *testing.T, args CreateCategoryRuleParams) CategoryRule {
+	rule, err := testStore.CreateCategoryRule(context.Background(), args)
+	require.NoError(t, err)
+	return rule
+}
+
+func TestTransferTxCategorizesEntries(t *testing.T) {
+	from := randomAccount(t)
+	to := randomAccount(t)
+
+	createTestCategoryRule(t, CreateCategoryRuleParams{
+		UserID:       from.OwnerID,
+		Category:     "housing",
+		MemoContains: pgtype.Text{String: "rent", Valid: true},
+	})
+	createTestCategoryRule(t, CreateCategoryRuleParams{
+		UserID:                to.OwnerID,
+		Category:              "income",
+		CounterpartyAccountID: pgtype.Int4{Int32: from.ID, Valid: true},
+		Priority:              1,
+	})
+	createTestCategoryRule(t, CreateCategoryRuleParams{
+		UserID:       to.OwnerID,
+		Category:     "rent received",
+		MemoContains: pgtype.Text{String: "rent", Valid: true},
+	})
+
+	result, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        1,
+		Memo:          "March Rent",
+		Reference:     "RENT-03",
+		SenderID:      pgtype.Int4{Int32: from.OwnerID, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "March Rent", result.Transfer.Memo)
+	require.Equal(t, "RENT-03", result.Transfer.Reference.String)
+	require.Equal(t, "housing", result.FromEntry.Category.String)
+	// The rule with the lower priority is tried first.
+	require.Equal(t, "rent received", result.ToEntry.Category.String)
+
+	result, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        1,
+		Memo:          "rent deposit",
+		SenderID:      pgtype.Int4{Int32: from.OwnerID, Valid: true},
+		Category:      "deposits",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "deposits", result.FromEntry.Category.String)
+	require.False(t, result.Transfer.Reference.Valid)
+
+	history, err := testStore.ListAccountHistory(context.Background(), ListAccountHistoryParams{
+		AccountID: to.ID,
+		PageSize:  10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, result.ToEntry.ID, history[0].ID)
+	require.Equal(t, JournalKindTransfer, history[0].Kind)
+	require.Equal(t, from.ID, history[0].FromAccountID.Int32)
+	require.Equal(t, "rent deposit", history[0].Memo.String)
+}
+
+func TestTransferTxRejectsDuplicateReference(t *testing.T) {
+	from := randomAccount(t)
+	to := randomAccount(t)
+
+	args := TransferTxParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        1,
+		Reference:     "INV-1",
+		SenderID:      pgtype.Int4{Int32: from.OwnerID, Valid: true},
+	}
+	_, err := testStore.TransferTx(context.Background(), args)
+	require.NoError(t, err)
+
+	_, err = testStore.TransferTx(context.Background(), args)
+	require.Error(t, err)
+
+	// References are only unique per sender.
+	other := randomAccount(t)
+	_, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: other.ID,
+		ToAccountID:   to.ID,
+		Amount:        1,
+		Reference:     "INV-1",
+		SenderID:      pgtype.Int4{Int32: other.OwnerID, Valid: true},
+	})
+	require.NoError(t, err)
+}
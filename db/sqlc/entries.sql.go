@@ -7,36 +7,43 @@ package db
 
 import (
 	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createEntry = `-- name: CreateEntry :one
 INSERT INTO entries (
+  journal_id,
   account_id,
   amount
 ) VALUES (
-  $1, $2
-)RETURNING id, account_id, amount, created_at
+  $1, $2, $3
+)RETURNING id, account_id, amount, created_at, journal_id, category
 `
 
 type CreateEntryParams struct {
+	JournalID int64 `json:"journal_id"`
 	AccountID int32 `json:"account_id"`
 	Amount    int32 `json:"amount"`
 }
 
 func (q *Queries) CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error) {
-	row := q.db.QueryRow(ctx, createEntry, arg.AccountID, arg.Amount)
+	row := q.db.QueryRow(ctx, createEntry, arg.JournalID, arg.AccountID, arg.Amount)
 	var i Entry
 	err := row.Scan(
 		&i.ID,
 		&i.AccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.JournalID,
+		&i.Category,
 	)
 	return i, err
 }
 
 const getEntry = `-- name: GetEntry :one
-SELECT id, account_id, amount, created_at FROM entries
+SELECT id, account_id, amount, created_at, journal_id, category FROM entries
 WHERE id = $1 LIMIT 1
 `
 
@@ -48,12 +55,95 @@ func (q *Queries) GetEntry(ctx context.Context, id int32) (Entry, error) {
 		&i.AccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.JournalID,
+		&i.Category,
 	)
 	return i, err
 }
 
+const listAccountHistory = `-- name: ListAccountHistory :many
+SELECT
+  entries.id,
+  entries.account_id,
+  entries.amount,
+  entries.category,
+  entries.created_at,
+  journals.kind,
+  transfers.id AS transfer_id,
+  transfers.from_account_id,
+  transfers.to_account_id,
+  transfers.memo,
+  transfers.reference
+FROM entries
+JOIN journals ON journals.id = entries.journal_id
+LEFT JOIN transfers ON transfers.id = journals.transfer_id AND journals.kind = 'transfer'
+WHERE entries.account_id = $1
+  AND ($2::varchar IS NULL OR entries.category = $2)
+  AND ($3::int IS NULL OR entries.id < $3)
+ORDER BY entries.id DESC
+LIMIT $4
+`
+
+type ListAccountHistoryParams struct {
+	AccountID int32       `json:"account_id"`
+	Category  pgtype.Text `json:"category"`
+	BeforeID  pgtype.Int4 `json:"before_id"`
+	PageSize  int32       `json:"page_size"`
+}
+
+type ListAccountHistoryRow struct {
+	ID            int32       `json:"id"`
+	AccountID     int32       `json:"account_id"`
+	Amount        int32       `json:"amount"`
+	Category      pgtype.Text `json:"category"`
+	CreatedAt     time.Time   `json:"created_at"`
+	Kind          string      `json:"kind"`
+	TransferID    pgtype.Int4 `json:"transfer_id"`
+	FromAccountID pgtype.Int4 `json:"from_account_id"`
+	ToAccountID   pgtype.Int4 `json:"to_account_id"`
+	Memo          pgtype.Text `json:"memo"`
+	Reference     pgtype.Text `json:"reference"`
+}
+
+func (q *Queries) ListAccountHistory(ctx context.Context, arg ListAccountHistoryParams) ([]ListAccountHistoryRow, error) {
+	rows, err := q.db.Query(ctx, listAccountHistory,
+		arg.AccountID,
+		arg.Category,
+		arg.BeforeID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAccountHistoryRow{}
+	for rows.Next() {
+		var i ListAccountHistoryRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.Category,
+			&i.CreatedAt,
+			&i.Kind,
+			&i.TransferID,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Memo,
+			&i.Reference,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listEntries = `-- name: ListEntries :many
-SELECT id, account_id, amount, created_at FROM entries
+SELECT id, account_id, amount, created_at, journal_id, category FROM entries
 WHERE account_id = $1
 ORDER BY id
 LIMIT $2
@@ -80,6 +170,8 @@ func (q *Queries) ListEntries(ctx context.Context, arg ListEntriesParams) ([]Ent
 			&i.AccountID,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.JournalID,
+			&i.Category,
 		); err != nil {
 			return nil, err
 		}
@@ -90,3 +182,62 @@ func (q *Queries) ListEntries(ctx context.Context, arg ListEntriesParams) ([]Ent
 	}
 	return items, nil
 }
+
+const listJournalEntries = `-- name: ListJournalEntries :many
+SELECT id, account_id, amount, created_at, journal_id, category FROM entries
+WHERE journal_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListJournalEntries(ctx context.Context, journalID int64) ([]Entry, error) {
+	rows, err := q.db.Query(ctx, listJournalEntries, journalID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Entry{}
+	for rows.Next() {
+		var i Entry
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.JournalID,
+			&i.Category,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setEntryCategory = `-- name: SetEntryCategory :one
+UPDATE entries
+SET category = $1
+WHERE id = $2
+RETURNING id, account_id, amount, created_at, journal_id, category
+`
+
+type SetEntryCategoryParams struct {
+	Category pgtype.Text `json:"category"`
+	ID       int32       `json:"id"`
+}
+
+func (q *Queries) SetEntryCategory(ctx context.Context, arg SetEntryCategoryParams) (Entry, error) {
+	row := q.db.QueryRow(ctx, setEntryCategory, arg.Category, arg.ID)
+	var i Entry
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.JournalID,
+		&i.Category,
+	)
+	return i, err
+}
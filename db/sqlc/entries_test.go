@@ -49,19 +49,21 @@ func TestListEntries(t *testing.T) {
 
 func randomEntry(t *testing.T) Entry {
 	account := randomAccount(t)
+	amount := utils.RandomMoney()
+	account = fundAccount(t, account, amount+1)
 
-	args := CreateEntryParams{
+	entries, err := testStore.ListEntries(context.Background(), ListEntriesParams{
 		AccountID: account.ID,
-		Amount:    utils.RandomMoney(),
-	}
-
-	entry, err := testStore.CreateEntry(context.Background(), args)
-
+		Limit:     100,
+	})
 	require.NoError(t, err)
-	require.NotEmpty(t, entry)
-	require.Equal(t, args.AccountID, entry.AccountID)
-	require.Equal(t, args.Amount, entry.Amount)
+	require.NotEmpty(t, entries)
+
+	entry := entries[len(entries)-1]
+	require.Equal(t, account.ID, entry.AccountID)
+	require.Equal(t, amount+1, entry.Amount)
 	require.NotZero(t, entry.ID)
+	require.NotZero(t, entry.JournalID)
 	require.NotZero(t, entry.CreatedAt)
 
 	return entry
@@ -1,6 +1,11 @@
 package db
 
-import "github.com/jackc/pgx/v5/pgconn"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
 
 const (
 	UniqueViolation = "23505"
@@ -9,3 +14,37 @@ const (
 var ErrUniqueViolation = &pgconn.PgError{
 	Code: UniqueViolation,
 }
+
+var (
+	ErrAccountHasBalance = errors.New("account balance must be zero or swept to another account")
+	ErrCurrencyMismatch  = errors.New("accounts must have the same currency")
+	ErrSweepToOtherOwner = errors.New("balance can only be swept to an account of the same owner")
+
+	ErrInsufficientFunds      = errors.New("balance would fall below the product minimum")
+	ErrWithdrawalLimitReached = errors.New("monthly withdrawal limit reached")
+)
+
+// AccountNotActiveError is returned when money would move in or out of a
+// frozen or closed account.
+type AccountNotActiveError struct {
+	AccountID int32
+	Status    AccountStatus
+}
+
+func (e *AccountNotActiveError) Error() string {
+	return fmt.Sprintf("account %d is %s", e.AccountID, e.Status)
+}
+
+// ProductRuleError reports which rule of the account product refused a debit.
+type ProductRuleError struct {
+	ProductCode string
+	Rule        error
+}
+
+func (e *ProductRuleError) Error() string {
+	return fmt.Sprintf("%s account: %s", e.ProductCode, e.Rule)
+}
+
+func (e *ProductRuleError) Unwrap() error {
+	return e.Rule
+}
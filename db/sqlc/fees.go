@@ -0,0 +1,215 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const SystemAccountFeeRevenue = "fee_revenue"
+
+const basisPoints = 10_000
+
+var ErrFeeAlreadyCharged = errors.New("fee already charged for this period")
+
+// Compute returns the fee for a transfer of amount: the flat amount plus the
+// percentage, rounded half up, then held between the rule's minimum and cap.
+// Maintenance fees are computed with a zero amount.
+func (rule FeeRule) Compute(amount int32) int32 {
+	fee := int64(rule.FlatAmount) + (int64(amount)*int64(rule.PercentBps)+basisPoints/2)/basisPoints
+	fee = max(fee, int64(rule.MinAmount))
+	if rule.MaxAmount.Valid {
+		fee = min(fee, int64(rule.MaxAmount.Int32))
+	}
+	return int32(min(fee, math.MaxInt32))
+}
+
+// findFee looks up the most specific rule of a kind for an account and
+// computes the fee. A rule for the product and currency wins over one for the
+// product only, which wins over one for the currency only. Bank internal
+// accounts are never charged, and no matching rule means no fee.
+func findFee(ctx context.Context, q *Queries, kind FeeKind, account Account, product AccountProduct, amount int32) (int32, error) {
+	if product.Internal {
+		return 0, nil
+	}
+
+	rule, err := q.FindFeeRule(ctx, FindFeeRuleParams{
+		Kind:        kind,
+		ProductCode: product.Code,
+		Currency:    account.Currency,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return rule.Compute(amount), nil
+}
+
+// QuoteTransferFee returns the fee TransferTx would charge fromAccount for a
+// transfer of amount under the current fee schedule.
+func (store *SQLStore) QuoteTransferFee(ctx context.Context, fromAccount Account, amount int32) (int32, error) {
+	product, err := store.GetAccountProduct(ctx, fromAccount.ProductCode)
+	if err != nil {
+		return 0, err
+	}
+	return findFee(ctx, store.Queries, FeeKindTransfer, fromAccount, product, amount)
+}
+
+type chargeFeeParams struct {
+	Account    Account
+	Kind       FeeKind
+	Amount     int32
+	TransferID pgtype.Int4
+	Period     pgtype.Date
+}
+
+type chargeFeeResult struct {
+	Fee     Fee
+	Entry   *Entry
+	Account Account
+}
+
+// chargeFee records a fee and moves it into the bank's fee revenue account
+// for the account currency. The caller must hold the lock on the charged
+// account and have checked its balance. The revenue account is locked last,
+// after every customer account of the transaction.
+func chargeFee(ctx context.Context, q *Queries, args chargeFeeParams) (chargeFeeResult, error) {
+	result := chargeFeeResult{Account: args.Account}
+
+	var err error
+	result.Fee, err = q.CreateFee(ctx, CreateFeeParams{
+		AccountID:  args.Account.ID,
+		Kind:       args.Kind,
+		Amount:     args.Amount,
+		TransferID: args.TransferID,
+		Period:     args.Period,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return result, ErrFeeAlreadyCharged
+		}
+		return result, err
+	}
+
+	if args.Amount == 0 {
+		return result, nil
+	}
+
+	revenueAccountID, err := q.GetSystemAccountID(ctx, GetSystemAccountIDParams{
+		Purpose:  SystemAccountFeeRevenue,
+		Currency: args.Account.Currency,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	revenueAccount, err := q.GetAccountForUpdate(ctx, revenueAccountID)
+	if err != nil {
+		return result, err
+	}
+
+	journal, err := postJournal(ctx, q, map[int32]Account{
+		args.Account.ID:   args.Account,
+		revenueAccount.ID: revenueAccount,
+	}, journalParams{
+		Kind:        JournalKindFee,
+		TransferID:  args.TransferID,
+		Description: fmt.Sprintf("%s fee", args.Kind),
+		Postings: []Posting{
+			{AccountID: args.Account.ID, Amount: -args.Amount},
+			{AccountID: revenueAccount.ID, Amount: args.Amount},
+		},
+	})
+	if err != nil {
+		return result, err
+	}
+
+	entry := journal.Entries[0]
+	result.Entry = &entry
+	result.Account = journal.Accounts[args.Account.ID]
+
+	result.Fee, err = q.SetFeeEntry(ctx, SetFeeEntryParams{
+		ID:      result.Fee.ID,
+		EntryID: pgtype.Int4{Int32: entry.ID, Valid: true},
+	})
+	return result, err
+}
+
+type ChargeMaintenanceFeeTxParams struct {
+	AccountID int32 `json:"account_id"`
+	// Period is the first day of the month being charged for.
+	Period time.Time `json:"period"`
+}
+
+type ChargeMaintenanceFeeTxResult struct {
+	Fee     Fee     `json:"fee"`
+	Entry   *Entry  `json:"entry"`
+	Account Account `json:"account"`
+}
+
+// ChargeMaintenanceFeeTx charges an account its monthly maintenance fee for a
+// period. Each period is charged at most once. The fee may use the overdraft
+// of the product but, unlike a transfer, doesn't count against its monthly
+// withdrawal limit.
+func (store *SQLStore) ChargeMaintenanceFeeTx(ctx context.Context, args ChargeMaintenanceFeeTxParams) (ChargeMaintenanceFeeTxResult, error) {
+	ctx, span := tracer.Start(ctx, "db.ChargeMaintenanceFeeTx")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("account.id", int(args.AccountID)),
+		attribute.String("fee.period", args.Period.Format(time.DateOnly)),
+	)
+
+	result := ChargeMaintenanceFeeTxResult{}
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		accounts, err := lockAccounts(ctx, q, args.AccountID)
+		if err != nil {
+			return err
+		}
+
+		account := accounts[args.AccountID]
+		if err := checkAccountsActive(account); err != nil {
+			return err
+		}
+
+		product, err := q.GetAccountProduct(ctx, account.ProductCode)
+		if err != nil {
+			return err
+		}
+
+		amount, err := findFee(ctx, q, FeeKindMaintenance, account, product, 0)
+		if err != nil {
+			return err
+		}
+		if err := checkBalanceFloor(account, product, int64(amount)); err != nil {
+			return err
+		}
+
+		charge, err := chargeFee(ctx, q, chargeFeeParams{
+			Account: account,
+			Kind:    FeeKindMaintenance,
+			Amount:  amount,
+			Period:  pgtype.Date{Time: args.Period, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Fee, result.Entry, result.Account = charge.Fee, charge.Entry, charge.Account
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return result, err
+}
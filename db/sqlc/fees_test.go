@@ -0,0 +1,88 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/valkyraycho/bank_project/utils"
+)
+
+func TestFeeRuleCompute(t *testing.T) {
+	rule := FeeRule{FlatAmount: 25, PercentBps: 10, MaxAmount: pgtype.Int4{Int32: 500, Valid: true}}
+	require.Equal(t, int32(25), rule.Compute(0))
+	require.Equal(t, int32(26), rule.Compute(1000))
+	require.Equal(t, int32(27), rule.Compute(1500))
+	require.Equal(t, int32(500), rule.Compute(1_000_000))
+
+	require.Equal(t, int32(100), FeeRule{PercentBps: 100, MinAmount: 100}.Compute(50))
+}
+
+func createBusinessAccount(t *testing.T, balance int32) Account {
+	user := randomUser(t)
+
+	account, err := testStore.CreateAccount(context.Background(), CreateAccountParams{
+		OwnerID:     user.ID,
+		Currency:    utils.USD,
+		ProductCode: "business",
+	})
+	require.NoError(t, err)
+	return fundAccount(t, account, balance)
+}
+
+func TestTransferTxChargesFee(t *testing.T) {
+	from := createBusinessAccount(t, 10000)
+	to := randomAccount(t)
+
+	fee, err := testStore.QuoteTransferFee(context.Background(), from, 1000)
+	require.NoError(t, err)
+	require.Equal(t, int32(26), fee)
+
+	result, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        1000,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result.Fee)
+	require.NotNil(t, result.FeeEntry)
+	require.Equal(t, fee, result.Fee.Amount)
+	require.Equal(t, result.Transfer.ID, result.Fee.TransferID.Int32)
+	require.Equal(t, -fee, result.FeeEntry.Amount)
+	require.Equal(t, from.Balance-1000-fee, result.FromAccount.Balance)
+}
+
+func TestTransferTxWithoutFee(t *testing.T) {
+	from := randomAccount(t)
+	to := randomAccount(t)
+
+	result, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        1,
+	})
+	require.NoError(t, err)
+	require.Nil(t, result.Fee)
+	require.Nil(t, result.FeeEntry)
+}
+
+func TestChargeMaintenanceFeeTxOncePerPeriod(t *testing.T) {
+	account := createBusinessAccount(t, 0)
+	period := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	result, err := testStore.ChargeMaintenanceFeeTx(context.Background(), ChargeMaintenanceFeeTxParams{
+		AccountID: account.ID,
+		Period:    period,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1500), result.Fee.Amount)
+	require.Equal(t, int32(-1500), result.Account.Balance)
+
+	_, err = testStore.ChargeMaintenanceFeeTx(context.Background(), ChargeMaintenanceFeeTxParams{
+		AccountID: account.ID,
+		Period:    period,
+	})
+	require.ErrorIs(t, err, ErrFeeAlreadyCharged)
+}
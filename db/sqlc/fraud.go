@@ -0,0 +1,174 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const (
+	FraudAlertSourceRealtime = "realtime"
+	FraudAlertSourceBatch    = "batch"
+)
+
+var ErrFraudAlertNotOpen = errors.New("fraud alert is not open")
+
+// TransferScreener decides whether a customer transfer may move money. It runs
+// inside the transfer transaction, once the transfer passed every other
+// check and the source account is locked.
+type TransferScreener interface {
+	ScreenTransfer(ctx context.Context, q Querier, args TransferTxParams) (TransferScreening, error)
+}
+
+type TransferScreening struct {
+	Decision FraudDecision `json:"decision"`
+	Score    int32         `json:"score"`
+	// Hits is the JSON encoded list of the rules that matched.
+	Hits []byte `json:"hits"`
+}
+
+// holdTransfer records a transfer the screener did not allow, without moving
+// any money, and raises a fraud alert for it.
+func holdTransfer(ctx context.Context, q *Queries, accounts map[int32]Account, args TransferTxParams, screening TransferScreening) (TransferTxResult, error) {
+	status := TransferStatusHeld
+	if screening.Decision == FraudDecisionBlock {
+		status = TransferStatusBlocked
+	}
+
+	transfer, err := recordTransfer(ctx, q, args, status)
+	if err != nil {
+		return TransferTxResult{}, err
+	}
+
+	alert, err := q.CreateFraudAlert(ctx, CreateFraudAlertParams{
+		TransferID: transfer.ID,
+		AccountID:  args.FromAccountID,
+		Score:      screening.Score,
+		Decision:   screening.Decision,
+		Hits:       screening.Hits,
+		Source:     FraudAlertSourceRealtime,
+	})
+	if err != nil {
+		return TransferTxResult{}, err
+	}
+
+	return TransferTxResult{
+		Transfer:    transfer,
+		FromAccount: accounts[args.FromAccountID],
+		ToAccount:   accounts[args.ToAccountID],
+		Alert:       &alert,
+	}, nil
+}
+
+type ResolveFraudAlertTxParams struct {
+	ID int64 `json:"id"`
+	// Confirmed marks the alert as fraud and rejects a held transfer.
+	// Otherwise the alert is dismissed and a held transfer goes ahead.
+	Confirmed  bool   `json:"confirmed"`
+	ResolvedBy int32  `json:"resolved_by"`
+	Reason     string `json:"reason"`
+}
+
+type ResolveFraudAlertTxResult struct {
+	Alert    FraudAlert `json:"alert"`
+	Transfer Transfer   `json:"transfer"`
+}
+
+// ResolveFraudAlertTx closes an open alert. A held transfer is released or
+// rejected with it; released transfers go through the same checks as a new
+// transfer, so one that no longer fits the balance or limits can't be
+// released. Blocked and completed transfers are left as they are.
+func (store *SQLStore) ResolveFraudAlertTx(ctx context.Context, args ResolveFraudAlertTxParams) (ResolveFraudAlertTxResult, error) {
+	ctx, span := tracer.Start(ctx, "db.ResolveFraudAlertTx")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int64("fraud_alert.id", args.ID),
+		attribute.Bool("fraud_alert.confirmed", args.Confirmed),
+	)
+
+	var result ResolveFraudAlertTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		alert, err := q.GetFraudAlertForUpdate(ctx, args.ID)
+		if err != nil {
+			return err
+		}
+		if alert.Status != FraudAlertStatusOpen {
+			return ErrFraudAlertNotOpen
+		}
+
+		result.Transfer, err = q.GetTransferForUpdate(ctx, alert.TransferID)
+		if err != nil {
+			return err
+		}
+
+		if result.Transfer.Status == TransferStatusHeld {
+			if args.Confirmed {
+				result.Transfer, err = q.UpdateTransferStatus(ctx, UpdateTransferStatusParams{
+					ID:            result.Transfer.ID,
+					CurrentStatus: TransferStatusHeld,
+					Status:        TransferStatusRejected,
+				})
+			} else {
+				result.Transfer, err = releaseTransfer(ctx, q, result.Transfer)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		status := FraudAlertStatusDismissed
+		if args.Confirmed {
+			status = FraudAlertStatusConfirmed
+		}
+
+		result.Alert, err = q.ResolveFraudAlert(ctx, ResolveFraudAlertParams{
+			ID:               alert.ID,
+			Status:           status,
+			ResolvedBy:       pgtype.Int4{Int32: args.ResolvedBy, Valid: true},
+			ResolutionReason: args.Reason,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrFraudAlertNotOpen
+		}
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return result, err
+}
+
+// releaseTransfer moves the money of a held transfer. Its entries are
+// categorized by the owners' rules only, as the category the sender asked for
+// is not kept while the transfer is held.
+func releaseTransfer(ctx context.Context, q *Queries, transfer Transfer) (Transfer, error) {
+	args := TransferTxParams{
+		FromAccountID: transfer.FromAccountID,
+		ToAccountID:   transfer.ToAccountID,
+		Amount:        transfer.Amount,
+		Memo:          transfer.Memo,
+		Reference:     transfer.Reference.String,
+		SenderID:      transfer.SenderID,
+	}
+
+	accounts, fee, err := checkTransfer(ctx, q, args)
+	if err != nil {
+		return transfer, err
+	}
+
+	if _, err := settleTransfer(ctx, q, accounts, transfer, args, fee); err != nil {
+		return transfer, err
+	}
+
+	return q.UpdateTransferStatus(ctx, UpdateTransferStatusParams{
+		ID:            transfer.ID,
+		CurrentStatus: TransferStatusHeld,
+		Status:        TransferStatusCompleted,
+	})
+}
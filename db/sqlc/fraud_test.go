@@ -0,0 +1,180 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+type stubScreener struct {
+	decision FraudDecision
+}
+
+func (screener stubScreener) ScreenTransfer(ctx context.Context, q Querier, args TransferTxParams) (TransferScreening, error) {
+	return TransferScreening{
+		Decision: screener.decision,
+		Score:    60,
+		Hits:     []byte(`[{"rule": "stub", "score": 60, "reason": "test"}]`),
+	}, nil
+}
+
+func screenedTransfer(t *testing.T, from, to Account, decision FraudDecision) TransferTxResult {
+	result, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        10,
+		SenderID:      pgtype.Int4{Int32: from.OwnerID, Valid: true},
+		Screener:      stubScreener{decision: decision},
+	})
+	require.NoError(t, err)
+	return result
+}
+
+func requireBalance(t *testing.T, account Account, balance int32) {
+	account, err := testStore.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, balance, account.Balance)
+}
+
+func TestTransferTxAllowedByScreener(t *testing.T) {
+	from := randomAccount(t)
+	to := randomAccount(t)
+
+	result := screenedTransfer(t, from, to, FraudDecisionAllow)
+	require.Equal(t, TransferStatusCompleted, result.Transfer.Status)
+	require.Nil(t, result.Alert)
+	requireBalance(t, to, to.Balance+10)
+}
+
+func TestTransferTxHeldAndReleased(t *testing.T) {
+	from := randomAccount(t)
+	to := randomAccount(t)
+
+	result := screenedTransfer(t, from, to, FraudDecisionHold)
+	require.Equal(t, TransferStatusHeld, result.Transfer.Status)
+	require.NotNil(t, result.Alert)
+	require.Equal(t, FraudAlertStatusOpen, result.Alert.Status)
+	require.Equal(t, FraudAlertSourceRealtime, result.Alert.Source)
+	require.Equal(t, result.Transfer.ID, result.Alert.TransferID)
+	requireBalance(t, from, from.Balance)
+	requireBalance(t, to, to.Balance)
+
+	resolved, err := testStore.ResolveFraudAlertTx(context.Background(), ResolveFraudAlertTxParams{
+		ID:         result.Alert.ID,
+		ResolvedBy: from.OwnerID,
+		Reason:     "customer confirmed",
+	})
+	require.NoError(t, err)
+	require.Equal(t, FraudAlertStatusDismissed, resolved.Alert.Status)
+	require.Equal(t, from.OwnerID, resolved.Alert.ResolvedBy.Int32)
+	require.True(t, resolved.Alert.ResolvedAt.Valid)
+	require.Equal(t, TransferStatusCompleted, resolved.Transfer.Status)
+	requireBalance(t, to, to.Balance+10)
+
+	_, err = testStore.ResolveFraudAlertTx(context.Background(), ResolveFraudAlertTxParams{
+		ID:         result.Alert.ID,
+		Confirmed:  true,
+		ResolvedBy: from.OwnerID,
+		Reason:     "changed my mind",
+	})
+	require.ErrorIs(t, err, ErrFraudAlertNotOpen)
+}
+
+func TestTransferTxHeldAndRejected(t *testing.T) {
+	from := randomAccount(t)
+	to := randomAccount(t)
+
+	result := screenedTransfer(t, from, to, FraudDecisionHold)
+
+	resolved, err := testStore.ResolveFraudAlertTx(context.Background(), ResolveFraudAlertTxParams{
+		ID:         result.Alert.ID,
+		Confirmed:  true,
+		ResolvedBy: from.OwnerID,
+		Reason:     "account taken over",
+	})
+	require.NoError(t, err)
+	require.Equal(t, FraudAlertStatusConfirmed, resolved.Alert.Status)
+	require.Equal(t, TransferStatusRejected, resolved.Transfer.Status)
+	requireBalance(t, from, from.Balance)
+	requireBalance(t, to, to.Balance)
+}
+
+func TestTransferTxBlocked(t *testing.T) {
+	from := randomAccount(t)
+	to := randomAccount(t)
+
+	result := screenedTransfer(t, from, to, FraudDecisionBlock)
+	require.Equal(t, TransferStatusBlocked, result.Transfer.Status)
+	require.Equal(t, FraudDecisionBlock, result.Alert.Decision)
+
+	// Dismissing the alert of a blocked transfer doesn't let it through.
+	resolved, err := testStore.ResolveFraudAlertTx(context.Background(), ResolveFraudAlertTxParams{
+		ID:         result.Alert.ID,
+		ResolvedBy: from.OwnerID,
+		Reason:     "false positive",
+	})
+	require.NoError(t, err)
+	require.Equal(t, TransferStatusBlocked, resolved.Transfer.Status)
+	requireBalance(t, from, from.Balance)
+	requireBalance(t, to, to.Balance)
+}
+
+func TestListUnscreenedTransfers(t *testing.T) {
+	from := randomAccount(t)
+	to := randomAccount(t)
+
+	completed := screenedTransfer(t, from, to, FraudDecisionAllow)
+	held := screenedTransfer(t, from, to, FraudDecisionHold)
+
+	transfers, err := testStore.ListUnscreenedTransfers(context.Background(), 1000000)
+	require.NoError(t, err)
+
+	ids := map[int32]bool{}
+	for _, transfer := range transfers {
+		ids[transfer.ID] = true
+	}
+	require.True(t, ids[completed.Transfer.ID])
+	require.False(t, ids[held.Transfer.ID])
+
+	require.NoError(t, testStore.MarkTransferScreened(context.Background(), completed.Transfer.ID))
+	transfer, err := testStore.GetTransfer(context.Background(), completed.Transfer.ID)
+	require.NoError(t, err)
+	require.True(t, transfer.ScreenedAt.Valid)
+}
+
+func TestMarkSystemTransfersScreened(t *testing.T) {
+	from := randomAccount(t)
+	to := randomAccount(t)
+
+	customer := screenedTransfer(t, from, to, FraudDecisionAllow)
+	system, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	transfers, err := testStore.ListUnscreenedTransfers(context.Background(), 1000000)
+	require.NoError(t, err)
+
+	ids := map[int32]bool{}
+	for _, transfer := range transfers {
+		ids[transfer.ID] = true
+	}
+	require.True(t, ids[customer.Transfer.ID])
+	require.False(t, ids[system.Transfer.ID])
+
+	marked, err := testStore.MarkSystemTransfersScreened(context.Background())
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, marked, int64(1))
+
+	transfer, err := testStore.GetTransfer(context.Background(), system.Transfer.ID)
+	require.NoError(t, err)
+	require.True(t, transfer.ScreenedAt.Valid)
+
+	transfer, err = testStore.GetTransfer(context.Background(), customer.Transfer.ID)
+	require.NoError(t, err)
+	require.False(t, transfer.ScreenedAt.Valid)
+}
@@ -0,0 +1,108 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	SystemAccountCash     = "cash"
+	SystemAccountSuspense = "suspense"
+)
+
+const (
+	JournalKindTransfer   = "transfer"
+	JournalKindFee        = "fee"
+	JournalKindAdjustment = "adjustment"
+)
+
+var (
+	ErrEmptyJournal      = errors.New("journal has no postings")
+	ErrUnbalancedJournal = errors.New("journal postings must sum to zero per currency")
+)
+
+// Posting is one leg of a journal: a signed amount credited to an account.
+type Posting struct {
+	AccountID int32 `json:"account_id"`
+	Amount    int32 `json:"amount"`
+}
+
+type journalParams struct {
+	Kind        string
+	TransferID  pgtype.Int4
+	Description string
+	Postings    []Posting
+}
+
+type journalResult struct {
+	Journal Journal
+	// Entries are in the order of the postings.
+	Entries  []Entry
+	Accounts map[int32]Account
+}
+
+// postJournal records a balanced journal and applies it to the account
+// balances. accounts must hold every account of the postings, locked by the
+// caller. Balances are updated in account id order, the order lockAccounts
+// takes the locks in.
+func postJournal(ctx context.Context, q *Queries, accounts map[int32]Account, args journalParams) (journalResult, error) {
+	if len(args.Postings) == 0 {
+		return journalResult{}, ErrEmptyJournal
+	}
+
+	totals := map[string]int64{}
+	changes := map[int32]int32{}
+	for _, posting := range args.Postings {
+		account, ok := accounts[posting.AccountID]
+		if !ok {
+			return journalResult{}, fmt.Errorf("account %d of the journal is not locked", posting.AccountID)
+		}
+		totals[account.Currency] += int64(posting.Amount)
+		changes[posting.AccountID] += posting.Amount
+	}
+	for _, total := range totals {
+		if total != 0 {
+			return journalResult{}, ErrUnbalancedJournal
+		}
+	}
+
+	result := journalResult{Accounts: map[int32]Account{}}
+
+	var err error
+	result.Journal, err = q.CreateJournal(ctx, CreateJournalParams{
+		Kind:        args.Kind,
+		TransferID:  args.TransferID,
+		Description: args.Description,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	for _, posting := range args.Postings {
+		entry, err := q.CreateEntry(ctx, CreateEntryParams{
+			JournalID: result.Journal.ID,
+			AccountID: posting.AccountID,
+			Amount:    posting.Amount,
+		})
+		if err != nil {
+			return result, err
+		}
+		result.Entries = append(result.Entries, entry)
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(changes)) {
+		result.Accounts[id], err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
+			ID:     id,
+			Amount: changes[id],
+		})
+		if err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,100 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// postJournalTx posts a journal between any accounts in a transaction of its
+// own. Production code only posts journals inside the transaction of the
+// operation they record; tests use it to fund accounts.
+func postJournalTx(ctx context.Context, args journalParams) (journalResult, error) {
+	result := journalResult{}
+	err := testStore.(*SQLStore).ExecTx(ctx, func(q *Queries) error {
+		ids := make([]int32, 0, len(args.Postings))
+		for _, posting := range args.Postings {
+			ids = append(ids, posting.AccountID)
+		}
+
+		accounts, err := lockAccounts(ctx, q, ids...)
+		if err != nil {
+			return err
+		}
+
+		result, err = postJournal(ctx, q, accounts, args)
+		return err
+	})
+	return result, err
+}
+
+func TestPostJournalRejectsUnbalancedPostings(t *testing.T) {
+	account1 := randomAccount(t)
+	account2 := randomAccount(t)
+
+	_, err := postJournalTx(context.Background(), journalParams{
+		Kind: JournalKindAdjustment,
+		Postings: []Posting{
+			{AccountID: account1.ID, Amount: -10},
+			{AccountID: account2.ID, Amount: 9},
+		},
+	})
+	require.ErrorIs(t, err, ErrUnbalancedJournal)
+
+	_, err = postJournalTx(context.Background(), journalParams{
+		Kind: JournalKindAdjustment,
+	})
+	require.ErrorIs(t, err, ErrEmptyJournal)
+
+	gotAccount1, err := testStore.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance, gotAccount1.Balance)
+}
+
+func TestTransferTxPostsBalancedJournal(t *testing.T) {
+	from := randomAccount(t)
+	to := randomAccount(t)
+
+	result, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, result.FromEntry.JournalID, result.ToEntry.JournalID)
+
+	journal, err := testStore.GetJournal(context.Background(), result.FromEntry.JournalID)
+	require.NoError(t, err)
+	require.Equal(t, JournalKindTransfer, journal.Kind)
+	require.Equal(t, result.Transfer.ID, journal.TransferID.Int32)
+
+	entries, err := testStore.ListJournalEntries(context.Background(), journal.ID)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Zero(t, entries[0].Amount+entries[1].Amount)
+}
+
+func TestLedgerChecksFindJournaledAccountsConsistent(t *testing.T) {
+	from := randomAccount(t)
+	to := randomAccount(t)
+
+	result, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        1,
+	})
+	require.NoError(t, err)
+
+	mismatches, err := testStore.ListAccountBalanceMismatches(context.Background())
+	require.NoError(t, err)
+	for _, mismatch := range mismatches {
+		require.NotContains(t, []int32{from.ID, to.ID}, mismatch.AccountID)
+	}
+
+	unbalanced, err := testStore.ListUnbalancedJournals(context.Background())
+	require.NoError(t, err)
+	for _, journal := range unbalanced {
+		require.NotEqual(t, result.FromEntry.JournalID, journal.JournalID)
+	}
+}
@@ -5,16 +5,696 @@
 package db
 
 import (
+	"database/sql/driver"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AccountStatus string
+
+const (
+	AccountStatusActive AccountStatus = "active"
+	AccountStatusFrozen AccountStatus = "frozen"
+	AccountStatusClosed AccountStatus = "closed"
+)
+
+func (e *AccountStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AccountStatus(s)
+	case string:
+		*e = AccountStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AccountStatus: %T", src)
+	}
+	return nil
+}
+
+type NullAccountStatus struct {
+	AccountStatus AccountStatus `json:"account_status"`
+	Valid         bool          `json:"valid"` // Valid is true if AccountStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAccountStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.AccountStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AccountStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAccountStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AccountStatus), nil
+}
+
+type AccountType string
+
+const (
+	AccountTypeChecking AccountType = "checking"
+	AccountTypeSavings  AccountType = "savings"
+	AccountTypeBusiness AccountType = "business"
+)
+
+func (e *AccountType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AccountType(s)
+	case string:
+		*e = AccountType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AccountType: %T", src)
+	}
+	return nil
+}
+
+type NullAccountType struct {
+	AccountType AccountType `json:"account_type"`
+	Valid       bool        `json:"valid"` // Valid is true if AccountType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAccountType) Scan(value interface{}) error {
+	if value == nil {
+		ns.AccountType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AccountType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAccountType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AccountType), nil
+}
+
+type ApprovalEventKind string
+
+const (
+	ApprovalEventKindRequested ApprovalEventKind = "requested"
+	ApprovalEventKindApproved  ApprovalEventKind = "approved"
+	ApprovalEventKindRejected  ApprovalEventKind = "rejected"
+	ApprovalEventKindExpired   ApprovalEventKind = "expired"
+)
+
+func (e *ApprovalEventKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ApprovalEventKind(s)
+	case string:
+		*e = ApprovalEventKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ApprovalEventKind: %T", src)
+	}
+	return nil
+}
+
+type NullApprovalEventKind struct {
+	ApprovalEventKind ApprovalEventKind `json:"approval_event_kind"`
+	Valid             bool              `json:"valid"` // Valid is true if ApprovalEventKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullApprovalEventKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.ApprovalEventKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ApprovalEventKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullApprovalEventKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ApprovalEventKind), nil
+}
+
+type ApprovalStatus string
+
+const (
+	ApprovalStatusPending  ApprovalStatus = "pending"
+	ApprovalStatusApproved ApprovalStatus = "approved"
+	ApprovalStatusRejected ApprovalStatus = "rejected"
+	ApprovalStatusExpired  ApprovalStatus = "expired"
+)
+
+func (e *ApprovalStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ApprovalStatus(s)
+	case string:
+		*e = ApprovalStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ApprovalStatus: %T", src)
+	}
+	return nil
+}
+
+type NullApprovalStatus struct {
+	ApprovalStatus ApprovalStatus `json:"approval_status"`
+	Valid          bool           `json:"valid"` // Valid is true if ApprovalStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullApprovalStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ApprovalStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ApprovalStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullApprovalStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ApprovalStatus), nil
+}
+
+type CashMovementChannel string
+
+const (
+	CashMovementChannelCashDesk CashMovementChannel = "cash_desk"
+	CashMovementChannelWire     CashMovementChannel = "wire"
+)
+
+func (e *CashMovementChannel) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CashMovementChannel(s)
+	case string:
+		*e = CashMovementChannel(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CashMovementChannel: %T", src)
+	}
+	return nil
+}
+
+type NullCashMovementChannel struct {
+	CashMovementChannel CashMovementChannel `json:"cash_movement_channel"`
+	Valid               bool                `json:"valid"` // Valid is true if CashMovementChannel is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullCashMovementChannel) Scan(value interface{}) error {
+	if value == nil {
+		ns.CashMovementChannel, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.CashMovementChannel.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullCashMovementChannel) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.CashMovementChannel), nil
+}
+
+type CashMovementDirection string
+
+const (
+	CashMovementDirectionDeposit    CashMovementDirection = "deposit"
+	CashMovementDirectionWithdrawal CashMovementDirection = "withdrawal"
+)
+
+func (e *CashMovementDirection) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CashMovementDirection(s)
+	case string:
+		*e = CashMovementDirection(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CashMovementDirection: %T", src)
+	}
+	return nil
+}
+
+type NullCashMovementDirection struct {
+	CashMovementDirection CashMovementDirection `json:"cash_movement_direction"`
+	Valid                 bool                  `json:"valid"` // Valid is true if CashMovementDirection is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullCashMovementDirection) Scan(value interface{}) error {
+	if value == nil {
+		ns.CashMovementDirection, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.CashMovementDirection.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullCashMovementDirection) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.CashMovementDirection), nil
+}
+
+type CashMovementStatus string
+
+const (
+	CashMovementStatusPendingApproval CashMovementStatus = "pending_approval"
+	CashMovementStatusCompleted       CashMovementStatus = "completed"
+	CashMovementStatusRejected        CashMovementStatus = "rejected"
+)
+
+func (e *CashMovementStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CashMovementStatus(s)
+	case string:
+		*e = CashMovementStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CashMovementStatus: %T", src)
+	}
+	return nil
+}
+
+type NullCashMovementStatus struct {
+	CashMovementStatus CashMovementStatus `json:"cash_movement_status"`
+	Valid              bool               `json:"valid"` // Valid is true if CashMovementStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullCashMovementStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.CashMovementStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.CashMovementStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullCashMovementStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.CashMovementStatus), nil
+}
+
+type FeeKind string
+
+const (
+	FeeKindTransfer    FeeKind = "transfer"
+	FeeKindMaintenance FeeKind = "maintenance"
+)
+
+func (e *FeeKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = FeeKind(s)
+	case string:
+		*e = FeeKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for FeeKind: %T", src)
+	}
+	return nil
+}
+
+type NullFeeKind struct {
+	FeeKind FeeKind `json:"fee_kind"`
+	Valid   bool    `json:"valid"` // Valid is true if FeeKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullFeeKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.FeeKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.FeeKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullFeeKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.FeeKind), nil
+}
+
+type FraudAlertStatus string
+
+const (
+	FraudAlertStatusOpen      FraudAlertStatus = "open"
+	FraudAlertStatusDismissed FraudAlertStatus = "dismissed"
+	FraudAlertStatusConfirmed FraudAlertStatus = "confirmed"
+)
+
+func (e *FraudAlertStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = FraudAlertStatus(s)
+	case string:
+		*e = FraudAlertStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for FraudAlertStatus: %T", src)
+	}
+	return nil
+}
+
+type NullFraudAlertStatus struct {
+	FraudAlertStatus FraudAlertStatus `json:"fraud_alert_status"`
+	Valid            bool             `json:"valid"` // Valid is true if FraudAlertStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullFraudAlertStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.FraudAlertStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.FraudAlertStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullFraudAlertStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.FraudAlertStatus), nil
+}
+
+type FraudDecision string
+
+const (
+	FraudDecisionAllow FraudDecision = "allow"
+	FraudDecisionHold  FraudDecision = "hold"
+	FraudDecisionBlock FraudDecision = "block"
+)
+
+func (e *FraudDecision) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = FraudDecision(s)
+	case string:
+		*e = FraudDecision(s)
+	default:
+		return fmt.Errorf("unsupported scan type for FraudDecision: %T", src)
+	}
+	return nil
+}
+
+type NullFraudDecision struct {
+	FraudDecision FraudDecision `json:"fraud_decision"`
+	Valid         bool          `json:"valid"` // Valid is true if FraudDecision is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullFraudDecision) Scan(value interface{}) error {
+	if value == nil {
+		ns.FraudDecision, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.FraudDecision.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullFraudDecision) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.FraudDecision), nil
+}
+
+type ScreeningMatchStatus string
+
+const (
+	ScreeningMatchStatusPending   ScreeningMatchStatus = "pending"
+	ScreeningMatchStatusCleared   ScreeningMatchStatus = "cleared"
+	ScreeningMatchStatusConfirmed ScreeningMatchStatus = "confirmed"
+)
+
+func (e *ScreeningMatchStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ScreeningMatchStatus(s)
+	case string:
+		*e = ScreeningMatchStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ScreeningMatchStatus: %T", src)
+	}
+	return nil
+}
+
+type NullScreeningMatchStatus struct {
+	ScreeningMatchStatus ScreeningMatchStatus `json:"screening_match_status"`
+	Valid                bool                 `json:"valid"` // Valid is true if ScreeningMatchStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullScreeningMatchStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ScreeningMatchStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ScreeningMatchStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullScreeningMatchStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ScreeningMatchStatus), nil
+}
+
+type ScreeningStatus string
+
+const (
+	ScreeningStatusClear         ScreeningStatus = "clear"
+	ScreeningStatusPendingReview ScreeningStatus = "pending_review"
+	ScreeningStatusBlocked       ScreeningStatus = "blocked"
+)
+
+func (e *ScreeningStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ScreeningStatus(s)
+	case string:
+		*e = ScreeningStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ScreeningStatus: %T", src)
+	}
+	return nil
+}
+
+type NullScreeningStatus struct {
+	ScreeningStatus ScreeningStatus `json:"screening_status"`
+	Valid           bool            `json:"valid"` // Valid is true if ScreeningStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullScreeningStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ScreeningStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ScreeningStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullScreeningStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ScreeningStatus), nil
+}
+
+type TransferStatus string
+
+const (
+	TransferStatusCompleted TransferStatus = "completed"
+	TransferStatusHeld      TransferStatus = "held"
+	TransferStatusRejected  TransferStatus = "rejected"
+	TransferStatusBlocked   TransferStatus = "blocked"
+)
+
+func (e *TransferStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TransferStatus(s)
+	case string:
+		*e = TransferStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TransferStatus: %T", src)
+	}
+	return nil
+}
+
+type NullTransferStatus struct {
+	TransferStatus TransferStatus `json:"transfer_status"`
+	Valid          bool           `json:"valid"` // Valid is true if TransferStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTransferStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.TransferStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TransferStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTransferStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TransferStatus), nil
+}
+
 type Account struct {
-	ID        int32     `json:"id"`
-	OwnerID   int32     `json:"owner_id"`
-	Balance   int32     `json:"balance"`
-	Currency  string    `json:"currency"`
+	ID              int32              `json:"id"`
+	OwnerID         int32              `json:"owner_id"`
+	Balance         int32              `json:"balance"`
+	Currency        string             `json:"currency"`
+	CreatedAt       time.Time          `json:"created_at"`
+	Status          AccountStatus      `json:"status"`
+	StatusReason    string             `json:"status_reason"`
+	StatusChangedBy pgtype.Int4        `json:"status_changed_by"`
+	StatusChangedAt pgtype.Timestamptz `json:"status_changed_at"`
+	ProductCode     string             `json:"product_code"`
+}
+
+// per-account overrides of the transfer limits of the account product
+type AccountLimit struct {
+	AccountID int32 `json:"account_id"`
+	// NULL falls back to the product default, as do the other limits
+	PerTransferLimit   pgtype.Int4 `json:"per_transfer_limit"`
+	DailyAmountLimit   pgtype.Int4 `json:"daily_amount_limit"`
+	DailyCountLimit    pgtype.Int4 `json:"daily_count_limit"`
+	MonthlyAmountLimit pgtype.Int4 `json:"monthly_amount_limit"`
+	MonthlyCountLimit  pgtype.Int4 `json:"monthly_count_limit"`
+	UpdatedBy          int32       `json:"updated_by"`
+	UpdatedAt          time.Time   `json:"updated_at"`
+}
+
+type AccountProduct struct {
+	Code              string      `json:"code"`
+	Name              string      `json:"name"`
+	Type              AccountType `json:"type"`
+	AllowedCurrencies []string    `json:"allowed_currencies"`
+	MinBalance        int32       `json:"min_balance"`
+	// how far below zero the balance may go, 0 when the product is not eligible for overdraft
+	OverdraftLimit int32 `json:"overdraft_limit"`
+	// outgoing transfers allowed per calendar month, NULL for unlimited
+	MonthlyWithdrawalLimit pgtype.Int4 `json:"monthly_withdrawal_limit"`
+	CreatedAt              time.Time   `json:"created_at"`
+	// bank-owned ledger accounts, never offered to customers
+	Internal bool `json:"internal"`
+	// default largest single outgoing transfer, NULL for unlimited
+	PerTransferLimit pgtype.Int4 `json:"per_transfer_limit"`
+	// default total of outgoing transfers per calendar day, NULL for unlimited
+	DailyAmountLimit pgtype.Int4 `json:"daily_amount_limit"`
+	DailyCountLimit  pgtype.Int4 `json:"daily_count_limit"`
+	// default total of outgoing transfers per calendar month, NULL for unlimited
+	MonthlyAmountLimit pgtype.Int4 `json:"monthly_amount_limit"`
+	MonthlyCountLimit  pgtype.Int4 `json:"monthly_count_limit"`
+}
+
+type ApprovalEvent struct {
+	ID        int64             `json:"id"`
+	RequestID int64             `json:"request_id"`
+	Event     ApprovalEventKind `json:"event"`
+	// NULL for events raised by the system, such as expiry
+	ActorID   pgtype.Int4 `json:"actor_id"`
+	Reason    string      `json:"reason"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+type ApprovalRequest struct {
+	ID     int64  `json:"id"`
+	Action string `json:"action"`
+	// arguments of the action, run as is once approved
+	Payload     []byte         `json:"payload"`
+	Summary     string         `json:"summary"`
+	Status      ApprovalStatus `json:"status"`
+	RequestedBy int32          `json:"requested_by"`
+	// banker who approved or rejected, never the requester; NULL when expired
+	DecidedBy      pgtype.Int4        `json:"decided_by"`
+	DecisionReason string             `json:"decision_reason"`
+	ExpiresAt      time.Time          `json:"expires_at"`
+	DecidedAt      pgtype.Timestamptz `json:"decided_at"`
+	CreatedAt      time.Time          `json:"created_at"`
+}
+
+type AuditLog struct {
+	ID int64 `json:"id"`
+	// no foreign key, so entries outlive deleted users
+	ActorID    pgtype.Int4 `json:"actor_id"`
+	ActorRole  string      `json:"actor_role"`
+	Method     string      `json:"method"`
+	TargetType string      `json:"target_type"`
+	TargetID   string      `json:"target_id"`
+	Before     []byte      `json:"before"`
+	After      []byte      `json:"after"`
+	ClientIp   string      `json:"client_ip"`
+	UserAgent  string      `json:"user_agent"`
+	StatusCode string      `json:"status_code"`
+	// hash of the previous entry, all zeros for the first one
+	PrevHash string `json:"prev_hash"`
+	// hex SHA-256 of prev_hash and the contents of the entry
+	Hash      string    `json:"hash"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type CashMovement struct {
+	ID        int64                 `json:"id"`
+	Direction CashMovementDirection `json:"direction"`
+	Channel   CashMovementChannel   `json:"channel"`
+	AccountID int32                 `json:"account_id"`
+	Amount    int32                 `json:"amount"`
+	// id of the movement outside the bank, such as a teller receipt or wire reference
+	ExternalReference string             `json:"external_reference"`
+	Memo              string             `json:"memo"`
+	Status            CashMovementStatus `json:"status"`
+	RequestedBy       int32              `json:"requested_by"`
+	// banker who approved or rejected a movement above the approval threshold
+	DecidedBy      pgtype.Int4        `json:"decided_by"`
+	DecisionReason string             `json:"decision_reason"`
+	DecidedAt      pgtype.Timestamptz `json:"decided_at"`
+	JournalID      pgtype.Int8        `json:"journal_id"`
+	CreatedAt      time.Time          `json:"created_at"`
+}
+
+type CategoryRule struct {
+	ID       int32  `json:"id"`
+	UserID   int32  `json:"user_id"`
+	Category string `json:"category"`
+	// matches transfers whose memo contains it, ignoring case
+	MemoContains          pgtype.Text `json:"memo_contains"`
+	CounterpartyAccountID pgtype.Int4 `json:"counterparty_account_id"`
+	// rules are tried in ascending priority, then creation order; the first match wins
+	Priority  int32     `json:"priority"`
 	CreatedAt time.Time `json:"created_at"`
 }
 
@@ -23,6 +703,119 @@ type Entry struct {
 	AccountID int32     `json:"account_id"`
 	Amount    int32     `json:"amount"`
 	CreatedAt time.Time `json:"created_at"`
+	JournalID int64     `json:"journal_id"`
+	// budgeting category chosen by the account owner or set by one of their category rules
+	Category pgtype.Text `json:"category"`
+}
+
+type Fee struct {
+	ID        int64   `json:"id"`
+	AccountID int32   `json:"account_id"`
+	Kind      FeeKind `json:"kind"`
+	Amount    int32   `json:"amount"`
+	// transfer the fee was charged on, set for transfer fees
+	TransferID pgtype.Int4 `json:"transfer_id"`
+	// first day of the month charged for, set for maintenance fees
+	Period    pgtype.Date `json:"period"`
+	EntryID   pgtype.Int4 `json:"entry_id"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+type FeeRule struct {
+	ID   int32   `json:"id"`
+	Kind FeeKind `json:"kind"`
+	// NULL applies to every product
+	ProductCode pgtype.Text `json:"product_code"`
+	// NULL applies to every currency
+	Currency   pgtype.Text `json:"currency"`
+	FlatAmount int32       `json:"flat_amount"`
+	// share of the transfer amount in basis points, added to flat_amount
+	PercentBps int32 `json:"percent_bps"`
+	MinAmount  int32 `json:"min_amount"`
+	// cap on the fee, NULL for no cap
+	MaxAmount pgtype.Int4 `json:"max_amount"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+type FraudAlert struct {
+	ID         int64 `json:"id"`
+	TransferID int32 `json:"transfer_id"`
+	// account the screened transfer was sent from
+	AccountID int32         `json:"account_id"`
+	Score     int32         `json:"score"`
+	Decision  FraudDecision `json:"decision"`
+	// rules that matched, with their score and reason
+	Hits []byte `json:"hits"`
+	// realtime when raised before the transfer committed, batch when raised afterwards
+	Source           string             `json:"source"`
+	Status           FraudAlertStatus   `json:"status"`
+	ResolvedBy       pgtype.Int4        `json:"resolved_by"`
+	ResolutionReason string             `json:"resolution_reason"`
+	ResolvedAt       pgtype.Timestamptz `json:"resolved_at"`
+	CreatedAt        time.Time          `json:"created_at"`
+}
+
+type InterestAccrual struct {
+	AccountID       int32     `json:"account_id"`
+	AccrualDate     time.Time `json:"accrual_date"`
+	EndOfDayBalance int32     `json:"end_of_day_balance"`
+	// interest earned that day in millionths of the currency minor unit
+	AmountMicros int64 `json:"amount_micros"`
+	// period of the posting that paid this accrual, NULL until paid
+	PostingPeriod pgtype.Date `json:"posting_period"`
+	CreatedAt     time.Time   `json:"created_at"`
+}
+
+type InterestPosting struct {
+	AccountID int32 `json:"account_id"`
+	// first day of the month the interest was earned in
+	Period     time.Time   `json:"period"`
+	Amount     int32       `json:"amount"`
+	TransferID pgtype.Int4 `json:"transfer_id"`
+	CreatedAt  time.Time   `json:"created_at"`
+	// fraction of the minor unit left unpaid, carried into the next posting or written off once the account is closed
+	RemainderMicros int64 `json:"remainder_micros"`
+}
+
+type InterestTier struct {
+	ProductCode string `json:"product_code"`
+	MinBalance  int32  `json:"min_balance"`
+	// annual rate in basis points earned by the part of the balance above min_balance
+	AprBps int32 `json:"apr_bps"`
+}
+
+// a balanced set of entries: per currency, the amounts of its entries sum to zero
+type Journal struct {
+	ID          int64       `json:"id"`
+	Kind        string      `json:"kind"`
+	TransferID  pgtype.Int4 `json:"transfer_id"`
+	Description string      `json:"description"`
+	CreatedAt   time.Time   `json:"created_at"`
+}
+
+type RateLimitBucket struct {
+	Key       string    `json:"key"`
+	Tokens    float64   `json:"tokens"`
+	Allowed   bool      `json:"allowed"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ScreeningMatch struct {
+	ID     int64 `json:"id"`
+	UserID int32 `json:"user_id"`
+	// id of the sanctions list entry the user matched
+	EntryID   string `json:"entry_id"`
+	EntryName string `json:"entry_name"`
+	// name or alias of the entry that matched the full name of the user
+	MatchedName string  `json:"matched_name"`
+	Score       float64 `json:"score"`
+	// create_user, update_user or rescreen
+	Source       string               `json:"source"`
+	Status       ScreeningMatchStatus `json:"status"`
+	ReviewedBy   pgtype.Int4          `json:"reviewed_by"`
+	ReviewReason string               `json:"review_reason"`
+	ReviewedAt   pgtype.Timestamptz   `json:"reviewed_at"`
+	CreatedAt    time.Time            `json:"created_at"`
 }
 
 type Session struct {
@@ -36,12 +829,27 @@ type Session struct {
 	CreatedAt    time.Time `json:"created_at"`
 }
 
+type SystemAccount struct {
+	Purpose   string `json:"purpose"`
+	Currency  string `json:"currency"`
+	AccountID int32  `json:"account_id"`
+}
+
 type Transfer struct {
 	ID            int32     `json:"id"`
 	FromAccountID int32     `json:"from_account_id"`
 	ToAccountID   int32     `json:"to_account_id"`
 	Amount        int32     `json:"amount"`
 	CreatedAt     time.Time `json:"created_at"`
+	Memo          string    `json:"memo"`
+	// end-to-end reference supplied by the sender, unique per sender
+	Reference pgtype.Text `json:"reference"`
+	// user who made the transfer, NULL for transfers made by the bank
+	SenderID pgtype.Int4 `json:"sender_id"`
+	// held transfers move no money until a banker dismisses their fraud alert; rejected and blocked ones never do
+	Status TransferStatus `json:"status"`
+	// when the batch fraud monitor last screened the transfer, NULL until it has
+	ScreenedAt pgtype.Timestamptz `json:"screened_at"`
 }
 
 type User struct {
@@ -53,4 +861,8 @@ type User struct {
 	Role              string    `json:"role"`
 	PasswordChangedAt time.Time `json:"password_changed_at"`
 	CreatedAt         time.Time `json:"created_at"`
+	// users pending review or blocked can neither send nor receive transfers
+	ScreeningStatus ScreeningStatus `json:"screening_status"`
+	// when the full name was last screened against the sanctions list, NULL until it has
+	ScreenedAt pgtype.Timestamptz `json:"screened_at"`
 }
@@ -0,0 +1,47 @@
+package db
+
+import (
+	"context"
+	"slices"
+)
+
+// BalanceFloor is the lowest balance a debit may leave behind. Products
+// eligible for overdraft may go down to minus their overdraft limit, the
+// others must stay at or above their minimum balance.
+func (product AccountProduct) BalanceFloor() int32 {
+	if product.OverdraftLimit > 0 {
+		return -product.OverdraftLimit
+	}
+	return product.MinBalance
+}
+
+func (product AccountProduct) AllowsCurrency(currency string) bool {
+	return slices.Contains(product.AllowedCurrencies, currency)
+}
+
+// checkWithdrawal applies the product rules of the debited account. The
+// account row must be locked so the balance and withdrawal count can't change
+// underneath the check.
+func checkWithdrawal(ctx context.Context, q *Queries, account Account, product AccountProduct, amount int64) error {
+	if err := checkBalanceFloor(account, product, amount); err != nil {
+		return err
+	}
+
+	if product.MonthlyWithdrawalLimit.Valid {
+		count, err := q.CountMonthlyWithdrawals(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+		if count >= int64(product.MonthlyWithdrawalLimit.Int32) {
+			return &ProductRuleError{ProductCode: product.Code, Rule: ErrWithdrawalLimitReached}
+		}
+	}
+	return nil
+}
+
+func checkBalanceFloor(account Account, product AccountProduct, amount int64) error {
+	if int64(account.Balance)-amount < int64(product.BalanceFloor()) {
+		return &ProductRuleError{ProductCode: product.Code, Rule: ErrInsufficientFunds}
+	}
+	return nil
+}
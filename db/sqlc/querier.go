@@ -6,29 +6,119 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
 	AddAccountBalance(ctx context.Context, arg AddAccountBalanceParams) (Account, error)
+	BlockUserSessions(ctx context.Context, userID int32) error
+	CompleteCashMovement(ctx context.Context, arg CompleteCashMovementParams) (CashMovement, error)
+	CountMonthlyWithdrawals(ctx context.Context, accountID int32) (int64, error)
+	CountStructuredTransfers(ctx context.Context, arg CountStructuredTransfersParams) (int64, error)
+	CountTransfersToPayee(ctx context.Context, arg CountTransfersToPayeeParams) (int64, error)
 	CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error)
+	CreateApprovalEvent(ctx context.Context, arg CreateApprovalEventParams) (ApprovalEvent, error)
+	CreateApprovalRequest(ctx context.Context, arg CreateApprovalRequestParams) (ApprovalRequest, error)
+	CreateAuditLogEntry(ctx context.Context, arg CreateAuditLogEntryParams) (AuditLog, error)
+	CreateCashMovement(ctx context.Context, arg CreateCashMovementParams) (CashMovement, error)
+	CreateCategoryRule(ctx context.Context, arg CreateCategoryRuleParams) (CategoryRule, error)
 	CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error)
+	CreateFee(ctx context.Context, arg CreateFeeParams) (Fee, error)
+	CreateFraudAlert(ctx context.Context, arg CreateFraudAlertParams) (FraudAlert, error)
+	CreateInterestAccrual(ctx context.Context, arg CreateInterestAccrualParams) (int64, error)
+	CreateInterestPosting(ctx context.Context, arg CreateInterestPostingParams) (InterestPosting, error)
+	CreateJournal(ctx context.Context, arg CreateJournalParams) (Journal, error)
+	CreateScreeningMatch(ctx context.Context, arg CreateScreeningMatchParams) (ScreeningMatch, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
 	CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	DecideApprovalRequest(ctx context.Context, arg DecideApprovalRequestParams) (ApprovalRequest, error)
 	DeleteAccount(ctx context.Context, id int32) error
+	DeleteCategoryRule(ctx context.Context, arg DeleteCategoryRuleParams) (CategoryRule, error)
+	DeleteIdleRateLimitBuckets(ctx context.Context, idleSince time.Time) error
 	DeleteUser(ctx context.Context, id int32) error
+	FindFeeRule(ctx context.Context, arg FindFeeRuleParams) (FeeRule, error)
 	GetAccount(ctx context.Context, id int32) (Account, error)
+	GetAccountForUpdate(ctx context.Context, id int32) (Account, error)
+	GetAccountLimits(ctx context.Context, accountID int32) (AccountLimit, error)
+	GetAccountProduct(ctx context.Context, code string) (AccountProduct, error)
+	GetApprovalRequest(ctx context.Context, id int64) (ApprovalRequest, error)
+	GetApprovalRequestForUpdate(ctx context.Context, id int64) (ApprovalRequest, error)
+	GetCashMovement(ctx context.Context, id int64) (CashMovement, error)
+	GetCashMovementForUpdate(ctx context.Context, id int64) (CashMovement, error)
+	GetEndOfDayBalance(ctx context.Context, arg GetEndOfDayBalanceParams) (int32, error)
 	GetEntry(ctx context.Context, id int32) (Entry, error)
+	GetFraudAlert(ctx context.Context, id int64) (FraudAlert, error)
+	GetFraudAlertForUpdate(ctx context.Context, id int64) (FraudAlert, error)
+	GetInterestPosting(ctx context.Context, arg GetInterestPostingParams) (InterestPosting, error)
+	GetInterestRemainder(ctx context.Context, arg GetInterestRemainderParams) (int64, error)
+	GetJournal(ctx context.Context, id int64) (Journal, error)
+	GetLastAuditLogHash(ctx context.Context) (string, error)
+	GetOutgoingTransferTotals(ctx context.Context, accountID int32) (GetOutgoingTransferTotalsRow, error)
+	GetOutgoingTransferVelocity(ctx context.Context, arg GetOutgoingTransferVelocityParams) (GetOutgoingTransferVelocityRow, error)
 	GetSession(ctx context.Context, id uuid.UUID) (Session, error)
+	GetSystemAccountID(ctx context.Context, arg GetSystemAccountIDParams) (int32, error)
 	GetTransfer(ctx context.Context, id int32) (Transfer, error)
+	GetTransferForUpdate(ctx context.Context, id int32) (Transfer, error)
 	GetUser(ctx context.Context, username string) (User, error)
+	GetUserByID(ctx context.Context, id int32) (User, error)
+	GetUserForUpdate(ctx context.Context, id int32) (User, error)
 	ListAccount(ctx context.Context, arg ListAccountParams) ([]Account, error)
+	ListAccountBalanceMismatches(ctx context.Context) ([]ListAccountBalanceMismatchesRow, error)
+	ListAccountHistory(ctx context.Context, arg ListAccountHistoryParams) ([]ListAccountHistoryRow, error)
+	ListAccountProducts(ctx context.Context) ([]AccountProduct, error)
+	ListAccountsDueMaintenanceFee(ctx context.Context, arg ListAccountsDueMaintenanceFeeParams) ([]int32, error)
+	ListAccountsWithUnpostedInterest(ctx context.Context, arg ListAccountsWithUnpostedInterestParams) ([]int32, error)
+	ListApprovalEvents(ctx context.Context, requestID int64) ([]ApprovalEvent, error)
+	ListApprovalRequests(ctx context.Context, arg ListApprovalRequestsParams) ([]ApprovalRequest, error)
+	ListAuditLog(ctx context.Context, arg ListAuditLogParams) ([]AuditLog, error)
+	ListAuditLogChain(ctx context.Context, arg ListAuditLogChainParams) ([]AuditLog, error)
+	ListCategoryRules(ctx context.Context, userID int32) ([]CategoryRule, error)
 	ListEntries(ctx context.Context, arg ListEntriesParams) ([]Entry, error)
+	ListExpiredApprovalRequests(ctx context.Context, arg ListExpiredApprovalRequestsParams) ([]int64, error)
+	ListFraudAlerts(ctx context.Context, arg ListFraudAlertsParams) ([]FraudAlert, error)
+	ListInterestBearingAccounts(ctx context.Context, dayEnd time.Time) ([]Account, error)
+	ListInterestTiers(ctx context.Context) ([]InterestTier, error)
+	ListJournalEntries(ctx context.Context, journalID int64) ([]Entry, error)
+	ListScreeningMatches(ctx context.Context, arg ListScreeningMatchesParams) ([]ScreeningMatch, error)
+	ListSessionIPs(ctx context.Context, arg ListSessionIPsParams) ([]string, error)
 	ListTransfer(ctx context.Context, arg ListTransferParams) ([]Transfer, error)
-	UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error)
+	ListUnbalancedJournals(ctx context.Context) ([]ListUnbalancedJournalsRow, error)
+	ListUnclearedAccountOwners(ctx context.Context, accountIds []int32) ([]ListUnclearedAccountOwnersRow, error)
+	ListUnscreenedTransfers(ctx context.Context, batchSize int32) ([]Transfer, error)
+	ListUsersForScreening(ctx context.Context, arg ListUsersForScreeningParams) ([]User, error)
+	LockAuditLog(ctx context.Context) error
+	MarkInterestAccrualsPosted(ctx context.Context, arg MarkInterestAccrualsPostedParams) (int64, error)
+	MarkSystemTransfersScreened(ctx context.Context) (int64, error)
+	MarkTransferScreened(ctx context.Context, id int32) error
+	MarkUserScreened(ctx context.Context, id int32) (User, error)
+	RejectCashMovement(ctx context.Context, arg RejectCashMovementParams) (CashMovement, error)
+	ResolveFraudAlert(ctx context.Context, arg ResolveFraudAlertParams) (FraudAlert, error)
+	ReviewScreeningMatches(ctx context.Context, arg ReviewScreeningMatchesParams) ([]ScreeningMatch, error)
+	SearchAccountsByBalance(ctx context.Context, arg SearchAccountsByBalanceParams) ([]Account, error)
+	SearchAccountsByBalanceDesc(ctx context.Context, arg SearchAccountsByBalanceDescParams) ([]Account, error)
+	SearchAccountsByCreatedAt(ctx context.Context, arg SearchAccountsByCreatedAtParams) ([]Account, error)
+	SearchAccountsByCreatedAtDesc(ctx context.Context, arg SearchAccountsByCreatedAtDescParams) ([]Account, error)
+	SearchAccountsByID(ctx context.Context, arg SearchAccountsByIDParams) ([]Account, error)
+	SearchAccountsByIDDesc(ctx context.Context, arg SearchAccountsByIDDescParams) ([]Account, error)
+	SearchUsersByCreatedAt(ctx context.Context, arg SearchUsersByCreatedAtParams) ([]User, error)
+	SearchUsersByCreatedAtDesc(ctx context.Context, arg SearchUsersByCreatedAtDescParams) ([]User, error)
+	SearchUsersByID(ctx context.Context, arg SearchUsersByIDParams) ([]User, error)
+	SearchUsersByIDDesc(ctx context.Context, arg SearchUsersByIDDescParams) ([]User, error)
+	SearchUsersByUsername(ctx context.Context, arg SearchUsersByUsernameParams) ([]User, error)
+	SearchUsersByUsernameDesc(ctx context.Context, arg SearchUsersByUsernameDescParams) ([]User, error)
+	SetEntryCategory(ctx context.Context, arg SetEntryCategoryParams) (Entry, error)
+	SetFeeEntry(ctx context.Context, arg SetFeeEntryParams) (Fee, error)
+	SetUserScreeningStatus(ctx context.Context, arg SetUserScreeningStatusParams) (User, error)
+	TakeRateLimitToken(ctx context.Context, arg TakeRateLimitTokenParams) (TakeRateLimitTokenRow, error)
+	UpdateAccountStatus(ctx context.Context, arg UpdateAccountStatusParams) (Account, error)
+	UpdateInterestPosting(ctx context.Context, arg UpdateInterestPostingParams) (InterestPosting, error)
+	UpdateTransferStatus(ctx context.Context, arg UpdateTransferStatusParams) (Transfer, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) (User, error)
+	UpsertAccountLimits(ctx context.Context, arg UpsertAccountLimitsParams) (AccountLimit, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,47 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/valkyraycho/bank_project/utils"
+)
+
+func TestTakeRateLimitToken(t *testing.T) {
+	args := TakeRateLimitTokenParams{
+		Key:   utils.RandomString(12),
+		Burst: 2,
+		Rate:  0.001,
+	}
+
+	for i := 0; i < 2; i++ {
+		row, err := testStore.TakeRateLimitToken(context.Background(), args)
+		require.NoError(t, err)
+		require.True(t, row.Allowed)
+	}
+
+	row, err := testStore.TakeRateLimitToken(context.Background(), args)
+	require.NoError(t, err)
+	require.False(t, row.Allowed)
+	require.Less(t, row.Tokens, float64(1))
+}
+
+func TestDeleteIdleRateLimitBuckets(t *testing.T) {
+	args := TakeRateLimitTokenParams{
+		Key:   utils.RandomString(12),
+		Burst: 1,
+		Rate:  0.001,
+	}
+
+	_, err := testStore.TakeRateLimitToken(context.Background(), args)
+	require.NoError(t, err)
+
+	err = testStore.DeleteIdleRateLimitBuckets(context.Background(), time.Now().Add(time.Minute))
+	require.NoError(t, err)
+
+	row, err := testStore.TakeRateLimitToken(context.Background(), args)
+	require.NoError(t, err)
+	require.True(t, row.Allowed)
+}
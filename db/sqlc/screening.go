@@ -0,0 +1,199 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const (
+	ScreeningSourceCreateUser = "create_user"
+	ScreeningSourceUpdateUser = "update_user"
+	ScreeningSourceRescreen   = "rescreen"
+)
+
+var ErrNoPendingScreeningReview = errors.New("user has no pending screening review")
+
+// OwnerScreeningError is returned for a transfer or cash movement on an
+// account whose owner is pending a sanctions review or was blocked by one.
+type OwnerScreeningError struct {
+	AccountID int32
+	Status    ScreeningStatus
+}
+
+func (e *OwnerScreeningError) Error() string {
+	if e.Status == ScreeningStatusBlocked {
+		return fmt.Sprintf("account %d is blocked after a compliance review", e.AccountID)
+	}
+	return fmt.Sprintf("account %d is pending a compliance review", e.AccountID)
+}
+
+// checkOwnerScreening refuses to move money for owners who aren't cleared.
+func checkOwnerScreening(ctx context.Context, q *Queries, accountIDs ...int32) error {
+	owners, err := q.ListUnclearedAccountOwners(ctx, accountIDs)
+	if err != nil {
+		return err
+	}
+	if len(owners) > 0 {
+		return &OwnerScreeningError{AccountID: owners[0].AccountID, Status: owners[0].ScreeningStatus}
+	}
+	return nil
+}
+
+// UserScreener matches a full name against a sanctions list.
+type UserScreener interface {
+	ScreenName(name string) []NewScreeningMatch
+}
+
+// NewScreeningMatch is a sanctions list entry a name matched.
+type NewScreeningMatch struct {
+	EntryID     string  `json:"entry_id"`
+	EntryName   string  `json:"entry_name"`
+	MatchedName string  `json:"matched_name"`
+	Score       float64 `json:"score"`
+}
+
+type RecordScreeningMatchesTxParams struct {
+	UserID  int32               `json:"user_id"`
+	Matches []NewScreeningMatch `json:"matches"`
+	Source  string              `json:"source"`
+}
+
+type RecordScreeningMatchesTxResult struct {
+	User User `json:"user"`
+	// Matches holds the matches not recorded before. Entries already
+	// reviewed for the user are not raised again.
+	Matches []ScreeningMatch `json:"matches"`
+}
+
+// RecordScreeningMatchesTx records the result of screening a user. A user
+// with a new match goes to pending review, unless already blocked.
+func (store *SQLStore) RecordScreeningMatchesTx(ctx context.Context, args RecordScreeningMatchesTxParams) (RecordScreeningMatchesTxResult, error) {
+	ctx, span := tracer.Start(ctx, "db.RecordScreeningMatchesTx")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("user.id", int(args.UserID)),
+		attribute.Int("screening.matches", len(args.Matches)),
+	)
+
+	var result RecordScreeningMatchesTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		var err error
+		result, err = recordScreeningMatches(ctx, q, args)
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return result, err
+}
+
+func recordScreeningMatches(ctx context.Context, q *Queries, args RecordScreeningMatchesTxParams) (RecordScreeningMatchesTxResult, error) {
+	result := RecordScreeningMatchesTxResult{Matches: []ScreeningMatch{}}
+
+	user, err := q.GetUserForUpdate(ctx, args.UserID)
+	if err != nil {
+		return result, err
+	}
+
+	for _, match := range args.Matches {
+		recorded, err := q.CreateScreeningMatch(ctx, CreateScreeningMatchParams{
+			UserID:      user.ID,
+			EntryID:     match.EntryID,
+			EntryName:   match.EntryName,
+			MatchedName: match.MatchedName,
+			Score:       match.Score,
+			Source:      args.Source,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return result, err
+		}
+		result.Matches = append(result.Matches, recorded)
+	}
+
+	if len(result.Matches) > 0 && user.ScreeningStatus == ScreeningStatusClear {
+		_, err = q.SetUserScreeningStatus(ctx, SetUserScreeningStatusParams{
+			ID:              user.ID,
+			ScreeningStatus: ScreeningStatusPendingReview,
+		})
+		if err != nil {
+			return result, err
+		}
+	}
+
+	result.User, err = q.MarkUserScreened(ctx, user.ID)
+	return result, err
+}
+
+type ResolveScreeningReviewTxParams struct {
+	UserID int32 `json:"user_id"`
+	// Confirmed blocks the user. Otherwise the pending matches are cleared
+	// and so is the user.
+	Confirmed  bool   `json:"confirmed"`
+	ReviewedBy int32  `json:"reviewed_by"`
+	Reason     string `json:"reason"`
+}
+
+type ResolveScreeningReviewTxResult struct {
+	User    User             `json:"user"`
+	Matches []ScreeningMatch `json:"matches"`
+}
+
+// ResolveScreeningReviewTx closes the pending review of a user, deciding on
+// all of the pending matches at once.
+func (store *SQLStore) ResolveScreeningReviewTx(ctx context.Context, args ResolveScreeningReviewTxParams) (ResolveScreeningReviewTxResult, error) {
+	ctx, span := tracer.Start(ctx, "db.ResolveScreeningReviewTx")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("user.id", int(args.UserID)),
+		attribute.Bool("screening.confirmed", args.Confirmed),
+	)
+
+	var result ResolveScreeningReviewTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		user, err := q.GetUserForUpdate(ctx, args.UserID)
+		if err != nil {
+			return err
+		}
+		if user.ScreeningStatus != ScreeningStatusPendingReview {
+			return ErrNoPendingScreeningReview
+		}
+
+		matchStatus, userStatus := ScreeningMatchStatusCleared, ScreeningStatusClear
+		if args.Confirmed {
+			matchStatus, userStatus = ScreeningMatchStatusConfirmed, ScreeningStatusBlocked
+		}
+
+		result.Matches, err = q.ReviewScreeningMatches(ctx, ReviewScreeningMatchesParams{
+			UserID:       user.ID,
+			Status:       matchStatus,
+			ReviewedBy:   pgtype.Int4{Int32: args.ReviewedBy, Valid: true},
+			ReviewReason: args.Reason,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.User, err = q.SetUserScreeningStatus(ctx, SetUserScreeningStatusParams{
+			ID:              user.ID,
+			ScreeningStatus: userStatus,
+		})
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return result, err
+}
@@ -0,0 +1,182 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/valkyraycho/bank_project/utils"
+)
+
+func flagOwner(t *testing.T, account Account) RecordScreeningMatchesTxResult {
+	result, err := testStore.RecordScreeningMatchesTx(context.Background(), RecordScreeningMatchesTxParams{
+		UserID: account.OwnerID,
+		Matches: []NewScreeningMatch{
+			{EntryID: utils.RandomString(8), EntryName: "Hans Müller", MatchedName: "Hans Müller", Score: 0.9},
+		},
+		Source: ScreeningSourceCreateUser,
+	})
+	require.NoError(t, err)
+	return result
+}
+
+func TestRecordScreeningMatchesTx(t *testing.T) {
+	account := randomAccount(t)
+
+	result, err := testStore.RecordScreeningMatchesTx(context.Background(), RecordScreeningMatchesTxParams{
+		UserID:  account.OwnerID,
+		Matches: []NewScreeningMatch{},
+		Source:  ScreeningSourceCreateUser,
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.Matches)
+	require.Equal(t, ScreeningStatusClear, result.User.ScreeningStatus)
+	require.True(t, result.User.ScreenedAt.Valid)
+
+	flagged := flagOwner(t, account)
+	require.Len(t, flagged.Matches, 1)
+	require.Equal(t, ScreeningStatusPendingReview, flagged.User.ScreeningStatus)
+	require.Equal(t, ScreeningMatchStatusPending, flagged.Matches[0].Status)
+
+	again, err := testStore.RecordScreeningMatchesTx(context.Background(), RecordScreeningMatchesTxParams{
+		UserID: account.OwnerID,
+		Matches: []NewScreeningMatch{{
+			EntryID:     flagged.Matches[0].EntryID,
+			EntryName:   flagged.Matches[0].EntryName,
+			MatchedName: flagged.Matches[0].MatchedName,
+			Score:       flagged.Matches[0].Score,
+		}},
+		Source: ScreeningSourceRescreen,
+	})
+	require.NoError(t, err)
+	require.Empty(t, again.Matches)
+	require.Equal(t, ScreeningStatusPendingReview, again.User.ScreeningStatus)
+}
+
+func TestTransferTxBlockedByOwnerScreening(t *testing.T) {
+	from := randomAccount(t)
+	to := randomAccount(t)
+	flagOwner(t, to)
+
+	_, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        10,
+	})
+	var screeningErr *OwnerScreeningError
+	require.ErrorAs(t, err, &screeningErr)
+	require.Equal(t, to.ID, screeningErr.AccountID)
+	require.Equal(t, ScreeningStatusPendingReview, screeningErr.Status)
+	requireBalance(t, from, from.Balance)
+}
+
+func TestResolveScreeningReviewTxClear(t *testing.T) {
+	account := randomAccount(t)
+	other := randomAccount(t)
+	flagOwner(t, account)
+
+	result, err := testStore.ResolveScreeningReviewTx(context.Background(), ResolveScreeningReviewTxParams{
+		UserID:     account.OwnerID,
+		ReviewedBy: other.OwnerID,
+		Reason:     "different date of birth",
+	})
+	require.NoError(t, err)
+	require.Equal(t, ScreeningStatusClear, result.User.ScreeningStatus)
+	require.Len(t, result.Matches, 1)
+	require.Equal(t, ScreeningMatchStatusCleared, result.Matches[0].Status)
+	require.Equal(t, other.OwnerID, result.Matches[0].ReviewedBy.Int32)
+	require.True(t, result.Matches[0].ReviewedAt.Valid)
+
+	_, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account.ID,
+		ToAccountID:   other.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.ResolveScreeningReviewTx(context.Background(), ResolveScreeningReviewTxParams{
+		UserID:     account.OwnerID,
+		Confirmed:  true,
+		ReviewedBy: other.OwnerID,
+		Reason:     "changed my mind",
+	})
+	require.ErrorIs(t, err, ErrNoPendingScreeningReview)
+}
+
+func TestResolveScreeningReviewTxConfirm(t *testing.T) {
+	account := randomAccount(t)
+	other := randomAccount(t)
+	flagOwner(t, account)
+
+	result, err := testStore.ResolveScreeningReviewTx(context.Background(), ResolveScreeningReviewTxParams{
+		UserID:     account.OwnerID,
+		Confirmed:  true,
+		ReviewedBy: other.OwnerID,
+		Reason:     "passport matches listing",
+	})
+	require.NoError(t, err)
+	require.Equal(t, ScreeningStatusBlocked, result.User.ScreeningStatus)
+	require.Equal(t, ScreeningMatchStatusConfirmed, result.Matches[0].Status)
+
+	_, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account.ID,
+		ToAccountID:   other.ID,
+		Amount:        10,
+	})
+	var screeningErr *OwnerScreeningError
+	require.ErrorAs(t, err, &screeningErr)
+	require.Equal(t, ScreeningStatusBlocked, screeningErr.Status)
+}
+
+// stubUserScreener matches every name against a single sanctions entry.
+type stubUserScreener struct{}
+
+func (stubUserScreener) ScreenName(name string) []NewScreeningMatch {
+	return []NewScreeningMatch{{EntryID: "X-1", EntryName: name, MatchedName: name, Score: 1}}
+}
+
+func TestCreateUserTxScreensUser(t *testing.T) {
+	hashedPassword, err := utils.HashPassword(utils.RandomString(8))
+	require.NoError(t, err)
+
+	result, err := testStore.CreateUserTx(context.Background(), CreateUserTxParams{
+		CreateUserParams: CreateUserParams{
+			Username:       utils.RandomName(),
+			HashedPassword: hashedPassword,
+			Email:          utils.RandomEmail(),
+			FullName:       utils.RandomName(),
+		},
+		Screener: stubUserScreener{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, ScreeningStatusPendingReview, result.User.ScreeningStatus)
+	require.True(t, result.User.ScreenedAt.Valid)
+	require.Len(t, result.Matches, 1)
+}
+
+func TestUpdateUserTxScreensNewFullName(t *testing.T) {
+	user := randomUser(t)
+
+	result, err := testStore.UpdateUserTx(context.Background(), UpdateUserTxParams{
+		UpdateUserParams: UpdateUserParams{
+			ID:    user.ID,
+			Email: pgtype.Text{String: utils.RandomEmail(), Valid: true},
+		},
+		Screener: stubUserScreener{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, ScreeningStatusClear, result.User.ScreeningStatus)
+	require.Empty(t, result.Matches)
+
+	result, err = testStore.UpdateUserTx(context.Background(), UpdateUserTxParams{
+		UpdateUserParams: UpdateUserParams{
+			ID:       user.ID,
+			FullName: pgtype.Text{String: utils.RandomName(), Valid: true},
+		},
+		Screener: stubUserScreener{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, ScreeningStatusPendingReview, result.User.ScreeningStatus)
+	require.Len(t, result.Matches, 1)
+}
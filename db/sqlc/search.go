@@ -0,0 +1,195 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// SearchAccountsParams filters the accounts of customers. Invalid filters
+// match everything. CursorID, with the sort key of the same row, is the last
+// row of the previous page; invalid starts from the first page.
+type SearchAccountsParams struct {
+	OwnerID         pgtype.Int4        `json:"owner_id"`
+	Currency        pgtype.Text        `json:"currency"`
+	MinBalance      pgtype.Int4        `json:"min_balance"`
+	MaxBalance      pgtype.Int4        `json:"max_balance"`
+	CreatedAfter    pgtype.Timestamptz `json:"created_after"`
+	CreatedBefore   pgtype.Timestamptz `json:"created_before"`
+	SortBy          string             `json:"sort_by"`
+	Descending      bool               `json:"descending"`
+	CursorID        pgtype.Int4        `json:"cursor_id"`
+	CursorBalance   int32              `json:"cursor_balance"`
+	CursorCreatedAt time.Time          `json:"cursor_created_at"`
+	PageSize        int32              `json:"page_size"`
+}
+
+// SearchAccounts returns a page of customer accounts ordered by SortBy, then
+// id. Each sort order has its own query so the keyset comparison and the
+// ORDER BY can use the matching index.
+func (store *SQLStore) SearchAccounts(ctx context.Context, arg SearchAccountsParams) ([]Account, error) {
+	switch {
+	case arg.SortBy == "id" && !arg.Descending:
+		return store.SearchAccountsByID(ctx, SearchAccountsByIDParams{
+			OwnerID:       arg.OwnerID,
+			Currency:      arg.Currency,
+			MinBalance:    arg.MinBalance,
+			MaxBalance:    arg.MaxBalance,
+			CreatedAfter:  arg.CreatedAfter,
+			CreatedBefore: arg.CreatedBefore,
+			CursorID:      arg.CursorID,
+			PageSize:      arg.PageSize,
+		})
+	case arg.SortBy == "id":
+		return store.SearchAccountsByIDDesc(ctx, SearchAccountsByIDDescParams{
+			OwnerID:       arg.OwnerID,
+			Currency:      arg.Currency,
+			MinBalance:    arg.MinBalance,
+			MaxBalance:    arg.MaxBalance,
+			CreatedAfter:  arg.CreatedAfter,
+			CreatedBefore: arg.CreatedBefore,
+			CursorID:      arg.CursorID,
+			PageSize:      arg.PageSize,
+		})
+	case arg.SortBy == "balance" && !arg.Descending:
+		return store.SearchAccountsByBalance(ctx, SearchAccountsByBalanceParams{
+			OwnerID:       arg.OwnerID,
+			Currency:      arg.Currency,
+			MinBalance:    arg.MinBalance,
+			MaxBalance:    arg.MaxBalance,
+			CreatedAfter:  arg.CreatedAfter,
+			CreatedBefore: arg.CreatedBefore,
+			CursorID:      arg.CursorID,
+			CursorBalance: arg.CursorBalance,
+			PageSize:      arg.PageSize,
+		})
+	case arg.SortBy == "balance":
+		return store.SearchAccountsByBalanceDesc(ctx, SearchAccountsByBalanceDescParams{
+			OwnerID:       arg.OwnerID,
+			Currency:      arg.Currency,
+			MinBalance:    arg.MinBalance,
+			MaxBalance:    arg.MaxBalance,
+			CreatedAfter:  arg.CreatedAfter,
+			CreatedBefore: arg.CreatedBefore,
+			CursorID:      arg.CursorID,
+			CursorBalance: arg.CursorBalance,
+			PageSize:      arg.PageSize,
+		})
+	case arg.SortBy == "created_at" && !arg.Descending:
+		return store.SearchAccountsByCreatedAt(ctx, SearchAccountsByCreatedAtParams{
+			OwnerID:         arg.OwnerID,
+			Currency:        arg.Currency,
+			MinBalance:      arg.MinBalance,
+			MaxBalance:      arg.MaxBalance,
+			CreatedAfter:    arg.CreatedAfter,
+			CreatedBefore:   arg.CreatedBefore,
+			CursorID:        arg.CursorID,
+			CursorCreatedAt: arg.CursorCreatedAt,
+			PageSize:        arg.PageSize,
+		})
+	case arg.SortBy == "created_at":
+		return store.SearchAccountsByCreatedAtDesc(ctx, SearchAccountsByCreatedAtDescParams{
+			OwnerID:         arg.OwnerID,
+			Currency:        arg.Currency,
+			MinBalance:      arg.MinBalance,
+			MaxBalance:      arg.MaxBalance,
+			CreatedAfter:    arg.CreatedAfter,
+			CreatedBefore:   arg.CreatedBefore,
+			CursorID:        arg.CursorID,
+			CursorCreatedAt: arg.CursorCreatedAt,
+			PageSize:        arg.PageSize,
+		})
+	}
+	return nil, fmt.Errorf("unsupported account sort field %q", arg.SortBy)
+}
+
+// SearchUsersParams filters users. Invalid filters match everything.
+// CursorID, with the sort key of the same row, is the last row of the
+// previous page; invalid starts from the first page.
+type SearchUsersParams struct {
+	UsernamePrefix  pgtype.Text        `json:"username_prefix"`
+	EmailPrefix     pgtype.Text        `json:"email_prefix"`
+	Role            pgtype.Text        `json:"role"`
+	CreatedAfter    pgtype.Timestamptz `json:"created_after"`
+	CreatedBefore   pgtype.Timestamptz `json:"created_before"`
+	SortBy          string             `json:"sort_by"`
+	Descending      bool               `json:"descending"`
+	CursorID        pgtype.Int4        `json:"cursor_id"`
+	CursorUsername  string             `json:"cursor_username"`
+	CursorCreatedAt time.Time          `json:"cursor_created_at"`
+	PageSize        int32              `json:"page_size"`
+}
+
+// SearchUsers returns a page of users ordered by SortBy, then id, with one
+// query per sort order like SearchAccounts.
+func (store *SQLStore) SearchUsers(ctx context.Context, arg SearchUsersParams) ([]User, error) {
+	switch {
+	case arg.SortBy == "id" && !arg.Descending:
+		return store.SearchUsersByID(ctx, SearchUsersByIDParams{
+			UsernamePrefix: arg.UsernamePrefix,
+			EmailPrefix:    arg.EmailPrefix,
+			Role:           arg.Role,
+			CreatedAfter:   arg.CreatedAfter,
+			CreatedBefore:  arg.CreatedBefore,
+			CursorID:       arg.CursorID,
+			PageSize:       arg.PageSize,
+		})
+	case arg.SortBy == "id":
+		return store.SearchUsersByIDDesc(ctx, SearchUsersByIDDescParams{
+			UsernamePrefix: arg.UsernamePrefix,
+			EmailPrefix:    arg.EmailPrefix,
+			Role:           arg.Role,
+			CreatedAfter:   arg.CreatedAfter,
+			CreatedBefore:  arg.CreatedBefore,
+			CursorID:       arg.CursorID,
+			PageSize:       arg.PageSize,
+		})
+	case arg.SortBy == "username" && !arg.Descending:
+		return store.SearchUsersByUsername(ctx, SearchUsersByUsernameParams{
+			UsernamePrefix: arg.UsernamePrefix,
+			EmailPrefix:    arg.EmailPrefix,
+			Role:           arg.Role,
+			CreatedAfter:   arg.CreatedAfter,
+			CreatedBefore:  arg.CreatedBefore,
+			CursorID:       arg.CursorID,
+			CursorUsername: arg.CursorUsername,
+			PageSize:       arg.PageSize,
+		})
+	case arg.SortBy == "username":
+		return store.SearchUsersByUsernameDesc(ctx, SearchUsersByUsernameDescParams{
+			UsernamePrefix: arg.UsernamePrefix,
+			EmailPrefix:    arg.EmailPrefix,
+			Role:           arg.Role,
+			CreatedAfter:   arg.CreatedAfter,
+			CreatedBefore:  arg.CreatedBefore,
+			CursorID:       arg.CursorID,
+			CursorUsername: arg.CursorUsername,
+			PageSize:       arg.PageSize,
+		})
+	case arg.SortBy == "created_at" && !arg.Descending:
+		return store.SearchUsersByCreatedAt(ctx, SearchUsersByCreatedAtParams{
+			UsernamePrefix:  arg.UsernamePrefix,
+			EmailPrefix:     arg.EmailPrefix,
+			Role:            arg.Role,
+			CreatedAfter:    arg.CreatedAfter,
+			CreatedBefore:   arg.CreatedBefore,
+			CursorID:        arg.CursorID,
+			CursorCreatedAt: arg.CursorCreatedAt,
+			PageSize:        arg.PageSize,
+		})
+	case arg.SortBy == "created_at":
+		return store.SearchUsersByCreatedAtDesc(ctx, SearchUsersByCreatedAtDescParams{
+			UsernamePrefix:  arg.UsernamePrefix,
+			EmailPrefix:     arg.EmailPrefix,
+			Role:            arg.Role,
+			CreatedAfter:    arg.CreatedAfter,
+			CreatedBefore:   arg.CreatedBefore,
+			CursorID:        arg.CursorID,
+			CursorCreatedAt: arg.CursorCreatedAt,
+			PageSize:        arg.PageSize,
+		})
+	}
+	return nil, fmt.Errorf("unsupported user sort field %q", arg.SortBy)
+}
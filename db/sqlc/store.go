@@ -5,11 +5,35 @@ import (
 	"fmt"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/valkyraycho/bank_project/telemetry"
+	"go.opentelemetry.io/otel"
 )
 
+var tracer = otel.Tracer("github.com/valkyraycho/bank_project/db")
+
 type Store interface {
 	Querier
 	TransferTx(ctx context.Context, args TransferTxParams) (TransferTxResult, error)
+	CashMovementTx(ctx context.Context, args CashMovementTxParams) (CashMovementTxResult, error)
+	RequestApprovalTx(ctx context.Context, args RequestApprovalTxParams) (ApprovalRequest, error)
+	DecideApprovalTx(ctx context.Context, args DecideApprovalTxParams) (ApprovalRequest, error)
+	ExpireApprovalTx(ctx context.Context, id int64) (ApprovalRequest, error)
+	AppendAuditLogTx(ctx context.Context, args AppendAuditLogTxParams) (AuditLog, error)
+	CloseAccountTx(ctx context.Context, args CloseAccountTxParams) (CloseAccountTxResult, error)
+	PostInterestTx(ctx context.Context, args PostInterestTxParams) (PostInterestTxResult, error)
+	ChargeMaintenanceFeeTx(ctx context.Context, args ChargeMaintenanceFeeTxParams) (ChargeMaintenanceFeeTxResult, error)
+	QuoteTransferFee(ctx context.Context, fromAccount Account, amount int32) (int32, error)
+	SearchAccounts(ctx context.Context, arg SearchAccountsParams) ([]Account, error)
+	SearchUsers(ctx context.Context, arg SearchUsersParams) ([]User, error)
+	GetTransferAllowance(ctx context.Context, account Account) (TransferAllowance, error)
+	SetTransferLimitsTx(ctx context.Context, args SetTransferLimitsTxParams) (SetTransferLimitsTxResult, error)
+	ResolveFraudAlertTx(ctx context.Context, args ResolveFraudAlertTxParams) (ResolveFraudAlertTxResult, error)
+	CreateUserTx(ctx context.Context, args CreateUserTxParams) (CreateUserTxResult, error)
+	UpdateUserTx(ctx context.Context, args UpdateUserTxParams) (UpdateUserTxResult, error)
+	RecordScreeningMatchesTx(ctx context.Context, args RecordScreeningMatchesTxParams) (RecordScreeningMatchesTxResult, error)
+	ResolveScreeningReviewTx(ctx context.Context, args ResolveScreeningReviewTxParams) (ResolveScreeningReviewTxResult, error)
+	Ping(ctx context.Context) error
+	MigrationVersion(ctx context.Context) (version uint, dirty bool, err error)
 }
 
 type SQLStore struct {
@@ -31,6 +55,7 @@ func (store *SQLStore) ExecTx(ctx context.Context, fn func(*Queries) error) erro
 	}
 	q := New(tx)
 	if err := fn(q); err != nil {
+		telemetry.Logger(ctx).Debug().Err(err).Msg("rolling back transaction")
 		if rberr := tx.Rollback(ctx); rberr != nil {
 			return fmt.Errorf("transaction error: %w, rollback error: %w", err, rberr)
 		}
@@ -38,3 +63,21 @@ func (store *SQLStore) ExecTx(ctx context.Context, fn func(*Queries) error) erro
 	}
 	return tx.Commit(ctx)
 }
+
+func (store *SQLStore) Ping(ctx context.Context) error {
+	return store.connPool.Ping(ctx)
+}
+
+// MigrationVersion reads the version recorded by golang-migrate. The
+// schema_migrations table is owned by the migration tool, so it is queried
+// directly instead of going through sqlc.
+func (store *SQLStore) MigrationVersion(ctx context.Context) (uint, bool, error) {
+	var version int64
+	var dirty bool
+
+	err := store.connPool.QueryRow(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+	if err != nil {
+		return 0, false, fmt.Errorf("cannot read migration version: %w", err)
+	}
+	return uint(version), dirty, nil
+}
@@ -0,0 +1,270 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+var (
+	ErrPerTransferLimitExceeded   = errors.New("amount exceeds the per-transfer limit")
+	ErrDailyAmountLimitExceeded   = errors.New("daily transfer amount limit exceeded")
+	ErrDailyCountLimitReached     = errors.New("daily transfer count limit reached")
+	ErrMonthlyAmountLimitExceeded = errors.New("monthly transfer amount limit exceeded")
+	ErrMonthlyCountLimitReached   = errors.New("monthly transfer count limit reached")
+)
+
+// TransferLimitError reports which transfer limit of an account refused a
+// transfer.
+type TransferLimitError struct {
+	AccountID int32
+	Rule      error
+}
+
+func (e *TransferLimitError) Error() string {
+	return fmt.Sprintf("account %d: %s", e.AccountID, e.Rule)
+}
+
+func (e *TransferLimitError) Unwrap() error {
+	return e.Rule
+}
+
+// TransferLimitChangeError is returned when a limit would move in the wrong
+// direction: customers may only lower their limits and bankers only raise
+// them.
+type TransferLimitChangeError struct {
+	Limit   string
+	Current pgtype.Int4
+	Lower   bool
+}
+
+func (e *TransferLimitChangeError) Error() string {
+	current := "unlimited"
+	if e.Current.Valid {
+		current = fmt.Sprintf("%d", e.Current.Int32)
+	}
+	if e.Lower {
+		return fmt.Sprintf("%s can only be lowered, currently %s", e.Limit, current)
+	}
+	return fmt.Sprintf("%s can only be raised, currently %s", e.Limit, current)
+}
+
+// TransferLimits bound the outgoing transfers of an account. Invalid fields
+// are unlimited.
+type TransferLimits struct {
+	PerTransfer   pgtype.Int4 `json:"per_transfer"`
+	DailyAmount   pgtype.Int4 `json:"daily_amount"`
+	DailyCount    pgtype.Int4 `json:"daily_count"`
+	MonthlyAmount pgtype.Int4 `json:"monthly_amount"`
+	MonthlyCount  pgtype.Int4 `json:"monthly_count"`
+}
+
+type transferLimitField struct {
+	name  string
+	value *pgtype.Int4
+}
+
+// fields names the limits after their columns, in a fixed order.
+func (limits *TransferLimits) fields() []transferLimitField {
+	return []transferLimitField{
+		{"per_transfer_limit", &limits.PerTransfer},
+		{"daily_amount_limit", &limits.DailyAmount},
+		{"daily_count_limit", &limits.DailyCount},
+		{"monthly_amount_limit", &limits.MonthlyAmount},
+		{"monthly_count_limit", &limits.MonthlyCount},
+	}
+}
+
+func firstValid(values ...pgtype.Int4) pgtype.Int4 {
+	for _, value := range values {
+		if value.Valid {
+			return value
+		}
+	}
+	return pgtype.Int4{}
+}
+
+// EffectiveTransferLimits applies the overrides of an account on top of the
+// defaults of its product. A zero override leaves the defaults in force.
+func EffectiveTransferLimits(product AccountProduct, override AccountLimit) TransferLimits {
+	return TransferLimits{
+		PerTransfer:   firstValid(override.PerTransferLimit, product.PerTransferLimit),
+		DailyAmount:   firstValid(override.DailyAmountLimit, product.DailyAmountLimit),
+		DailyCount:    firstValid(override.DailyCountLimit, product.DailyCountLimit),
+		MonthlyAmount: firstValid(override.MonthlyAmountLimit, product.MonthlyAmountLimit),
+		MonthlyCount:  firstValid(override.MonthlyCountLimit, product.MonthlyCountLimit),
+	}
+}
+
+// TransferAllowance holds the limits in force for an account and what was
+// already sent against them in the current day and month.
+type TransferAllowance struct {
+	AccountID int32                        `json:"account_id"`
+	Limits    TransferLimits               `json:"limits"`
+	Used      GetOutgoingTransferTotalsRow `json:"used"`
+}
+
+func findAccountLimits(ctx context.Context, q *Queries, accountID int32) (AccountLimit, error) {
+	override, err := q.GetAccountLimits(ctx, accountID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return AccountLimit{}, nil
+	}
+	return override, err
+}
+
+func getTransferAllowance(ctx context.Context, q *Queries, account Account, product AccountProduct) (TransferAllowance, error) {
+	override, err := findAccountLimits(ctx, q, account.ID)
+	if err != nil {
+		return TransferAllowance{}, err
+	}
+
+	used, err := q.GetOutgoingTransferTotals(ctx, account.ID)
+	if err != nil {
+		return TransferAllowance{}, err
+	}
+
+	return TransferAllowance{
+		AccountID: account.ID,
+		Limits:    EffectiveTransferLimits(product, override),
+		Used:      used,
+	}, nil
+}
+
+// GetTransferAllowance returns the transfer limits of an account and how much
+// of them is used up.
+func (store *SQLStore) GetTransferAllowance(ctx context.Context, account Account) (TransferAllowance, error) {
+	product, err := store.GetAccountProduct(ctx, account.ProductCode)
+	if err != nil {
+		return TransferAllowance{}, err
+	}
+	return getTransferAllowance(ctx, store.Queries, account, product)
+}
+
+// checkTransferLimits refuses a transfer of amount that would break one of
+// the limits of the account. The account row must be locked so concurrent
+// transfers can't both squeeze under a limit.
+func checkTransferLimits(ctx context.Context, q *Queries, account Account, product AccountProduct, amount int32) error {
+	allowance, err := getTransferAllowance(ctx, q, account, product)
+	if err != nil {
+		return err
+	}
+
+	limits, used := allowance.Limits, allowance.Used
+	var rule error
+	switch {
+	case limits.PerTransfer.Valid && amount > limits.PerTransfer.Int32:
+		rule = ErrPerTransferLimitExceeded
+	case limits.DailyCount.Valid && used.DailyCount >= int64(limits.DailyCount.Int32):
+		rule = ErrDailyCountLimitReached
+	case limits.DailyAmount.Valid && used.DailyAmount+int64(amount) > int64(limits.DailyAmount.Int32):
+		rule = ErrDailyAmountLimitExceeded
+	case limits.MonthlyCount.Valid && used.MonthlyCount >= int64(limits.MonthlyCount.Int32):
+		rule = ErrMonthlyCountLimitReached
+	case limits.MonthlyAmount.Valid && used.MonthlyAmount+int64(amount) > int64(limits.MonthlyAmount.Int32):
+		rule = ErrMonthlyAmountLimitExceeded
+	}
+	if rule != nil {
+		return &TransferLimitError{AccountID: account.ID, Rule: rule}
+	}
+	return nil
+}
+
+type SetTransferLimitsTxParams struct {
+	AccountID int32 `json:"account_id"`
+	// Limits holds the limits to change. Invalid fields are left as they are.
+	Limits TransferLimits `json:"limits"`
+	// Lower requires every change to lower its limit, otherwise every change
+	// must raise it.
+	Lower     bool  `json:"lower"`
+	UpdatedBy int32 `json:"updated_by"`
+}
+
+type SetTransferLimitsTxResult struct {
+	// Previous are the limits that were in force before the change.
+	Previous  TransferLimits    `json:"previous"`
+	Allowance TransferAllowance `json:"allowance"`
+}
+
+// SetTransferLimitsTx overrides the transfer limits of an account. Each
+// change is checked against the limit currently in force, whether it comes
+// from the product or an earlier override.
+func (store *SQLStore) SetTransferLimitsTx(ctx context.Context, args SetTransferLimitsTxParams) (SetTransferLimitsTxResult, error) {
+	ctx, span := tracer.Start(ctx, "db.SetTransferLimitsTx")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("account.id", int(args.AccountID)),
+		attribute.Bool("transfer_limits.lower", args.Lower),
+	)
+
+	var result SetTransferLimitsTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		account, err := q.GetAccountForUpdate(ctx, args.AccountID)
+		if err != nil {
+			return err
+		}
+
+		product, err := q.GetAccountProduct(ctx, account.ProductCode)
+		if err != nil {
+			return err
+		}
+
+		override, err := findAccountLimits(ctx, q, account.ID)
+		if err != nil {
+			return err
+		}
+
+		current := EffectiveTransferLimits(product, override)
+		result.Previous = current
+		updated := TransferLimits{
+			PerTransfer:   override.PerTransferLimit,
+			DailyAmount:   override.DailyAmountLimit,
+			DailyCount:    override.DailyCountLimit,
+			MonthlyAmount: override.MonthlyAmountLimit,
+			MonthlyCount:  override.MonthlyCountLimit,
+		}
+
+		currentFields, updatedFields := current.fields(), updated.fields()
+		for i, change := range args.Limits.fields() {
+			if !change.value.Valid {
+				continue
+			}
+
+			limit := *currentFields[i].value
+			allowed := limit.Valid && change.value.Int32 >= limit.Int32
+			if args.Lower {
+				allowed = !limit.Valid || change.value.Int32 <= limit.Int32
+			}
+			if !allowed {
+				return &TransferLimitChangeError{Limit: change.name, Current: limit, Lower: args.Lower}
+			}
+			*updatedFields[i].value = *change.value
+		}
+
+		_, err = q.UpsertAccountLimits(ctx, UpsertAccountLimitsParams{
+			AccountID:          account.ID,
+			PerTransferLimit:   updated.PerTransfer,
+			DailyAmountLimit:   updated.DailyAmount,
+			DailyCountLimit:    updated.DailyCount,
+			MonthlyAmountLimit: updated.MonthlyAmount,
+			MonthlyCountLimit:  updated.MonthlyCount,
+			UpdatedBy:          args.UpdatedBy,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Allowance, err = getTransferAllowance(ctx, q, account, product)
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return result, err
+}
@@ -0,0 +1,119 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEffectiveTransferLimits(t *testing.T) {
+	product := AccountProduct{
+		PerTransferLimit: pgtype.Int4{Int32: 500, Valid: true},
+		DailyAmountLimit: pgtype.Int4{Int32: 1000, Valid: true},
+	}
+
+	limits := EffectiveTransferLimits(product, AccountLimit{})
+	require.Equal(t, product.PerTransferLimit, limits.PerTransfer)
+	require.Equal(t, product.DailyAmountLimit, limits.DailyAmount)
+	require.False(t, limits.MonthlyCount.Valid)
+
+	limits = EffectiveTransferLimits(product, AccountLimit{
+		DailyAmountLimit:  pgtype.Int4{Int32: 200, Valid: true},
+		MonthlyCountLimit: pgtype.Int4{Int32: 3, Valid: true},
+	})
+	require.Equal(t, product.PerTransferLimit, limits.PerTransfer)
+	require.Equal(t, int32(200), limits.DailyAmount.Int32)
+	require.Equal(t, int32(3), limits.MonthlyCount.Int32)
+}
+
+func TestTransferTxEnforcesLimits(t *testing.T) {
+	from := randomAccount(t)
+	to := randomAccount(t)
+
+	_, err := testStore.SetTransferLimitsTx(context.Background(), SetTransferLimitsTxParams{
+		AccountID: from.ID,
+		Limits: TransferLimits{
+			PerTransfer: pgtype.Int4{Int32: 5, Valid: true},
+			DailyAmount: pgtype.Int4{Int32: 8, Valid: true},
+			DailyCount:  pgtype.Int4{Int32: 2, Valid: true},
+		},
+		Lower:     true,
+		UpdatedBy: from.OwnerID,
+	})
+	require.NoError(t, err)
+
+	transfer := func(amount int32) error {
+		_, err := testStore.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: from.ID,
+			ToAccountID:   to.ID,
+			Amount:        amount,
+		})
+		return err
+	}
+
+	require.ErrorIs(t, transfer(6), ErrPerTransferLimitExceeded)
+	require.NoError(t, transfer(5))
+	require.ErrorIs(t, transfer(4), ErrDailyAmountLimitExceeded)
+	require.NoError(t, transfer(3))
+	require.ErrorIs(t, transfer(0), ErrDailyCountLimitReached)
+
+	var limitErr *TransferLimitError
+	require.ErrorAs(t, transfer(1), &limitErr)
+	require.Equal(t, from.ID, limitErr.AccountID)
+
+	allowance, err := testStore.GetTransferAllowance(context.Background(), from)
+	require.NoError(t, err)
+	require.Equal(t, int64(8), allowance.Used.DailyAmount)
+	require.Equal(t, int64(2), allowance.Used.DailyCount)
+	require.Equal(t, int64(8), allowance.Used.MonthlyAmount)
+}
+
+func TestSetTransferLimitsTx(t *testing.T) {
+	account := randomAccount(t)
+	product, err := testStore.GetAccountProduct(context.Background(), account.ProductCode)
+	require.NoError(t, err)
+	require.True(t, product.DailyAmountLimit.Valid)
+
+	lowered := product.DailyAmountLimit.Int32 - 1
+	result, err := testStore.SetTransferLimitsTx(context.Background(), SetTransferLimitsTxParams{
+		AccountID: account.ID,
+		Limits:    TransferLimits{DailyAmount: pgtype.Int4{Int32: lowered, Valid: true}},
+		Lower:     true,
+		UpdatedBy: account.OwnerID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, product.DailyAmountLimit, result.Previous.DailyAmount)
+	require.Equal(t, lowered, result.Allowance.Limits.DailyAmount.Int32)
+	require.Equal(t, product.PerTransferLimit, result.Allowance.Limits.PerTransfer)
+
+	// Customers can't lower their way back up.
+	_, err = testStore.SetTransferLimitsTx(context.Background(), SetTransferLimitsTxParams{
+		AccountID: account.ID,
+		Limits:    TransferLimits{DailyAmount: product.DailyAmountLimit},
+		Lower:     true,
+		UpdatedBy: account.OwnerID,
+	})
+	var changeErr *TransferLimitChangeError
+	require.ErrorAs(t, err, &changeErr)
+	require.Equal(t, "daily_amount_limit", changeErr.Limit)
+
+	// Nor can bankers raise a limit below the one in force.
+	_, err = testStore.SetTransferLimitsTx(context.Background(), SetTransferLimitsTxParams{
+		AccountID: account.ID,
+		Limits:    TransferLimits{DailyAmount: pgtype.Int4{Int32: lowered - 1, Valid: true}},
+		UpdatedBy: account.OwnerID,
+	})
+	require.ErrorAs(t, err, &changeErr)
+
+	raised := product.DailyAmountLimit.Int32 * 2
+	result, err = testStore.SetTransferLimitsTx(context.Background(), SetTransferLimitsTxParams{
+		AccountID: account.ID,
+		Limits:    TransferLimits{DailyAmount: pgtype.Int4{Int32: raised, Valid: true}},
+		UpdatedBy: account.OwnerID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, lowered, result.Previous.DailyAmount.Int32)
+	require.Equal(t, raised, result.Allowance.Limits.DailyAmount.Int32)
+}
@@ -7,26 +7,44 @@ package db
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createTransfer = `-- name: CreateTransfer :one
 INSERT INTO transfers (
   from_account_id,
   to_account_id,
-  amount
+  amount,
+  memo,
+  reference,
+  sender_id,
+  status
 ) VALUES (
-  $1, $2, $3
-)RETURNING id, from_account_id, to_account_id, amount, created_at
+  $1, $2, $3, $4, $5, $6, $7
+)RETURNING id, from_account_id, to_account_id, amount, created_at, memo, reference, sender_id, status, screened_at
 `
 
 type CreateTransferParams struct {
-	FromAccountID int32 `json:"from_account_id"`
-	ToAccountID   int32 `json:"to_account_id"`
-	Amount        int32 `json:"amount"`
+	FromAccountID int32          `json:"from_account_id"`
+	ToAccountID   int32          `json:"to_account_id"`
+	Amount        int32          `json:"amount"`
+	Memo          string         `json:"memo"`
+	Reference     pgtype.Text    `json:"reference"`
+	SenderID      pgtype.Int4    `json:"sender_id"`
+	Status        TransferStatus `json:"status"`
 }
 
 func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error) {
-	row := q.db.QueryRow(ctx, createTransfer, arg.FromAccountID, arg.ToAccountID, arg.Amount)
+	row := q.db.QueryRow(ctx, createTransfer,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.Memo,
+		arg.Reference,
+		arg.SenderID,
+		arg.Status,
+	)
 	var i Transfer
 	err := row.Scan(
 		&i.ID,
@@ -34,12 +52,17 @@ func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams)
 		&i.ToAccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.Memo,
+		&i.Reference,
+		&i.SenderID,
+		&i.Status,
+		&i.ScreenedAt,
 	)
 	return i, err
 }
 
 const getTransfer = `-- name: GetTransfer :one
-SELECT id, from_account_id, to_account_id, amount, created_at FROM transfers
+SELECT id, from_account_id, to_account_id, amount, created_at, memo, reference, sender_id, status, screened_at FROM transfers
 WHERE id = $1 LIMIT 1
 `
 
@@ -52,12 +75,41 @@ func (q *Queries) GetTransfer(ctx context.Context, id int32) (Transfer, error) {
 		&i.ToAccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.Memo,
+		&i.Reference,
+		&i.SenderID,
+		&i.Status,
+		&i.ScreenedAt,
+	)
+	return i, err
+}
+
+const getTransferForUpdate = `-- name: GetTransferForUpdate :one
+SELECT id, from_account_id, to_account_id, amount, created_at, memo, reference, sender_id, status, screened_at FROM transfers
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetTransferForUpdate(ctx context.Context, id int32) (Transfer, error) {
+	row := q.db.QueryRow(ctx, getTransferForUpdate, id)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.Memo,
+		&i.Reference,
+		&i.SenderID,
+		&i.Status,
+		&i.ScreenedAt,
 	)
 	return i, err
 }
 
 const listTransfer = `-- name: ListTransfer :many
-SELECT id, from_account_id, to_account_id, amount, created_at FROM transfers
+SELECT id, from_account_id, to_account_id, amount, created_at, memo, reference, sender_id, status, screened_at FROM transfers
 WHERE
     from_account_id = $1 OR
     to_account_id = $2
@@ -93,6 +145,11 @@ func (q *Queries) ListTransfer(ctx context.Context, arg ListTransferParams) ([]T
 			&i.ToAccountID,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.Memo,
+			&i.Reference,
+			&i.SenderID,
+			&i.Status,
+			&i.ScreenedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -103,3 +160,34 @@ func (q *Queries) ListTransfer(ctx context.Context, arg ListTransferParams) ([]T
 	}
 	return items, nil
 }
+
+const updateTransferStatus = `-- name: UpdateTransferStatus :one
+UPDATE transfers
+SET status = $1
+WHERE id = $2 AND status = $3
+RETURNING id, from_account_id, to_account_id, amount, created_at, memo, reference, sender_id, status, screened_at
+`
+
+type UpdateTransferStatusParams struct {
+	Status        TransferStatus `json:"status"`
+	ID            int32          `json:"id"`
+	CurrentStatus TransferStatus `json:"current_status"`
+}
+
+func (q *Queries) UpdateTransferStatus(ctx context.Context, arg UpdateTransferStatusParams) (Transfer, error) {
+	row := q.db.QueryRow(ctx, updateTransferStatus, arg.Status, arg.ID, arg.CurrentStatus)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.Memo,
+		&i.Reference,
+		&i.SenderID,
+		&i.Status,
+		&i.ScreenedAt,
+	)
+	return i, err
+}
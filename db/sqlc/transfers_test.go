@@ -59,6 +59,7 @@ func randomTransfer(t *testing.T, account1, account2 Account) Transfer {
 		FromAccountID: account1.ID,
 		ToAccountID:   account2.ID,
 		Amount:        utils.RandomMoney(),
+		Status:        TransferStatusCompleted,
 	}
 
 	transfer, err := testStore.CreateTransfer(context.Background(), args)
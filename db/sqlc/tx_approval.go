@@ -0,0 +1,348 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const (
+	ApprovalActionCashMovement    = "cash_movement"
+	ApprovalActionUnfreezeAccount = "unfreeze_account"
+	ApprovalActionChangeUserRole  = "change_user_role"
+	ApprovalActionCloseAccount    = "close_account"
+)
+
+var (
+	ErrApprovalNotPending    = errors.New("approval request is not pending")
+	ErrApprovalExpired       = errors.New("approval request has expired")
+	ErrApprovalNotExpired    = errors.New("approval request has not expired yet")
+	ErrApprovalStale         = errors.New("target of the approval request changed since it was requested")
+	ErrSelfApproval          = errors.New("request must be decided by another banker")
+	ErrUnknownApprovalAction = errors.New("unknown approval action")
+)
+
+type CashMovementApproval struct {
+	CashMovementID int64 `json:"cash_movement_id"`
+}
+
+type UnfreezeAccountApproval struct {
+	AccountID int32  `json:"account_id"`
+	Reason    string `json:"reason"`
+}
+
+// CloseAccountApproval closes an account and sweeps its balance to an
+// account of another owner.
+type CloseAccountApproval struct {
+	AccountID        int32  `json:"account_id"`
+	SweepToAccountID int32  `json:"sweep_to_account_id"`
+	Reason           string `json:"reason"`
+}
+
+type ChangeUserRoleApproval struct {
+	UserID int32  `json:"user_id"`
+	Role   string `json:"role"`
+}
+
+// approvalAction runs the action behind an approval request, in the same
+// transaction that decides it. cancel undoes whatever the request put on
+// hold when it is rejected or expires, and may be nil.
+type approvalAction struct {
+	execute func(ctx context.Context, q *Queries, payload []byte, decidedBy int32) error
+	cancel  func(ctx context.Context, q *Queries, payload []byte, decidedBy pgtype.Int4, reason string) error
+}
+
+var approvalActions = map[string]approvalAction{
+	ApprovalActionCashMovement: {
+		execute: func(ctx context.Context, q *Queries, payload []byte, decidedBy int32) error {
+			var args CashMovementApproval
+			if err := json.Unmarshal(payload, &args); err != nil {
+				return err
+			}
+
+			movement, err := q.GetCashMovementForUpdate(ctx, args.CashMovementID)
+			if err != nil {
+				return err
+			}
+			if movement.Status != CashMovementStatusPendingApproval {
+				return ErrCashMovementNotPending
+			}
+
+			_, err = settleCashMovement(ctx, q, movement, pgtype.Int4{Int32: decidedBy, Valid: true})
+			return err
+		},
+		cancel: func(ctx context.Context, q *Queries, payload []byte, decidedBy pgtype.Int4, reason string) error {
+			var args CashMovementApproval
+			if err := json.Unmarshal(payload, &args); err != nil {
+				return err
+			}
+
+			_, err := q.RejectCashMovement(ctx, RejectCashMovementParams{
+				ID:             args.CashMovementID,
+				DecidedBy:      decidedBy,
+				DecisionReason: reason,
+			})
+			if err == pgx.ErrNoRows {
+				return ErrCashMovementNotPending
+			}
+			return err
+		},
+	},
+	ApprovalActionUnfreezeAccount: {
+		execute: func(ctx context.Context, q *Queries, payload []byte, decidedBy int32) error {
+			var args UnfreezeAccountApproval
+			if err := json.Unmarshal(payload, &args); err != nil {
+				return err
+			}
+
+			_, err := q.UpdateAccountStatus(ctx, UpdateAccountStatusParams{
+				ID:              args.AccountID,
+				CurrentStatus:   AccountStatusFrozen,
+				Status:          AccountStatusActive,
+				StatusReason:    args.Reason,
+				StatusChangedBy: pgtype.Int4{Int32: decidedBy, Valid: true},
+			})
+			if err == pgx.ErrNoRows {
+				return ErrApprovalStale
+			}
+			return err
+		},
+	},
+	ApprovalActionCloseAccount: {
+		execute: func(ctx context.Context, q *Queries, payload []byte, decidedBy int32) error {
+			var args CloseAccountApproval
+			if err := json.Unmarshal(payload, &args); err != nil {
+				return err
+			}
+
+			_, err := closeAccount(ctx, q, CloseAccountTxParams{
+				AccountID:        args.AccountID,
+				SweepToAccountID: args.SweepToAccountID,
+				ClosedBy:         decidedBy,
+				Reason:           args.Reason,
+			}, true)
+			return err
+		},
+	},
+	ApprovalActionChangeUserRole: {
+		execute: func(ctx context.Context, q *Queries, payload []byte, decidedBy int32) error {
+			var args ChangeUserRoleApproval
+			if err := json.Unmarshal(payload, &args); err != nil {
+				return err
+			}
+
+			_, err := q.UpdateUserRole(ctx, UpdateUserRoleParams{
+				ID:   args.UserID,
+				Role: args.Role,
+			})
+			if err != nil {
+				if err == pgx.ErrNoRows {
+					return ErrApprovalStale
+				}
+				return err
+			}
+
+			// Refresh tokens carry the role, so existing sessions must not
+			// be renewed with the old one.
+			return q.BlockUserSessions(ctx, args.UserID)
+		},
+	},
+}
+
+type RequestApprovalTxParams struct {
+	Action string `json:"action"`
+	// Payload is marshalled to JSON and handed back to the action once the
+	// request is approved.
+	Payload     any       `json:"payload"`
+	Summary     string    `json:"summary"`
+	RequestedBy int32     `json:"requested_by"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// RequestApprovalTx puts a sensitive action on hold until another banker
+// approves it.
+func (store *SQLStore) RequestApprovalTx(ctx context.Context, args RequestApprovalTxParams) (ApprovalRequest, error) {
+	ctx, span := tracer.Start(ctx, "db.RequestApprovalTx")
+	defer span.End()
+	span.SetAttributes(attribute.String("approval.action", args.Action))
+
+	var request ApprovalRequest
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		var err error
+		request, err = requestApproval(ctx, q, args)
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return request, err
+}
+
+func requestApproval(ctx context.Context, q *Queries, args RequestApprovalTxParams) (ApprovalRequest, error) {
+	if _, ok := approvalActions[args.Action]; !ok {
+		return ApprovalRequest{}, fmt.Errorf("%w: %s", ErrUnknownApprovalAction, args.Action)
+	}
+
+	payload, err := json.Marshal(args.Payload)
+	if err != nil {
+		return ApprovalRequest{}, err
+	}
+
+	request, err := q.CreateApprovalRequest(ctx, CreateApprovalRequestParams{
+		Action:      args.Action,
+		Payload:     payload,
+		Summary:     args.Summary,
+		RequestedBy: args.RequestedBy,
+		ExpiresAt:   args.ExpiresAt,
+	})
+	if err != nil {
+		return request, err
+	}
+
+	_, err = q.CreateApprovalEvent(ctx, CreateApprovalEventParams{
+		RequestID: request.ID,
+		Event:     ApprovalEventKindRequested,
+		ActorID:   pgtype.Int4{Int32: args.RequestedBy, Valid: true},
+	})
+	return request, err
+}
+
+type DecideApprovalTxParams struct {
+	ID        int64 `json:"id"`
+	DecidedBy int32 `json:"decided_by"`
+	// Approve runs the action. Otherwise the request is rejected and the
+	// action is cancelled.
+	Approve bool   `json:"approve"`
+	Reason  string `json:"reason"`
+}
+
+// DecideApprovalTx approves or rejects a pending request. Approving runs the
+// action in the same transaction, so a request is only marked approved when
+// its action succeeded. The banker deciding must not be the one who asked.
+func (store *SQLStore) DecideApprovalTx(ctx context.Context, args DecideApprovalTxParams) (ApprovalRequest, error) {
+	ctx, span := tracer.Start(ctx, "db.DecideApprovalTx")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int64("approval.id", args.ID),
+		attribute.Bool("approval.approve", args.Approve),
+	)
+
+	var request ApprovalRequest
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		var err error
+		request, err = q.GetApprovalRequestForUpdate(ctx, args.ID)
+		if err != nil {
+			return err
+		}
+
+		if request.Status != ApprovalStatusPending {
+			return ErrApprovalNotPending
+		}
+		if !request.ExpiresAt.After(time.Now()) {
+			return ErrApprovalExpired
+		}
+		if request.RequestedBy == args.DecidedBy {
+			return ErrSelfApproval
+		}
+
+		action, ok := approvalActions[request.Action]
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownApprovalAction, request.Action)
+		}
+
+		decidedBy := pgtype.Int4{Int32: args.DecidedBy, Valid: true}
+		status, event := ApprovalStatusRejected, ApprovalEventKindRejected
+		if args.Approve {
+			status, event = ApprovalStatusApproved, ApprovalEventKindApproved
+			err = action.execute(ctx, q, request.Payload, args.DecidedBy)
+		} else if action.cancel != nil {
+			err = action.cancel(ctx, q, request.Payload, decidedBy, args.Reason)
+		}
+		if err != nil {
+			return err
+		}
+
+		request, err = closeApprovalRequest(ctx, q, request.ID, status, event, decidedBy, args.Reason)
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return request, err
+}
+
+// ExpireApprovalTx marks a pending request whose deadline has passed as
+// expired and cancels its action.
+func (store *SQLStore) ExpireApprovalTx(ctx context.Context, id int64) (ApprovalRequest, error) {
+	ctx, span := tracer.Start(ctx, "db.ExpireApprovalTx")
+	defer span.End()
+	span.SetAttributes(attribute.Int64("approval.id", id))
+
+	var request ApprovalRequest
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		var err error
+		request, err = q.GetApprovalRequestForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if request.Status != ApprovalStatusPending {
+			return ErrApprovalNotPending
+		}
+		if request.ExpiresAt.After(time.Now()) {
+			return ErrApprovalNotExpired
+		}
+
+		action, ok := approvalActions[request.Action]
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownApprovalAction, request.Action)
+		}
+
+		const reason = "approval request expired"
+		if action.cancel != nil {
+			if err := action.cancel(ctx, q, request.Payload, pgtype.Int4{}, reason); err != nil {
+				return err
+			}
+		}
+
+		request, err = closeApprovalRequest(ctx, q, request.ID, ApprovalStatusExpired, ApprovalEventKindExpired, pgtype.Int4{}, reason)
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return request, err
+}
+
+func closeApprovalRequest(ctx context.Context, q *Queries, id int64, status ApprovalStatus, event ApprovalEventKind, actorID pgtype.Int4, reason string) (ApprovalRequest, error) {
+	request, err := q.DecideApprovalRequest(ctx, DecideApprovalRequestParams{
+		ID:             id,
+		Status:         status,
+		DecidedBy:      actorID,
+		DecisionReason: reason,
+	})
+	if err != nil {
+		return request, err
+	}
+
+	_, err = q.CreateApprovalEvent(ctx, CreateApprovalEventParams{
+		RequestID: id,
+		Event:     event,
+		ActorID:   actorID,
+		Reason:    reason,
+	})
+	return request, err
+}
@@ -0,0 +1,247 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/valkyraycho/bank_project/utils"
+)
+
+func TestDecideApprovalTxSettlesCashMovement(t *testing.T) {
+	account := fundAccount(t, randomAccount(t), 500)
+	maker := randomUser(t)
+	checker := randomUser(t)
+
+	args := randomCashMovementParams(account, maker, CashMovementDirectionWithdrawal)
+	args.RequiresApproval = true
+	args.ApprovalExpiresAt = time.Now().Add(time.Hour)
+
+	pending, err := testStore.CashMovementTx(context.Background(), args)
+	require.NoError(t, err)
+	require.Equal(t, CashMovementStatusPendingApproval, pending.CashMovement.Status)
+	require.Nil(t, pending.Account)
+	require.NotNil(t, pending.ApprovalRequest)
+	require.Equal(t, ApprovalActionCashMovement, pending.ApprovalRequest.Action)
+	require.Equal(t, ApprovalStatusPending, pending.ApprovalRequest.Status)
+
+	_, err = testStore.DecideApprovalTx(context.Background(), DecideApprovalTxParams{
+		ID:        pending.ApprovalRequest.ID,
+		DecidedBy: maker.ID,
+		Approve:   true,
+	})
+	require.ErrorIs(t, err, ErrSelfApproval)
+
+	request, err := testStore.DecideApprovalTx(context.Background(), DecideApprovalTxParams{
+		ID:        pending.ApprovalRequest.ID,
+		DecidedBy: checker.ID,
+		Approve:   true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, ApprovalStatusApproved, request.Status)
+	require.Equal(t, checker.ID, request.DecidedBy.Int32)
+
+	movement, err := testStore.GetCashMovement(context.Background(), pending.CashMovement.ID)
+	require.NoError(t, err)
+	require.Equal(t, CashMovementStatusCompleted, movement.Status)
+	require.Equal(t, checker.ID, movement.DecidedBy.Int32)
+
+	updated, err := testStore.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, account.Balance-100, updated.Balance)
+
+	_, err = testStore.DecideApprovalTx(context.Background(), DecideApprovalTxParams{
+		ID:        pending.ApprovalRequest.ID,
+		DecidedBy: checker.ID,
+		Approve:   true,
+	})
+	require.ErrorIs(t, err, ErrApprovalNotPending)
+
+	events, err := testStore.ListApprovalEvents(context.Background(), request.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, ApprovalEventKindRequested, events[0].Event)
+	require.Equal(t, maker.ID, events[0].ActorID.Int32)
+	require.Equal(t, ApprovalEventKindApproved, events[1].Event)
+	require.Equal(t, checker.ID, events[1].ActorID.Int32)
+}
+
+func TestDecideApprovalTxRejectsCashMovement(t *testing.T) {
+	account := randomAccount(t)
+	maker := randomUser(t)
+	checker := randomUser(t)
+
+	args := randomCashMovementParams(account, maker, CashMovementDirectionDeposit)
+	args.RequiresApproval = true
+	args.ApprovalExpiresAt = time.Now().Add(time.Hour)
+
+	pending, err := testStore.CashMovementTx(context.Background(), args)
+	require.NoError(t, err)
+
+	request, err := testStore.DecideApprovalTx(context.Background(), DecideApprovalTxParams{
+		ID:        pending.ApprovalRequest.ID,
+		DecidedBy: checker.ID,
+		Reason:    "source of funds unclear",
+	})
+	require.NoError(t, err)
+	require.Equal(t, ApprovalStatusRejected, request.Status)
+	require.Equal(t, "source of funds unclear", request.DecisionReason)
+
+	movement, err := testStore.GetCashMovement(context.Background(), pending.CashMovement.ID)
+	require.NoError(t, err)
+	require.Equal(t, CashMovementStatusRejected, movement.Status)
+	require.False(t, movement.JournalID.Valid)
+
+	unchanged, err := testStore.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, account.Balance, unchanged.Balance)
+}
+
+func TestDecideApprovalTxUnfreezesAccount(t *testing.T) {
+	account := randomAccount(t)
+	maker := randomUser(t)
+	checker := randomUser(t)
+
+	_, err := testStore.UpdateAccountStatus(context.Background(), UpdateAccountStatusParams{
+		ID:              account.ID,
+		CurrentStatus:   AccountStatusActive,
+		Status:          AccountStatusFrozen,
+		StatusReason:    "suspicious activity",
+		StatusChangedBy: pgtype.Int4{Int32: maker.ID, Valid: true},
+	})
+	require.NoError(t, err)
+
+	request, err := testStore.RequestApprovalTx(context.Background(), RequestApprovalTxParams{
+		Action:      ApprovalActionUnfreezeAccount,
+		Payload:     UnfreezeAccountApproval{AccountID: account.ID, Reason: "identity confirmed"},
+		RequestedBy: maker.ID,
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.DecideApprovalTx(context.Background(), DecideApprovalTxParams{
+		ID:        request.ID,
+		DecidedBy: checker.ID,
+		Approve:   true,
+	})
+	require.NoError(t, err)
+
+	unfrozen, err := testStore.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, AccountStatusActive, unfrozen.Status)
+	require.Equal(t, "identity confirmed", unfrozen.StatusReason)
+	require.Equal(t, checker.ID, unfrozen.StatusChangedBy.Int32)
+}
+
+func TestDecideApprovalTxChangesUserRole(t *testing.T) {
+	user := randomUser(t)
+	maker := randomUser(t)
+	checker := randomUser(t)
+
+	request, err := testStore.RequestApprovalTx(context.Background(), RequestApprovalTxParams{
+		Action:      ApprovalActionChangeUserRole,
+		Payload:     ChangeUserRoleApproval{UserID: user.ID, Role: utils.BankerRole},
+		RequestedBy: maker.ID,
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.DecideApprovalTx(context.Background(), DecideApprovalTxParams{
+		ID:        request.ID,
+		DecidedBy: checker.ID,
+		Approve:   true,
+	})
+	require.NoError(t, err)
+
+	updated, err := testStore.GetUserByID(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Equal(t, utils.BankerRole, updated.Role)
+}
+
+func TestDecideApprovalTxClosesAccountToOtherOwner(t *testing.T) {
+	account := fundAccount(t, randomAccount(t), 100)
+	maker := randomUser(t)
+	checker := randomUser(t)
+
+	sweepTo, err := testStore.CreateAccount(context.Background(), CreateAccountParams{
+		OwnerID:     randomUser(t).ID,
+		Currency:    account.Currency,
+		ProductCode: "checking",
+	})
+	require.NoError(t, err)
+
+	request, err := testStore.RequestApprovalTx(context.Background(), RequestApprovalTxParams{
+		Action:      ApprovalActionCloseAccount,
+		Payload:     CloseAccountApproval{AccountID: account.ID, SweepToAccountID: sweepTo.ID, Reason: "estate settled"},
+		RequestedBy: maker.ID,
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.DecideApprovalTx(context.Background(), DecideApprovalTxParams{
+		ID:        request.ID,
+		DecidedBy: checker.ID,
+		Approve:   true,
+	})
+	require.NoError(t, err)
+
+	closed, err := testStore.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, AccountStatusClosed, closed.Status)
+	require.Zero(t, closed.Balance)
+	require.Equal(t, checker.ID, closed.StatusChangedBy.Int32)
+	requireBalance(t, sweepTo, account.Balance)
+}
+
+func TestRequestApprovalTxRejectsUnknownAction(t *testing.T) {
+	maker := randomUser(t)
+
+	_, err := testStore.RequestApprovalTx(context.Background(), RequestApprovalTxParams{
+		Action:      "delete_bank",
+		Payload:     struct{}{},
+		RequestedBy: maker.ID,
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+	require.ErrorIs(t, err, ErrUnknownApprovalAction)
+}
+
+func TestExpireApprovalTx(t *testing.T) {
+	account := randomAccount(t)
+	maker := randomUser(t)
+	checker := randomUser(t)
+
+	args := randomCashMovementParams(account, maker, CashMovementDirectionDeposit)
+	args.RequiresApproval = true
+	args.ApprovalExpiresAt = time.Now().Add(-time.Minute)
+
+	pending, err := testStore.CashMovementTx(context.Background(), args)
+	require.NoError(t, err)
+
+	_, err = testStore.DecideApprovalTx(context.Background(), DecideApprovalTxParams{
+		ID:        pending.ApprovalRequest.ID,
+		DecidedBy: checker.ID,
+		Approve:   true,
+	})
+	require.ErrorIs(t, err, ErrApprovalExpired)
+
+	ids, err := testStore.ListExpiredApprovalRequests(context.Background(), ListExpiredApprovalRequestsParams{
+		Now:       time.Now(),
+		BatchSize: 1000,
+	})
+	require.NoError(t, err)
+	require.Contains(t, ids, pending.ApprovalRequest.ID)
+
+	request, err := testStore.ExpireApprovalTx(context.Background(), pending.ApprovalRequest.ID)
+	require.NoError(t, err)
+	require.Equal(t, ApprovalStatusExpired, request.Status)
+	require.False(t, request.DecidedBy.Valid)
+
+	movement, err := testStore.GetCashMovement(context.Background(), pending.CashMovement.ID)
+	require.NoError(t, err)
+	require.Equal(t, CashMovementStatusRejected, movement.Status)
+
+	_, err = testStore.ExpireApprovalTx(context.Background(), pending.ApprovalRequest.ID)
+	require.ErrorIs(t, err, ErrApprovalNotPending)
+}
@@ -0,0 +1,172 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const SystemAccountSettlement = "settlement"
+
+const (
+	JournalKindDeposit    = "deposit"
+	JournalKindWithdrawal = "withdrawal"
+)
+
+var ErrCashMovementNotPending = errors.New("cash movement is not pending approval")
+
+type CashMovementTxParams struct {
+	Direction         CashMovementDirection `json:"direction"`
+	Channel           CashMovementChannel   `json:"channel"`
+	AccountID         int32                 `json:"account_id"`
+	Amount            int32                 `json:"amount"`
+	ExternalReference string                `json:"external_reference"`
+	Memo              string                `json:"memo"`
+	RequestedBy       int32                 `json:"requested_by"`
+	// RequiresApproval leaves the movement pending behind an approval
+	// request that expires at ApprovalExpiresAt instead of settling it right
+	// away.
+	RequiresApproval  bool      `json:"requires_approval"`
+	ApprovalExpiresAt time.Time `json:"approval_expires_at"`
+}
+
+type CashMovementTxResult struct {
+	CashMovement CashMovement `json:"cash_movement"`
+	// Account is only set once the movement is settled.
+	Account *Account `json:"account"`
+	// ApprovalRequest is only set when the movement waits for approval.
+	ApprovalRequest *ApprovalRequest `json:"approval_request"`
+}
+
+// CashMovementTx records a deposit or withdrawal and, unless it requires
+// approval, settles it against the settlement account of the account
+// currency.
+func (store *SQLStore) CashMovementTx(ctx context.Context, args CashMovementTxParams) (CashMovementTxResult, error) {
+	ctx, span := tracer.Start(ctx, "db.CashMovementTx")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("cash_movement.direction", string(args.Direction)),
+		attribute.Int("account.id", int(args.AccountID)),
+	)
+
+	result := CashMovementTxResult{}
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		status := CashMovementStatusCompleted
+		if args.RequiresApproval {
+			status = CashMovementStatusPendingApproval
+		}
+
+		movement, err := q.CreateCashMovement(ctx, CreateCashMovementParams{
+			Direction:         args.Direction,
+			Channel:           args.Channel,
+			AccountID:         args.AccountID,
+			Amount:            args.Amount,
+			ExternalReference: args.ExternalReference,
+			Memo:              args.Memo,
+			Status:            status,
+			RequestedBy:       args.RequestedBy,
+		})
+		if err != nil {
+			return err
+		}
+
+		if args.RequiresApproval {
+			request, err := requestApproval(ctx, q, RequestApprovalTxParams{
+				Action:      ApprovalActionCashMovement,
+				Payload:     CashMovementApproval{CashMovementID: movement.ID},
+				Summary:     fmt.Sprintf("%s of %d on account %d", movement.Direction, movement.Amount, movement.AccountID),
+				RequestedBy: args.RequestedBy,
+				ExpiresAt:   args.ApprovalExpiresAt,
+			})
+			if err != nil {
+				return err
+			}
+
+			result.CashMovement = movement
+			result.ApprovalRequest = &request
+			return nil
+		}
+
+		result, err = settleCashMovement(ctx, q, movement, pgtype.Int4{})
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return result, err
+}
+
+// settleCashMovement posts a cash movement against the settlement account of
+// the account currency. Withdrawals are subject to the product rules of the
+// account, like outgoing transfers, and neither settles while the owner is
+// not cleared by sanctions screening.
+func settleCashMovement(ctx context.Context, q *Queries, movement CashMovement, decidedBy pgtype.Int4) (CashMovementTxResult, error) {
+	result := CashMovementTxResult{CashMovement: movement}
+
+	account, err := q.GetAccount(ctx, movement.AccountID)
+	if err != nil {
+		return result, err
+	}
+
+	settlementAccountID, err := q.GetSystemAccountID(ctx, GetSystemAccountIDParams{
+		Purpose:  SystemAccountSettlement,
+		Currency: account.Currency,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	accounts, err := lockAccounts(ctx, q, movement.AccountID, settlementAccountID)
+	if err != nil {
+		return result, err
+	}
+	if err := checkOwnerScreening(ctx, q, movement.AccountID); err != nil {
+		return result, err
+	}
+
+	account = accounts[movement.AccountID]
+	if err := checkAccountsActive(account); err != nil {
+		return result, err
+	}
+
+	kind, amount := JournalKindDeposit, movement.Amount
+	if movement.Direction == CashMovementDirectionWithdrawal {
+		product, err := q.GetAccountProduct(ctx, account.ProductCode)
+		if err != nil {
+			return result, err
+		}
+		if err := checkWithdrawal(ctx, q, account, product, int64(movement.Amount)); err != nil {
+			return result, err
+		}
+		kind, amount = JournalKindWithdrawal, -movement.Amount
+	}
+
+	journal, err := postJournal(ctx, q, accounts, journalParams{
+		Kind:        kind,
+		Description: fmt.Sprintf("%s %s", movement.Channel, movement.ExternalReference),
+		Postings: []Posting{
+			{AccountID: settlementAccountID, Amount: -amount},
+			{AccountID: movement.AccountID, Amount: amount},
+		},
+	})
+	if err != nil {
+		return result, err
+	}
+
+	updated := journal.Accounts[movement.AccountID]
+	result.Account = &updated
+
+	result.CashMovement, err = q.CompleteCashMovement(ctx, CompleteCashMovementParams{
+		ID:        movement.ID,
+		JournalID: journal.Journal.ID,
+		DecidedBy: decidedBy,
+	})
+	return result, err
+}
@@ -0,0 +1,77 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/valkyraycho/bank_project/utils"
+)
+
+func randomCashMovementParams(account Account, banker User, direction CashMovementDirection) CashMovementTxParams {
+	return CashMovementTxParams{
+		Direction:         direction,
+		Channel:           CashMovementChannelCashDesk,
+		AccountID:         account.ID,
+		Amount:            100,
+		ExternalReference: utils.RandomString(12),
+		RequestedBy:       banker.ID,
+	}
+}
+
+func TestCashMovementTxSettlesDeposit(t *testing.T) {
+	account := randomAccount(t)
+	banker := randomUser(t)
+
+	result, err := testStore.CashMovementTx(context.Background(), randomCashMovementParams(account, banker, CashMovementDirectionDeposit))
+	require.NoError(t, err)
+	require.Equal(t, CashMovementStatusCompleted, result.CashMovement.Status)
+	require.True(t, result.CashMovement.JournalID.Valid)
+	require.False(t, result.CashMovement.DecidedBy.Valid)
+	require.NotNil(t, result.Account)
+	require.Equal(t, account.Balance+100, result.Account.Balance)
+
+	entries, err := testStore.ListJournalEntries(context.Background(), result.CashMovement.JournalID.Int64)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Zero(t, entries[0].Amount+entries[1].Amount)
+}
+
+func TestCashMovementTxRejectsDuplicateReference(t *testing.T) {
+	account := randomAccount(t)
+	banker := randomUser(t)
+
+	args := randomCashMovementParams(account, banker, CashMovementDirectionDeposit)
+	_, err := testStore.CashMovementTx(context.Background(), args)
+	require.NoError(t, err)
+
+	_, err = testStore.CashMovementTx(context.Background(), args)
+	require.Error(t, err)
+}
+
+func TestCashMovementTxWithdrawalChecksBalanceFloor(t *testing.T) {
+	user := randomUser(t)
+	banker := randomUser(t)
+
+	savings, err := testStore.CreateAccount(context.Background(), CreateAccountParams{
+		OwnerID:     user.ID,
+		Currency:    utils.RandomCurrency(),
+		ProductCode: "savings",
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.CashMovementTx(context.Background(), randomCashMovementParams(savings, banker, CashMovementDirectionWithdrawal))
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+}
+
+func TestCashMovementTxBlockedByOwnerScreening(t *testing.T) {
+	account := randomAccount(t)
+	banker := randomUser(t)
+	flagOwner(t, account)
+
+	_, err := testStore.CashMovementTx(context.Background(), randomCashMovementParams(account, banker, CashMovementDirectionDeposit))
+	var screeningErr *OwnerScreeningError
+	require.ErrorAs(t, err, &screeningErr)
+	require.Equal(t, account.ID, screeningErr.AccountID)
+	requireBalance(t, account, account.Balance)
+}
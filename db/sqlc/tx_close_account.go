@@ -0,0 +1,159 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type CloseAccountTxParams struct {
+	AccountID int32 `json:"account_id"`
+	// SweepToAccountID receives the remaining balance. Zero means the
+	// account must already be empty.
+	SweepToAccountID int32  `json:"sweep_to_account_id"`
+	ClosedBy         int32  `json:"closed_by"`
+	Reason           string `json:"reason"`
+}
+
+type CloseAccountTxResult struct {
+	Account Account           `json:"account"`
+	Sweep   *TransferTxResult `json:"sweep"`
+	// Interest is the posting that paid the interest accrued so far, before
+	// the balance was swept.
+	Interest *InterestPosting `json:"interest"`
+}
+
+// CloseAccountTx closes an account. When the balance is swept, interest
+// accrued so far is paid first and swept with it; otherwise it is written
+// off by the next interest posting. The balance can only be swept to another
+// account of the same owner; a sweep to someone else goes through an
+// approval request.
+func (store *SQLStore) CloseAccountTx(ctx context.Context, args CloseAccountTxParams) (CloseAccountTxResult, error) {
+	ctx, span := tracer.Start(ctx, "db.CloseAccountTx")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("account.id", int(args.AccountID)),
+		attribute.Int("account.sweep_to_account_id", int(args.SweepToAccountID)),
+	)
+
+	var result CloseAccountTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		var err error
+		result, err = closeAccount(ctx, q, args, false)
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return result, err
+}
+
+// closeAccount closes the account within q. allowOtherOwner lets the balance
+// be swept to an account of another owner, once a second banker approved it.
+func closeAccount(ctx context.Context, q *Queries, args CloseAccountTxParams, allowOtherOwner bool) (CloseAccountTxResult, error) {
+	result := CloseAccountTxResult{}
+
+	lockIDs := []int32{args.AccountID}
+	if args.SweepToAccountID != 0 {
+		// Interest paid before the sweep debits the interest expense
+		// account, so it is locked with the others, in the same sorted
+		// order PostInterestTx locks them.
+		account, err := q.GetAccount(ctx, args.AccountID)
+		if err != nil {
+			return result, err
+		}
+
+		expenseAccountID, err := q.GetSystemAccountID(ctx, GetSystemAccountIDParams{
+			Purpose:  SystemAccountInterestExpense,
+			Currency: account.Currency,
+		})
+		if err != nil {
+			return result, err
+		}
+
+		lockIDs = append(lockIDs, args.SweepToAccountID, expenseAccountID)
+	}
+
+	accounts, err := lockAccounts(ctx, q, lockIDs...)
+	if err != nil {
+		return result, err
+	}
+
+	account := accounts[args.AccountID]
+	if account.Status == AccountStatusClosed {
+		return result, &AccountNotActiveError{AccountID: account.ID, Status: account.Status}
+	}
+	if args.SweepToAccountID != 0 && !allowOtherOwner && accounts[args.SweepToAccountID].OwnerID != account.OwnerID {
+		return result, ErrSweepToOtherOwner
+	}
+
+	if args.SweepToAccountID != 0 && account.Status == AccountStatusActive {
+		now := time.Now().UTC()
+		interest, err := postInterest(ctx, q, PostInterestTxParams{
+			AccountID: args.AccountID,
+			Period:    time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC),
+		})
+		if err != nil && !errors.Is(err, ErrInterestAlreadyPosted) {
+			return result, err
+		}
+		if err == nil {
+			result.Interest = &interest.Posting
+		}
+		if interest.Transfer != nil {
+			account = interest.Transfer.ToAccount
+		}
+	}
+
+	if account.Balance != 0 {
+		if args.SweepToAccountID == 0 || account.Balance < 0 {
+			return result, ErrAccountHasBalance
+		}
+		if err := checkAccountsActive(account, accounts[args.SweepToAccountID]); err != nil {
+			return result, err
+		}
+		if accounts[args.SweepToAccountID].Currency != account.Currency {
+			return result, ErrCurrencyMismatch
+		}
+		if err := checkOwnerScreening(ctx, q, args.AccountID, args.SweepToAccountID); err != nil {
+			return result, err
+		}
+
+		// Moving money between accounts of the same owner isn't an outgoing
+		// transfer, so the limits would only keep a large balance from ever
+		// being closed.
+		if accounts[args.SweepToAccountID].OwnerID != account.OwnerID {
+			product, err := q.GetAccountProduct(ctx, account.ProductCode)
+			if err != nil {
+				return result, err
+			}
+			if err := checkTransferLimits(ctx, q, account, product, account.Balance); err != nil {
+				return result, err
+			}
+		}
+
+		sweep, err := transfer(ctx, q, accounts, TransferTxParams{
+			FromAccountID: args.AccountID,
+			ToAccountID:   args.SweepToAccountID,
+			Amount:        account.Balance,
+		})
+		if err != nil {
+			return result, err
+		}
+		result.Sweep = &sweep
+	}
+
+	result.Account, err = q.UpdateAccountStatus(ctx, UpdateAccountStatusParams{
+		ID:              args.AccountID,
+		CurrentStatus:   account.Status,
+		Status:          AccountStatusClosed,
+		StatusReason:    args.Reason,
+		StatusChangedBy: pgtype.Int4{Int32: args.ClosedBy, Valid: true},
+	})
+	return result, err
+}
@@ -0,0 +1,119 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+// createSweepAccount opens another checking account of the same owner and
+// currency to receive a closing balance.
+func createSweepAccount(t *testing.T, account Account) Account {
+	sweepTo, err := testStore.CreateAccount(context.Background(), CreateAccountParams{
+		OwnerID:     account.OwnerID,
+		Currency:    account.Currency,
+		ProductCode: "checking",
+	})
+	require.NoError(t, err)
+	return sweepTo
+}
+
+func TestCloseAccountTxSweepsBalance(t *testing.T) {
+	account := randomAccount(t)
+	account = fundAccount(t, account, 100)
+	sweepTo := createSweepAccount(t, account)
+
+	result, err := testStore.CloseAccountTx(context.Background(), CloseAccountTxParams{
+		AccountID:        account.ID,
+		SweepToAccountID: sweepTo.ID,
+		ClosedBy:         account.OwnerID,
+		Reason:           "test",
+	})
+	require.NoError(t, err)
+	require.Equal(t, AccountStatusClosed, result.Account.Status)
+	require.Zero(t, result.Account.Balance)
+	require.NotNil(t, result.Sweep)
+	require.Equal(t, account.Balance, result.Sweep.ToAccount.Balance)
+
+	_, err = testStore.CloseAccountTx(context.Background(), CloseAccountTxParams{
+		AccountID: account.ID,
+		ClosedBy:  account.OwnerID,
+	})
+	var notActive *AccountNotActiveError
+	require.ErrorAs(t, err, &notActive)
+}
+
+func TestCloseAccountTxRequiresZeroBalance(t *testing.T) {
+	account := randomAccount(t)
+	account = fundAccount(t, account, 100)
+
+	_, err := testStore.CloseAccountTx(context.Background(), CloseAccountTxParams{
+		AccountID: account.ID,
+		ClosedBy:  account.OwnerID,
+	})
+	require.ErrorIs(t, err, ErrAccountHasBalance)
+}
+
+func TestCloseAccountTxRejectsSweepToOtherOwner(t *testing.T) {
+	account := randomAccount(t)
+	account = fundAccount(t, account, 100)
+	sweepTo := randomAccount(t)
+
+	_, err := testStore.CloseAccountTx(context.Background(), CloseAccountTxParams{
+		AccountID:        account.ID,
+		SweepToAccountID: sweepTo.ID,
+		ClosedBy:         account.OwnerID,
+	})
+	require.ErrorIs(t, err, ErrSweepToOtherOwner)
+	requireBalance(t, sweepTo, sweepTo.Balance)
+}
+
+func TestCloseAccountTxSweepToSameOwnerIgnoresTransferLimits(t *testing.T) {
+	account := randomAccount(t)
+	account = fundAccount(t, account, 100)
+	sweepTo := createSweepAccount(t, account)
+
+	_, err := testStore.SetTransferLimitsTx(context.Background(), SetTransferLimitsTxParams{
+		AccountID: account.ID,
+		Limits: TransferLimits{
+			PerTransfer: pgtype.Int4{Int32: account.Balance - 1, Valid: true},
+			DailyAmount: pgtype.Int4{Int32: account.Balance - 1, Valid: true},
+		},
+		Lower:     true,
+		UpdatedBy: account.OwnerID,
+	})
+	require.NoError(t, err)
+
+	result, err := testStore.CloseAccountTx(context.Background(), CloseAccountTxParams{
+		AccountID:        account.ID,
+		SweepToAccountID: sweepTo.ID,
+		ClosedBy:         account.OwnerID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, AccountStatusClosed, result.Account.Status)
+	require.Zero(t, result.Account.Balance)
+	requireBalance(t, sweepTo, sweepTo.Balance+account.Balance)
+}
+
+func TestCloseAccountTxSweepBlockedByOwnerScreening(t *testing.T) {
+	account := randomAccount(t)
+	sweepTo := createSweepAccount(t, account)
+	flagOwner(t, account)
+
+	_, err := testStore.CloseAccountTx(context.Background(), CloseAccountTxParams{
+		AccountID:        account.ID,
+		SweepToAccountID: sweepTo.ID,
+		ClosedBy:         account.OwnerID,
+	})
+	var screeningErr *OwnerScreeningError
+	require.ErrorAs(t, err, &screeningErr)
+	require.Equal(t, account.ID, screeningErr.AccountID)
+	require.Equal(t, ScreeningStatusPendingReview, screeningErr.Status)
+	requireBalance(t, sweepTo, sweepTo.Balance)
+
+	account, err = testStore.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, AccountStatusActive, account.Status)
+}
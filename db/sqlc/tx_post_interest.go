@@ -0,0 +1,140 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const SystemAccountInterestExpense = "interest_expense"
+
+// MicrosPerMinorUnit is the scale of interest_accruals.amount_micros.
+const MicrosPerMinorUnit = 1_000_000
+
+var ErrInterestAlreadyPosted = errors.New("interest already posted for this period")
+
+type PostInterestTxParams struct {
+	AccountID int32 `json:"account_id"`
+	// Period is the first day of the month being paid.
+	Period time.Time `json:"period"`
+}
+
+type PostInterestTxResult struct {
+	Posting  InterestPosting   `json:"posting"`
+	Transfer *TransferTxResult `json:"transfer"`
+}
+
+// PostInterestTx pays an account the interest accrued up to the end of a
+// period out of the bank's interest expense account for the same currency.
+// The posting row is inserted first, so a period is only ever paid once, and
+// accruals are marked with the period that paid them, so days backfilled
+// after a period was posted are paid with the next one. Fractions of the
+// currency minor unit are carried into the next posting. Interest of a
+// closed account is written off.
+func (store *SQLStore) PostInterestTx(ctx context.Context, args PostInterestTxParams) (PostInterestTxResult, error) {
+	ctx, span := tracer.Start(ctx, "db.PostInterestTx")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("account.id", int(args.AccountID)),
+		attribute.String("interest.period", args.Period.Format(time.DateOnly)),
+	)
+
+	result := PostInterestTxResult{}
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		var err error
+		result, err = postInterest(ctx, q, args)
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return result, err
+}
+
+// postInterest runs PostInterestTx inside an open transaction. The account
+// and the interest expense account are locked before the posting row is
+// inserted, in the same order CloseAccountTx locks them.
+func postInterest(ctx context.Context, q *Queries, args PostInterestTxParams) (PostInterestTxResult, error) {
+	result := PostInterestTxResult{}
+
+	account, err := q.GetAccount(ctx, args.AccountID)
+	if err != nil {
+		return result, err
+	}
+
+	expenseAccountID, err := q.GetSystemAccountID(ctx, GetSystemAccountIDParams{
+		Purpose:  SystemAccountInterestExpense,
+		Currency: account.Currency,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	accounts, err := lockAccounts(ctx, q, args.AccountID, expenseAccountID)
+	if err != nil {
+		return result, err
+	}
+	account = accounts[args.AccountID]
+
+	_, err = q.CreateInterestPosting(ctx, CreateInterestPostingParams{
+		AccountID: args.AccountID,
+		Period:    args.Period,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return result, ErrInterestAlreadyPosted
+		}
+		return result, err
+	}
+
+	micros, err := q.MarkInterestAccrualsPosted(ctx, MarkInterestAccrualsPostedParams{
+		AccountID: args.AccountID,
+		Period:    args.Period,
+		PeriodEnd: args.Period.AddDate(0, 1, 0),
+	})
+	if err != nil {
+		return result, err
+	}
+
+	remainder, err := q.GetInterestRemainder(ctx, GetInterestRemainderParams{
+		AccountID: args.AccountID,
+		Period:    args.Period,
+	})
+	if err != nil {
+		return result, err
+	}
+	micros += remainder
+
+	update := UpdateInterestPostingParams{
+		AccountID:       args.AccountID,
+		Period:          args.Period,
+		RemainderMicros: micros,
+	}
+	if account.Status != AccountStatusClosed {
+		update.Amount = int32(micros / MicrosPerMinorUnit)
+		update.RemainderMicros = micros % MicrosPerMinorUnit
+	}
+
+	if update.Amount > 0 {
+		transfer, err := transferTx(ctx, q, TransferTxParams{
+			FromAccountID: expenseAccountID,
+			ToAccountID:   args.AccountID,
+			Amount:        update.Amount,
+		})
+		if err != nil {
+			return result, err
+		}
+		result.Transfer = &transfer
+		update.TransferID = pgtype.Int4{Int32: transfer.Transfer.ID, Valid: true}
+	}
+
+	result.Posting, err = q.UpdateInterestPosting(ctx, update)
+	return result, err
+}
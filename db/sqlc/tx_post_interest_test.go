@@ -0,0 +1,122 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func accrueInterest(t *testing.T, account Account, day time.Time, micros int64) {
+	rows, err := testStore.CreateInterestAccrual(context.Background(), CreateInterestAccrualParams{
+		AccountID:       account.ID,
+		AccrualDate:     day,
+		EndOfDayBalance: account.Balance,
+		AmountMicros:    micros,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+}
+
+func TestPostInterestTxCarriesRemainder(t *testing.T) {
+	account := randomAccount(t)
+	january := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	february := january.AddDate(0, 1, 0)
+
+	accrueInterest(t, account, january, 1_500_000)
+	result, err := testStore.PostInterestTx(context.Background(), PostInterestTxParams{AccountID: account.ID, Period: january})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), result.Posting.Amount)
+	require.Equal(t, int64(500_000), result.Posting.RemainderMicros)
+	require.NotNil(t, result.Transfer)
+
+	accrueInterest(t, account, february, 700_000)
+	result, err = testStore.PostInterestTx(context.Background(), PostInterestTxParams{AccountID: account.ID, Period: february})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), result.Posting.Amount)
+	require.Equal(t, int64(200_000), result.Posting.RemainderMicros)
+	requireBalance(t, account, account.Balance+2)
+
+	_, err = testStore.PostInterestTx(context.Background(), PostInterestTxParams{AccountID: account.ID, Period: february})
+	require.ErrorIs(t, err, ErrInterestAlreadyPosted)
+}
+
+func TestPostInterestTxWritesOffClosedAccount(t *testing.T) {
+	account := randomAccount(t)
+	sweepTo := createSweepAccount(t, account)
+
+	_, err := testStore.CloseAccountTx(context.Background(), CloseAccountTxParams{
+		AccountID:        account.ID,
+		SweepToAccountID: sweepTo.ID,
+		ClosedBy:         account.OwnerID,
+	})
+	require.NoError(t, err)
+
+	// A day accrued after the account was closed.
+	period := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	accrueInterest(t, account, period, 3_000_000)
+
+	result, err := testStore.PostInterestTx(context.Background(), PostInterestTxParams{AccountID: account.ID, Period: period})
+	require.NoError(t, err)
+	require.Zero(t, result.Posting.Amount)
+	require.Equal(t, int64(3_000_000), result.Posting.RemainderMicros)
+	require.Nil(t, result.Transfer)
+}
+
+func TestCloseAccountTxPaysAccruedInterest(t *testing.T) {
+	account := randomAccount(t)
+	sweepTo := createSweepAccount(t, account)
+
+	accrueInterest(t, account, time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), 2_400_000)
+
+	result, err := testStore.CloseAccountTx(context.Background(), CloseAccountTxParams{
+		AccountID:        account.ID,
+		SweepToAccountID: sweepTo.ID,
+		ClosedBy:         account.OwnerID,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result.Interest)
+	require.Equal(t, int32(2), result.Interest.Amount)
+	require.Equal(t, int64(400_000), result.Interest.RemainderMicros)
+	require.NotNil(t, result.Sweep)
+	require.Equal(t, account.Balance+2, result.Sweep.Transfer.Amount)
+	require.Zero(t, result.Account.Balance)
+}
+
+func TestCloseAccountTxConcurrentWithInterestPosting(t *testing.T) {
+	account := randomAccount(t)
+	sweepTo := createSweepAccount(t, account)
+
+	now := time.Now().UTC()
+	period := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	accrueInterest(t, account, period, 2_000_000)
+
+	closeErr := make(chan error)
+	postErr := make(chan error)
+
+	go func() {
+		_, err := testStore.CloseAccountTx(context.Background(), CloseAccountTxParams{
+			AccountID:        account.ID,
+			SweepToAccountID: sweepTo.ID,
+			ClosedBy:         account.OwnerID,
+		})
+		closeErr <- err
+	}()
+
+	go func() {
+		_, err := testStore.PostInterestTx(context.Background(), PostInterestTxParams{AccountID: account.ID, Period: period})
+		postErr <- err
+	}()
+
+	require.NoError(t, <-closeErr)
+	if err := <-postErr; err != nil {
+		require.ErrorIs(t, err, ErrInterestAlreadyPosted)
+	}
+
+	closed, err := testStore.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, AccountStatusClosed, closed.Status)
+	require.Zero(t, closed.Balance)
+	requireBalance(t, sweepTo, account.Balance+2)
+}
@@ -2,12 +2,29 @@ package db
 
 import (
 	"context"
+	"slices"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 )
 
 type TransferTxParams struct {
-	FromAccountID int32 `json:"from_account_id"`
-	ToAccountID   int32 `json:"to_account_id"`
-	Amount        int32 `json:"amount"`
+	FromAccountID int32  `json:"from_account_id"`
+	ToAccountID   int32  `json:"to_account_id"`
+	Amount        int32  `json:"amount"`
+	Memo          string `json:"memo"`
+	// Reference is the sender's end-to-end reference, unique per sender.
+	// Empty means none.
+	Reference string      `json:"reference"`
+	SenderID  pgtype.Int4 `json:"sender_id"`
+	// Category files the sender's entry. Empty leaves it to the sender's
+	// category rules.
+	Category string `json:"category"`
+	// Screener decides whether the transfer may move money. Nil lets every
+	// transfer through.
+	Screener TransferScreener `json:"-"`
 }
 
 type TransferTxResult struct {
@@ -16,82 +33,201 @@ type TransferTxResult struct {
 	ToAccount   Account  `json:"to_account"`
 	FromEntry   Entry    `json:"from_entry"`
 	ToEntry     Entry    `json:"to_entry"`
+	Fee         *Fee     `json:"fee"`
+	FeeEntry    *Entry   `json:"fee_entry"`
+	// Alert is set when the screener held or blocked the transfer. No money
+	// moved and the entries are empty.
+	Alert *FraudAlert `json:"alert"`
 }
 
 func (store *SQLStore) TransferTx(ctx context.Context, args TransferTxParams) (TransferTxResult, error) {
+	ctx, span := tracer.Start(ctx, "db.TransferTx")
+	defer span.End()
+	span.SetAttributes(
+		attribute.Int("transfer.from_account_id", int(args.FromAccountID)),
+		attribute.Int("transfer.to_account_id", int(args.ToAccountID)),
+	)
+
 	result := TransferTxResult{}
 
 	err := store.ExecTx(ctx, func(q *Queries) error {
 		var err error
+		result, err = transferTx(ctx, q, args)
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return result, err
+}
 
-		result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
-			FromAccountID: args.FromAccountID,
-			ToAccountID:   args.ToAccountID,
-			Amount:        args.Amount,
-		})
-		if err != nil {
-			return err
-		}
+// lockAccounts locks the accounts for the rest of the transaction. Rows are
+// locked in id order, the same order postJournal updates them in, so
+// concurrent transfers in opposite directions can't deadlock.
+func lockAccounts(ctx context.Context, q *Queries, ids ...int32) (map[int32]Account, error) {
+	ids = slices.Clone(ids)
+	slices.Sort(ids)
 
-		result.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: args.FromAccountID,
-			Amount:    -args.Amount,
-		})
+	accounts := map[int32]Account{}
+	for _, id := range slices.Compact(ids) {
+		account, err := q.GetAccountForUpdate(ctx, id)
 		if err != nil {
-			return err
+			return nil, err
 		}
+		accounts[id] = account
+	}
+	return accounts, nil
+}
 
-		result.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: args.ToAccountID,
-			Amount:    args.Amount,
-		})
+// transferTx runs a customer facing transfer inside an open transaction. Once
+// it passed checkTransfer, the screener, if any, decides whether it goes
+// ahead; held and blocked transfers are recorded with a fraud alert but move
+// no money. The fee is charged in the same transaction, on top of the amount
+// received.
+func transferTx(ctx context.Context, q *Queries, args TransferTxParams) (TransferTxResult, error) {
+	accounts, fee, err := checkTransfer(ctx, q, args)
+	if err != nil {
+		return TransferTxResult{}, err
+	}
+
+	if args.Screener != nil {
+		screening, err := args.Screener.ScreenTransfer(ctx, q, args)
 		if err != nil {
-			return err
+			return TransferTxResult{}, err
 		}
+		if screening.Decision != FraudDecisionAllow {
+			return holdTransfer(ctx, q, accounts, args, screening)
+		}
+	}
+
+	created, err := recordTransfer(ctx, q, args, TransferStatusCompleted)
+	if err != nil {
+		return TransferTxResult{}, err
+	}
+	return settleTransfer(ctx, q, accounts, created, args, fee)
+}
+
+// checkTransfer locks both accounts and checks a customer facing transfer:
+// both accounts must be active, the debit, including the transfer fee, must
+// satisfy the product rules of the source account, and the amount must fit
+// within its transfer limits. It returns the locked accounts and the fee.
+func checkTransfer(ctx context.Context, q *Queries, args TransferTxParams) (map[int32]Account, int32, error) {
+	accounts, err := lockAccounts(ctx, q, args.FromAccountID, args.ToAccountID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	fromAccount := accounts[args.FromAccountID]
+	if err := checkAccountsActive(fromAccount, accounts[args.ToAccountID]); err != nil {
+		return nil, 0, err
+	}
+	if err := checkOwnerScreening(ctx, q, args.FromAccountID, args.ToAccountID); err != nil {
+		return nil, 0, err
+	}
+
+	product, err := q.GetAccountProduct(ctx, fromAccount.ProductCode)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	fee, err := findFee(ctx, q, FeeKindTransfer, fromAccount, product, args.Amount)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := checkWithdrawal(ctx, q, fromAccount, product, int64(args.Amount)+int64(fee)); err != nil {
+		return nil, 0, err
+	}
+	if err := checkTransferLimits(ctx, q, fromAccount, product, args.Amount); err != nil {
+		return nil, 0, err
+	}
+	return accounts, fee, nil
+}
+
+// settleTransfer moves the money of a recorded transfer and charges its fee.
+func settleTransfer(ctx context.Context, q *Queries, accounts map[int32]Account, transfer Transfer, args TransferTxParams, fee int32) (TransferTxResult, error) {
+	result, err := postTransfer(ctx, q, accounts, transfer, args)
+	if err != nil || fee == 0 {
+		return result, err
+	}
 
-		if args.FromAccountID < args.ToAccountID {
-			result.FromAccount, result.ToAccount, err = addMoney(
-				ctx,
-				q,
-				args.FromAccountID,
-				-args.Amount,
-				args.ToAccountID,
-				args.Amount,
-			)
-		} else {
-			result.ToAccount, result.FromAccount, err = addMoney(
-				ctx,
-				q,
-				args.ToAccountID,
-				args.Amount,
-				args.FromAccountID,
-				-args.Amount,
-			)
+	charge, err := chargeFee(ctx, q, chargeFeeParams{
+		Account:    result.FromAccount,
+		Kind:       FeeKindTransfer,
+		Amount:     fee,
+		TransferID: pgtype.Int4{Int32: result.Transfer.ID, Valid: true},
+	})
+	if err != nil {
+		return result, err
+	}
+
+	result.Fee, result.FeeEntry, result.FromAccount = &charge.Fee, charge.Entry, charge.Account
+	return result, nil
+}
+
+func checkAccountsActive(accounts ...Account) error {
+	for _, account := range accounts {
+		if account.Status != AccountStatusActive {
+			return &AccountNotActiveError{AccountID: account.ID, Status: account.Status}
 		}
+	}
+	return nil
+}
 
-		return err
+// transfer records a transfer and moves the money with a journal linked to
+// it. accounts must hold both accounts, locked by the caller.
+func transfer(ctx context.Context, q *Queries, accounts map[int32]Account, args TransferTxParams) (TransferTxResult, error) {
+	created, err := recordTransfer(ctx, q, args, TransferStatusCompleted)
+	if err != nil {
+		return TransferTxResult{}, err
+	}
+	return postTransfer(ctx, q, accounts, created, args)
+}
+
+func recordTransfer(ctx context.Context, q *Queries, args TransferTxParams, status TransferStatus) (Transfer, error) {
+	return q.CreateTransfer(ctx, CreateTransferParams{
+		FromAccountID: args.FromAccountID,
+		ToAccountID:   args.ToAccountID,
+		Amount:        args.Amount,
+		Memo:          args.Memo,
+		Reference:     pgtype.Text{String: args.Reference, Valid: args.Reference != ""},
+		SenderID:      args.SenderID,
+		Status:        status,
 	})
-	return result, err
 }
 
-func addMoney(
-	ctx context.Context,
-	q *Queries,
-	account1ID int32,
-	ammount1 int32,
-	account2ID int32,
-	ammount2 int32,
-) (account1 Account, account2 Account, err error) {
-	account1, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
-		ID:     account1ID,
-		Amount: ammount1,
+// postTransfer moves the money of a recorded transfer with a journal linked
+// to it and categorizes both entries.
+func postTransfer(ctx context.Context, q *Queries, accounts map[int32]Account, transfer Transfer, args TransferTxParams) (TransferTxResult, error) {
+	result := TransferTxResult{Transfer: transfer}
+
+	journal, err := postJournal(ctx, q, accounts, journalParams{
+		Kind:       JournalKindTransfer,
+		TransferID: pgtype.Int4{Int32: transfer.ID, Valid: true},
+		Postings: []Posting{
+			{AccountID: args.FromAccountID, Amount: -args.Amount},
+			{AccountID: args.ToAccountID, Amount: args.Amount},
+		},
 	})
 	if err != nil {
-		return
+		return result, err
 	}
-	account2, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
-		ID:     account2ID,
-		Amount: ammount2,
-	})
-	return
+
+	result.FromEntry, result.ToEntry = journal.Entries[0], journal.Entries[1]
+	result.FromAccount, result.ToAccount = journal.Accounts[args.FromAccountID], journal.Accounts[args.ToAccountID]
+
+	if args.Category != "" {
+		result.FromEntry, err = q.SetEntryCategory(ctx, SetEntryCategoryParams{
+			ID:       result.FromEntry.ID,
+			Category: pgtype.Text{String: args.Category, Valid: true},
+		})
+	} else {
+		result.FromEntry, err = categorizeEntry(ctx, q, result.FromEntry, result.FromAccount.OwnerID, args.Memo, args.ToAccountID)
+	}
+	if err != nil {
+		return result, err
+	}
+
+	result.ToEntry, err = categorizeEntry(ctx, q, result.ToEntry, result.ToAccount.OwnerID, args.Memo, args.FromAccountID)
+	return result, err
 }
@@ -138,3 +138,29 @@ func TestTransferTxNoDeadLock(t *testing.T) {
 	require.Equal(t, account2.Balance, updatedAccount2.Balance)
 
 }
+
+func TestTransferTxRejectsFrozenAccount(t *testing.T) {
+	account1 := randomAccount(t)
+	account2 := randomAccount(t)
+
+	_, err := testStore.UpdateAccountStatus(context.Background(), UpdateAccountStatusParams{
+		ID:            account2.ID,
+		CurrentStatus: AccountStatusActive,
+		Status:        AccountStatusFrozen,
+		StatusReason:  "test",
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	var notActive *AccountNotActiveError
+	require.ErrorAs(t, err, &notActive)
+	require.Equal(t, account2.ID, notActive.AccountID)
+
+	gotAccount1, err := testStore.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance, gotAccount1.Balance)
+}
@@ -0,0 +1,107 @@
+package db
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type CreateUserTxParams struct {
+	CreateUserParams
+	// Screener screens the full name of the new user. Nil skips screening.
+	Screener UserScreener `json:"-"`
+}
+
+type CreateUserTxResult struct {
+	User User `json:"user"`
+	// Matches holds the sanctions matches raised for the user, if any.
+	Matches []ScreeningMatch `json:"matches"`
+}
+
+// CreateUserTx creates a user and screens it in the same transaction, so a
+// user is never committed as clear when screening failed.
+func (store *SQLStore) CreateUserTx(ctx context.Context, args CreateUserTxParams) (CreateUserTxResult, error) {
+	ctx, span := tracer.Start(ctx, "db.CreateUserTx")
+	defer span.End()
+
+	var result CreateUserTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		var err error
+		result.User, err = q.CreateUser(ctx, args.CreateUserParams)
+		if err != nil {
+			return err
+		}
+		span.SetAttributes(attribute.Int("user.id", int(result.User.ID)))
+
+		if args.Screener == nil {
+			return nil
+		}
+
+		screened, err := recordScreeningMatches(ctx, q, RecordScreeningMatchesTxParams{
+			UserID:  result.User.ID,
+			Matches: args.Screener.ScreenName(result.User.FullName),
+			Source:  ScreeningSourceCreateUser,
+		})
+		result.User, result.Matches = screened.User, screened.Matches
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return result, err
+}
+
+type UpdateUserTxParams struct {
+	UpdateUserParams
+	// Screener screens the full name again when it changes. Nil skips
+	// screening.
+	Screener UserScreener `json:"-"`
+}
+
+type UpdateUserTxResult struct {
+	User User `json:"user"`
+	// Matches holds the sanctions matches raised for a new full name, if any.
+	Matches []ScreeningMatch `json:"matches"`
+}
+
+// UpdateUserTx updates a user and, when the full name changed, screens it
+// again in the same transaction.
+func (store *SQLStore) UpdateUserTx(ctx context.Context, args UpdateUserTxParams) (UpdateUserTxResult, error) {
+	ctx, span := tracer.Start(ctx, "db.UpdateUserTx")
+	defer span.End()
+	span.SetAttributes(attribute.Int("user.id", int(args.ID)))
+
+	var result UpdateUserTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		before, err := q.GetUserForUpdate(ctx, args.ID)
+		if err != nil {
+			return err
+		}
+
+		result.User, err = q.UpdateUser(ctx, args.UpdateUserParams)
+		if err != nil {
+			return err
+		}
+
+		if args.Screener == nil || result.User.FullName == before.FullName {
+			return nil
+		}
+
+		screened, err := recordScreeningMatches(ctx, q, RecordScreeningMatchesTxParams{
+			UserID:  result.User.ID,
+			Matches: args.Screener.ScreenName(result.User.FullName),
+			Source:  ScreeningSourceUpdateUser,
+		})
+		result.User, result.Matches = screened.User, screened.Matches
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return result, err
+}
@@ -7,6 +7,7 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 )
@@ -19,7 +20,7 @@ INSERT INTO users (
   email
 ) VALUES (
   $1, $2, $3, $4
-)RETURNING id, username, hashed_password, full_name, email, role, password_changed_at, created_at
+)RETURNING id, username, hashed_password, full_name, email, role, password_changed_at, created_at, screening_status, screened_at
 `
 
 type CreateUserParams struct {
@@ -46,6 +47,8 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.Role,
 		&i.PasswordChangedAt,
 		&i.CreatedAt,
+		&i.ScreeningStatus,
+		&i.ScreenedAt,
 	)
 	return i, err
 }
@@ -61,7 +64,7 @@ func (q *Queries) DeleteUser(ctx context.Context, id int32) error {
 }
 
 const getUser = `-- name: GetUser :one
-SELECT id, username, hashed_password, full_name, email, role, password_changed_at, created_at FROM users
+SELECT id, username, hashed_password, full_name, email, role, password_changed_at, created_at, screening_status, screened_at FROM users
 WHERE username = $1 LIMIT 1
 `
 
@@ -77,10 +80,415 @@ func (q *Queries) GetUser(ctx context.Context, username string) (User, error) {
 		&i.Role,
 		&i.PasswordChangedAt,
 		&i.CreatedAt,
+		&i.ScreeningStatus,
+		&i.ScreenedAt,
 	)
 	return i, err
 }
 
+const getUserByID = `-- name: GetUserByID :one
+SELECT id, username, hashed_password, full_name, email, role, password_changed_at, created_at, screening_status, screened_at FROM users
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetUserByID(ctx context.Context, id int32) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByID, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.Role,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.ScreeningStatus,
+		&i.ScreenedAt,
+	)
+	return i, err
+}
+
+const searchUsersByCreatedAt = `-- name: SearchUsersByCreatedAt :many
+SELECT id, username, hashed_password, full_name, email, role, password_changed_at, created_at, screening_status, screened_at FROM users
+WHERE
+  ($1::varchar IS NULL OR username LIKE $1::varchar || '%')
+  AND ($2::varchar IS NULL OR lower(email) LIKE lower($2::varchar) || '%')
+  AND ($3::varchar IS NULL OR role = $3::varchar)
+  AND ($4::timestamptz IS NULL OR created_at >= $4::timestamptz)
+  AND ($5::timestamptz IS NULL OR created_at < $5::timestamptz)
+  AND ($6::int IS NULL OR (created_at, id) > ($7::timestamptz, $6::int))
+ORDER BY created_at, id
+LIMIT $8
+`
+
+type SearchUsersByCreatedAtParams struct {
+	UsernamePrefix  pgtype.Text        `json:"username_prefix"`
+	EmailPrefix     pgtype.Text        `json:"email_prefix"`
+	Role            pgtype.Text        `json:"role"`
+	CreatedAfter    pgtype.Timestamptz `json:"created_after"`
+	CreatedBefore   pgtype.Timestamptz `json:"created_before"`
+	CursorID        pgtype.Int4        `json:"cursor_id"`
+	CursorCreatedAt time.Time          `json:"cursor_created_at"`
+	PageSize        int32              `json:"page_size"`
+}
+
+func (q *Queries) SearchUsersByCreatedAt(ctx context.Context, arg SearchUsersByCreatedAtParams) ([]User, error) {
+	rows, err := q.db.Query(ctx, searchUsersByCreatedAt,
+		arg.UsernamePrefix,
+		arg.EmailPrefix,
+		arg.Role,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.CursorID,
+		arg.CursorCreatedAt,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.HashedPassword,
+			&i.FullName,
+			&i.Email,
+			&i.Role,
+			&i.PasswordChangedAt,
+			&i.CreatedAt,
+			&i.ScreeningStatus,
+			&i.ScreenedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchUsersByCreatedAtDesc = `-- name: SearchUsersByCreatedAtDesc :many
+SELECT id, username, hashed_password, full_name, email, role, password_changed_at, created_at, screening_status, screened_at FROM users
+WHERE
+  ($1::varchar IS NULL OR username LIKE $1::varchar || '%')
+  AND ($2::varchar IS NULL OR lower(email) LIKE lower($2::varchar) || '%')
+  AND ($3::varchar IS NULL OR role = $3::varchar)
+  AND ($4::timestamptz IS NULL OR created_at >= $4::timestamptz)
+  AND ($5::timestamptz IS NULL OR created_at < $5::timestamptz)
+  AND ($6::int IS NULL OR (created_at, id) < ($7::timestamptz, $6::int))
+ORDER BY created_at DESC, id DESC
+LIMIT $8
+`
+
+type SearchUsersByCreatedAtDescParams struct {
+	UsernamePrefix  pgtype.Text        `json:"username_prefix"`
+	EmailPrefix     pgtype.Text        `json:"email_prefix"`
+	Role            pgtype.Text        `json:"role"`
+	CreatedAfter    pgtype.Timestamptz `json:"created_after"`
+	CreatedBefore   pgtype.Timestamptz `json:"created_before"`
+	CursorID        pgtype.Int4        `json:"cursor_id"`
+	CursorCreatedAt time.Time          `json:"cursor_created_at"`
+	PageSize        int32              `json:"page_size"`
+}
+
+func (q *Queries) SearchUsersByCreatedAtDesc(ctx context.Context, arg SearchUsersByCreatedAtDescParams) ([]User, error) {
+	rows, err := q.db.Query(ctx, searchUsersByCreatedAtDesc,
+		arg.UsernamePrefix,
+		arg.EmailPrefix,
+		arg.Role,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.CursorID,
+		arg.CursorCreatedAt,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.HashedPassword,
+			&i.FullName,
+			&i.Email,
+			&i.Role,
+			&i.PasswordChangedAt,
+			&i.CreatedAt,
+			&i.ScreeningStatus,
+			&i.ScreenedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchUsersByID = `-- name: SearchUsersByID :many
+SELECT id, username, hashed_password, full_name, email, role, password_changed_at, created_at, screening_status, screened_at FROM users
+WHERE
+  ($1::varchar IS NULL OR username LIKE $1::varchar || '%')
+  AND ($2::varchar IS NULL OR lower(email) LIKE lower($2::varchar) || '%')
+  AND ($3::varchar IS NULL OR role = $3::varchar)
+  AND ($4::timestamptz IS NULL OR created_at >= $4::timestamptz)
+  AND ($5::timestamptz IS NULL OR created_at < $5::timestamptz)
+  AND ($6::int IS NULL OR id > $6::int)
+ORDER BY id
+LIMIT $7
+`
+
+type SearchUsersByIDParams struct {
+	UsernamePrefix pgtype.Text        `json:"username_prefix"`
+	EmailPrefix    pgtype.Text        `json:"email_prefix"`
+	Role           pgtype.Text        `json:"role"`
+	CreatedAfter   pgtype.Timestamptz `json:"created_after"`
+	CreatedBefore  pgtype.Timestamptz `json:"created_before"`
+	CursorID       pgtype.Int4        `json:"cursor_id"`
+	PageSize       int32              `json:"page_size"`
+}
+
+func (q *Queries) SearchUsersByID(ctx context.Context, arg SearchUsersByIDParams) ([]User, error) {
+	rows, err := q.db.Query(ctx, searchUsersByID,
+		arg.UsernamePrefix,
+		arg.EmailPrefix,
+		arg.Role,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.CursorID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.HashedPassword,
+			&i.FullName,
+			&i.Email,
+			&i.Role,
+			&i.PasswordChangedAt,
+			&i.CreatedAt,
+			&i.ScreeningStatus,
+			&i.ScreenedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchUsersByIDDesc = `-- name: SearchUsersByIDDesc :many
+SELECT id, username, hashed_password, full_name, email, role, password_changed_at, created_at, screening_status, screened_at FROM users
+WHERE
+  ($1::varchar IS NULL OR username LIKE $1::varchar || '%')
+  AND ($2::varchar IS NULL OR lower(email) LIKE lower($2::varchar) || '%')
+  AND ($3::varchar IS NULL OR role = $3::varchar)
+  AND ($4::timestamptz IS NULL OR created_at >= $4::timestamptz)
+  AND ($5::timestamptz IS NULL OR created_at < $5::timestamptz)
+  AND ($6::int IS NULL OR id < $6::int)
+ORDER BY id DESC
+LIMIT $7
+`
+
+type SearchUsersByIDDescParams struct {
+	UsernamePrefix pgtype.Text        `json:"username_prefix"`
+	EmailPrefix    pgtype.Text        `json:"email_prefix"`
+	Role           pgtype.Text        `json:"role"`
+	CreatedAfter   pgtype.Timestamptz `json:"created_after"`
+	CreatedBefore  pgtype.Timestamptz `json:"created_before"`
+	CursorID       pgtype.Int4        `json:"cursor_id"`
+	PageSize       int32              `json:"page_size"`
+}
+
+func (q *Queries) SearchUsersByIDDesc(ctx context.Context, arg SearchUsersByIDDescParams) ([]User, error) {
+	rows, err := q.db.Query(ctx, searchUsersByIDDesc,
+		arg.UsernamePrefix,
+		arg.EmailPrefix,
+		arg.Role,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.CursorID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.HashedPassword,
+			&i.FullName,
+			&i.Email,
+			&i.Role,
+			&i.PasswordChangedAt,
+			&i.CreatedAt,
+			&i.ScreeningStatus,
+			&i.ScreenedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchUsersByUsername = `-- name: SearchUsersByUsername :many
+SELECT id, username, hashed_password, full_name, email, role, password_changed_at, created_at, screening_status, screened_at FROM users
+WHERE
+  ($1::varchar IS NULL OR username LIKE $1::varchar || '%')
+  AND ($2::varchar IS NULL OR lower(email) LIKE lower($2::varchar) || '%')
+  AND ($3::varchar IS NULL OR role = $3::varchar)
+  AND ($4::timestamptz IS NULL OR created_at >= $4::timestamptz)
+  AND ($5::timestamptz IS NULL OR created_at < $5::timestamptz)
+  AND ($6::int IS NULL OR (username, id) > ($7::varchar, $6::int))
+ORDER BY username, id
+LIMIT $8
+`
+
+type SearchUsersByUsernameParams struct {
+	UsernamePrefix pgtype.Text        `json:"username_prefix"`
+	EmailPrefix    pgtype.Text        `json:"email_prefix"`
+	Role           pgtype.Text        `json:"role"`
+	CreatedAfter   pgtype.Timestamptz `json:"created_after"`
+	CreatedBefore  pgtype.Timestamptz `json:"created_before"`
+	CursorID       pgtype.Int4        `json:"cursor_id"`
+	CursorUsername string             `json:"cursor_username"`
+	PageSize       int32              `json:"page_size"`
+}
+
+func (q *Queries) SearchUsersByUsername(ctx context.Context, arg SearchUsersByUsernameParams) ([]User, error) {
+	rows, err := q.db.Query(ctx, searchUsersByUsername,
+		arg.UsernamePrefix,
+		arg.EmailPrefix,
+		arg.Role,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.CursorID,
+		arg.CursorUsername,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.HashedPassword,
+			&i.FullName,
+			&i.Email,
+			&i.Role,
+			&i.PasswordChangedAt,
+			&i.CreatedAt,
+			&i.ScreeningStatus,
+			&i.ScreenedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchUsersByUsernameDesc = `-- name: SearchUsersByUsernameDesc :many
+SELECT id, username, hashed_password, full_name, email, role, password_changed_at, created_at, screening_status, screened_at FROM users
+WHERE
+  ($1::varchar IS NULL OR username LIKE $1::varchar || '%')
+  AND ($2::varchar IS NULL OR lower(email) LIKE lower($2::varchar) || '%')
+  AND ($3::varchar IS NULL OR role = $3::varchar)
+  AND ($4::timestamptz IS NULL OR created_at >= $4::timestamptz)
+  AND ($5::timestamptz IS NULL OR created_at < $5::timestamptz)
+  AND ($6::int IS NULL OR (username, id) < ($7::varchar, $6::int))
+ORDER BY username DESC, id DESC
+LIMIT $8
+`
+
+type SearchUsersByUsernameDescParams struct {
+	UsernamePrefix pgtype.Text        `json:"username_prefix"`
+	EmailPrefix    pgtype.Text        `json:"email_prefix"`
+	Role           pgtype.Text        `json:"role"`
+	CreatedAfter   pgtype.Timestamptz `json:"created_after"`
+	CreatedBefore  pgtype.Timestamptz `json:"created_before"`
+	CursorID       pgtype.Int4        `json:"cursor_id"`
+	CursorUsername string             `json:"cursor_username"`
+	PageSize       int32              `json:"page_size"`
+}
+
+func (q *Queries) SearchUsersByUsernameDesc(ctx context.Context, arg SearchUsersByUsernameDescParams) ([]User, error) {
+	rows, err := q.db.Query(ctx, searchUsersByUsernameDesc,
+		arg.UsernamePrefix,
+		arg.EmailPrefix,
+		arg.Role,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.CursorID,
+		arg.CursorUsername,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.HashedPassword,
+			&i.FullName,
+			&i.Email,
+			&i.Role,
+			&i.PasswordChangedAt,
+			&i.CreatedAt,
+			&i.ScreeningStatus,
+			&i.ScreenedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateUser = `-- name: UpdateUser :one
 UPDATE users
 SET
@@ -90,7 +498,7 @@ SET
   email = COALESCE($5, email),
   password_changed_at = COALESCE($6, password_changed_at)
 WHERE id = $1
-RETURNING id, username, hashed_password, full_name, email, role, password_changed_at, created_at
+RETURNING id, username, hashed_password, full_name, email, role, password_changed_at, created_at, screening_status, screened_at
 `
 
 type UpdateUserParams struct {
@@ -121,6 +529,38 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.Role,
 		&i.PasswordChangedAt,
 		&i.CreatedAt,
+		&i.ScreeningStatus,
+		&i.ScreenedAt,
+	)
+	return i, err
+}
+
+const updateUserRole = `-- name: UpdateUserRole :one
+UPDATE users
+SET role = $1
+WHERE id = $2
+RETURNING id, username, hashed_password, full_name, email, role, password_changed_at, created_at, screening_status, screened_at
+`
+
+type UpdateUserRoleParams struct {
+	Role string `json:"role"`
+	ID   int32  `json:"id"`
+}
+
+func (q *Queries) UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) (User, error) {
+	row := q.db.QueryRow(ctx, updateUserRole, arg.Role, arg.ID)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.Role,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.ScreeningStatus,
+		&i.ScreenedAt,
 	)
 	return i, err
 }
@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -182,6 +183,27 @@ func TestUpdateOnlyHashedPassword(t *testing.T) {
 	require.WithinDuration(t, user.PasswordChangedAt, updatedUser.PasswordChangedAt, time.Duration(2*time.Second))
 }
 
+func TestSearchUsersByPrefix(t *testing.T) {
+	user := randomUser(t)
+
+	users, err := testStore.SearchUsers(context.Background(), SearchUsersParams{
+		EmailPrefix: pgtype.Text{String: strings.ToUpper(user.Email), Valid: true},
+		SortBy:      "username",
+		PageSize:    10,
+	})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	require.Equal(t, user.ID, users[0].ID)
+
+	users, err = testStore.SearchUsers(context.Background(), SearchUsersParams{
+		UsernamePrefix: pgtype.Text{String: user.Username + "x", Valid: true},
+		SortBy:         "id",
+		PageSize:       10,
+	})
+	require.NoError(t, err)
+	require.Empty(t, users)
+}
+
 func randomUser(t *testing.T) User {
 	hashedPassword, err := utils.HashPassword(utils.RandomString(8))
 	require.NoError(t, err)
@@ -0,0 +1,8 @@
+package doc
+
+import "embed"
+
+// Swagger holds the generated OpenAPI spec and the Swagger UI serving it.
+//
+//go:embed swagger
+var Swagger embed.FS
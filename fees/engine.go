@@ -0,0 +1,97 @@
+package fees
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/telemetry"
+	"github.com/valkyraycho/bank_project/worker"
+)
+
+// Engine charges monthly maintenance fees once a month has ended. Charging is
+// idempotent per account and period. All periods are UTC.
+type Engine struct {
+	store db.Store
+	now   func() time.Time
+}
+
+func NewEngine(store db.Store) *Engine {
+	return &Engine{
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// ChargeMonth charges the maintenance fee for the month containing period to
+// every account that was open during it. Accounts that can't be charged right
+// now, because they are frozen or lack the funds, are skipped; RunOnce only
+// retries them until the next month ends, Backfill catches up on older
+// months. It returns the number of fees charged.
+func (engine *Engine) ChargeMonth(ctx context.Context, period time.Time) (int, error) {
+	period = worker.StartOfMonth(period)
+	periodEnd := period.AddDate(0, 1, 0)
+	if periodEnd.After(engine.now()) {
+		return 0, fmt.Errorf("cannot charge %s: %w", period.Format("2006-01"), worker.ErrPeriodNotEnded)
+	}
+
+	accountIDs, err := engine.store.ListAccountsDueMaintenanceFee(ctx, db.ListAccountsDueMaintenanceFeeParams{
+		Period:    period,
+		PeriodEnd: periodEnd,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("cannot list accounts due a maintenance fee: %w", err)
+	}
+
+	charged := 0
+	for _, accountID := range accountIDs {
+		_, err := engine.store.ChargeMaintenanceFeeTx(ctx, db.ChargeMaintenanceFeeTxParams{
+			AccountID: accountID,
+			Period:    period,
+		})
+
+		var notActive *db.AccountNotActiveError
+		switch {
+		case err == nil:
+			charged++
+		case errors.Is(err, db.ErrFeeAlreadyCharged):
+		case errors.As(err, &notActive), errors.Is(err, db.ErrInsufficientFunds):
+			telemetry.Logger(ctx).Warn().Err(err).Int32("account_id", accountID).Msg("skipping maintenance fee")
+		default:
+			return charged, fmt.Errorf("cannot charge maintenance fee to account %d: %w", accountID, err)
+		}
+	}
+	return charged, nil
+}
+
+// Backfill charges every month from the one containing from up to the last
+// month that has ended. It is used to recover months missed while the engine
+// wasn't running or accounts couldn't be charged.
+func (engine *Engine) Backfill(ctx context.Context, from time.Time) (int, error) {
+	charged := 0
+	for period := worker.StartOfMonth(from); !period.AddDate(0, 1, 0).After(engine.now()); period = period.AddDate(0, 1, 0) {
+		n, err := engine.ChargeMonth(ctx, period)
+		charged += n
+		if err != nil {
+			return charged, err
+		}
+	}
+	return charged, nil
+}
+
+// RunOnce charges the previous month.
+func (engine *Engine) RunOnce(ctx context.Context) {
+	logger := telemetry.Logger(ctx)
+
+	charged, err := engine.ChargeMonth(ctx, worker.StartOfMonth(engine.now()).AddDate(0, -1, 0))
+	if err != nil {
+		logger.Error().Err(err).Msg("maintenance fee run failed")
+		return
+	}
+
+	if charged > 0 {
+		logger.Info().Int("charged", charged).Msg("maintenance fee run")
+	}
+}
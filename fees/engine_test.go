@@ -0,0 +1,98 @@
+package fees
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/worker"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestEngine(t *testing.T, now time.Time) (*Engine, *mockdb.MockStore) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	engine := NewEngine(store)
+	engine.now = func() time.Time { return now }
+	return engine, store
+}
+
+func TestChargeMonth(t *testing.T) {
+	now := time.Date(2024, 3, 1, 0, 30, 0, 0, time.UTC)
+	period := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	engine, store := newTestEngine(t, now)
+
+	store.EXPECT().
+		ListAccountsDueMaintenanceFee(gomock.Any(), gomock.Eq(db.ListAccountsDueMaintenanceFeeParams{
+			Period:    period,
+			PeriodEnd: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		})).
+		Return([]int32{1, 2, 3, 4}, nil)
+
+	store.EXPECT().
+		ChargeMaintenanceFeeTx(gomock.Any(), gomock.Eq(db.ChargeMaintenanceFeeTxParams{AccountID: 1, Period: period})).
+		Return(db.ChargeMaintenanceFeeTxResult{}, nil)
+	store.EXPECT().
+		ChargeMaintenanceFeeTx(gomock.Any(), gomock.Eq(db.ChargeMaintenanceFeeTxParams{AccountID: 2, Period: period})).
+		Return(db.ChargeMaintenanceFeeTxResult{}, &db.AccountNotActiveError{AccountID: 2, Status: db.AccountStatusFrozen})
+	store.EXPECT().
+		ChargeMaintenanceFeeTx(gomock.Any(), gomock.Eq(db.ChargeMaintenanceFeeTxParams{AccountID: 3, Period: period})).
+		Return(db.ChargeMaintenanceFeeTxResult{}, &db.ProductRuleError{ProductCode: "savings", Rule: db.ErrInsufficientFunds})
+	store.EXPECT().
+		ChargeMaintenanceFeeTx(gomock.Any(), gomock.Eq(db.ChargeMaintenanceFeeTxParams{AccountID: 4, Period: period})).
+		Return(db.ChargeMaintenanceFeeTxResult{}, db.ErrFeeAlreadyCharged)
+
+	charged, err := engine.ChargeMonth(context.Background(), period.AddDate(0, 0, 10))
+	require.NoError(t, err)
+	require.Equal(t, 1, charged)
+
+	_, err = engine.ChargeMonth(context.Background(), now)
+	require.ErrorIs(t, err, worker.ErrPeriodNotEnded)
+}
+
+func TestChargeMonthStopsOnStoreError(t *testing.T) {
+	now := time.Date(2024, 3, 1, 0, 30, 0, 0, time.UTC)
+	engine, store := newTestEngine(t, now)
+
+	store.EXPECT().
+		ListAccountsDueMaintenanceFee(gomock.Any(), gomock.Any()).
+		Return([]int32{1, 2}, nil)
+	store.EXPECT().
+		ChargeMaintenanceFeeTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(db.ChargeMaintenanceFeeTxResult{}, sql.ErrConnDone)
+
+	_, err := engine.ChargeMonth(context.Background(), time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+	require.ErrorIs(t, err, sql.ErrConnDone)
+}
+
+func TestBackfill(t *testing.T) {
+	now := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	engine, store := newTestEngine(t, now)
+
+	// January and February have ended, March has not.
+	for _, period := range []time.Time{
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		store.EXPECT().
+			ListAccountsDueMaintenanceFee(gomock.Any(), gomock.Eq(db.ListAccountsDueMaintenanceFeeParams{
+				Period:    period,
+				PeriodEnd: period.AddDate(0, 1, 0),
+			})).
+			Return([]int32{1}, nil)
+		store.EXPECT().
+			ChargeMaintenanceFeeTx(gomock.Any(), gomock.Eq(db.ChargeMaintenanceFeeTxParams{AccountID: 1, Period: period})).
+			Return(db.ChargeMaintenanceFeeTxResult{}, nil)
+	}
+
+	charged, err := engine.Backfill(context.Background(), time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Equal(t, 2, charged)
+}
@@ -0,0 +1,219 @@
+package fraud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+)
+
+// Queries is the part of the store the rules read from.
+type Queries interface {
+	GetOutgoingTransferVelocity(ctx context.Context, arg db.GetOutgoingTransferVelocityParams) (db.GetOutgoingTransferVelocityRow, error)
+	CountTransfersToPayee(ctx context.Context, arg db.CountTransfersToPayeeParams) (int64, error)
+	CountStructuredTransfers(ctx context.Context, arg db.CountStructuredTransfersParams) (int64, error)
+	ListSessionIPs(ctx context.Context, arg db.ListSessionIPsParams) ([]string, error)
+}
+
+// Transaction is a transfer to screen. TransferID is set when screening a
+// transfer that is already recorded, so the rules don't count it twice.
+type Transaction struct {
+	TransferID    pgtype.Int4
+	FromAccountID int32
+	ToAccountID   int32
+	Amount        int32
+	SenderID      pgtype.Int4
+	At            time.Time
+}
+
+type Hit struct {
+	Rule   string `json:"rule"`
+	Score  int32  `json:"score"`
+	Reason string `json:"reason"`
+}
+
+type Result struct {
+	Score    int32
+	Decision db.FraudDecision
+	Hits     []Hit
+}
+
+func (result Result) screening() (db.TransferScreening, error) {
+	hits, err := json.Marshal(result.Hits)
+	if err != nil {
+		return db.TransferScreening{}, err
+	}
+	return db.TransferScreening{
+		Decision: result.Decision,
+		Score:    result.Score,
+		Hits:     hits,
+	}, nil
+}
+
+// Engine scores transfers against a rule set.
+type Engine struct {
+	rules RuleSet
+	now   func() time.Time
+}
+
+func NewEngine(rules RuleSet) *Engine {
+	return &Engine{
+		rules: rules,
+		now:   time.Now,
+	}
+}
+
+// Screen runs every rule against the transaction and decides on the total
+// score of the ones that matched.
+func (engine *Engine) Screen(ctx context.Context, q Queries, tx Transaction) (Result, error) {
+	result := Result{
+		Decision: db.FraudDecisionAllow,
+		Hits:     []Hit{},
+	}
+
+	for _, rule := range engine.rules.Rules {
+		reason, err := evaluate(ctx, q, rule, tx)
+		if err != nil {
+			return Result{}, fmt.Errorf("cannot evaluate fraud rule %q: %w", rule.Name, err)
+		}
+		if reason == "" {
+			continue
+		}
+		result.Score += rule.Score
+		result.Hits = append(result.Hits, Hit{Rule: rule.Name, Score: rule.Score, Reason: reason})
+	}
+
+	switch {
+	case result.Score >= engine.rules.BlockScore:
+		result.Decision = db.FraudDecisionBlock
+	case result.Score >= engine.rules.HoldScore:
+		result.Decision = db.FraudDecisionHold
+	}
+	return result, nil
+}
+
+// ScreenTransfer screens a transfer about to be made, implementing
+// db.TransferScreener.
+func (engine *Engine) ScreenTransfer(ctx context.Context, q db.Querier, args db.TransferTxParams) (db.TransferScreening, error) {
+	result, err := engine.Screen(ctx, q, Transaction{
+		FromAccountID: args.FromAccountID,
+		ToAccountID:   args.ToAccountID,
+		Amount:        args.Amount,
+		SenderID:      args.SenderID,
+		At:            engine.now(),
+	})
+	if err != nil {
+		return db.TransferScreening{}, err
+	}
+	return result.screening()
+}
+
+// evaluate returns why the rule matched the transaction, or an empty string
+// when it didn't.
+func evaluate(ctx context.Context, q Queries, rule Rule, tx Transaction) (string, error) {
+	switch rule.Type {
+	case RuleVelocity:
+		return evaluateVelocity(ctx, q, rule, tx)
+	case RuleNewPayee:
+		return evaluateNewPayee(ctx, q, rule, tx)
+	case RuleStructuring:
+		return evaluateStructuring(ctx, q, rule, tx)
+	case RuleIPChange:
+		return evaluateIPChange(ctx, q, rule, tx)
+	}
+	return "", fmt.Errorf("unknown type %q", rule.Type)
+}
+
+func evaluateVelocity(ctx context.Context, q Queries, rule Rule, tx Transaction) (string, error) {
+	velocity, err := q.GetOutgoingTransferVelocity(ctx, db.GetOutgoingTransferVelocityParams{
+		AccountID: tx.FromAccountID,
+		Since:     tx.At.Add(-rule.Window),
+		Until:     tx.At,
+		ExcludeID: tx.TransferID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	count := velocity.Transfers + 1
+	total := velocity.Total + int64(tx.Amount)
+	switch {
+	case rule.MaxCount > 0 && count > rule.MaxCount:
+		return fmt.Sprintf("%d transfers within %s, above %d", count, rule.Window, rule.MaxCount), nil
+	case rule.MaxAmount > 0 && total > rule.MaxAmount:
+		return fmt.Sprintf("%d sent within %s, above %d", total, rule.Window, rule.MaxAmount), nil
+	}
+	return "", nil
+}
+
+func evaluateNewPayee(ctx context.Context, q Queries, rule Rule, tx Transaction) (string, error) {
+	if tx.Amount < rule.MinAmount {
+		return "", nil
+	}
+
+	previous, err := q.CountTransfersToPayee(ctx, db.CountTransfersToPayeeParams{
+		FromAccountID: tx.FromAccountID,
+		ToAccountID:   tx.ToAccountID,
+		Until:         tx.At,
+		ExcludeID:     tx.TransferID,
+	})
+	if err != nil || previous > 0 {
+		return "", err
+	}
+	return fmt.Sprintf("%d to account %d, never paid before", tx.Amount, tx.ToAccountID), nil
+}
+
+func evaluateStructuring(ctx context.Context, q Queries, rule Rule, tx Transaction) (string, error) {
+	minAmount := rule.Threshold - rule.Margin
+	if tx.Amount < minAmount || tx.Amount >= rule.Threshold || tx.Amount%rule.RoundTo != 0 {
+		return "", nil
+	}
+
+	count := int64(1)
+	if rule.MinCount > 1 {
+		previous, err := q.CountStructuredTransfers(ctx, db.CountStructuredTransfersParams{
+			AccountID: tx.FromAccountID,
+			MinAmount: minAmount,
+			MaxAmount: rule.Threshold,
+			RoundTo:   rule.RoundTo,
+			Since:     tx.At.Add(-rule.Window),
+			Until:     tx.At,
+			ExcludeID: tx.TransferID,
+		})
+		if err != nil {
+			return "", err
+		}
+		count += previous
+	}
+	if count < rule.MinCount {
+		return "", nil
+	}
+	return fmt.Sprintf("%d round transfers just under %d", count, rule.Threshold), nil
+}
+
+func evaluateIPChange(ctx context.Context, q Queries, rule Rule, tx Transaction) (string, error) {
+	if !tx.SenderID.Valid {
+		return "", nil
+	}
+
+	ips, err := q.ListSessionIPs(ctx, db.ListSessionIPsParams{
+		UserID: tx.SenderID.Int32,
+		Since:  tx.At.Add(-rule.Window),
+		Until:  tx.At,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	distinct := map[string]bool{}
+	for _, ip := range ips {
+		distinct[ip] = true
+	}
+	if len(distinct) < rule.MinIPs {
+		return "", nil
+	}
+	return fmt.Sprintf("logged in from %d addresses within %s", len(distinct), rule.Window), nil
+}
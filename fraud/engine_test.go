@@ -0,0 +1,191 @@
+package fraud
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"go.uber.org/mock/gomock"
+)
+
+var testRules = RuleSet{
+	HoldScore:  50,
+	BlockScore: 100,
+	Rules: []Rule{
+		{Name: "burst", Type: RuleVelocity, Score: 40, Window: 10 * time.Minute, MaxCount: 3},
+		{Name: "new_payee", Type: RuleNewPayee, Score: 30, MinAmount: 1000},
+		{Name: "structuring", Type: RuleStructuring, Score: 60, Threshold: 10000, Margin: 500, RoundTo: 100, MinCount: 2, Window: 72 * time.Hour},
+		{Name: "ips", Type: RuleIPChange, Score: 20, Window: 24 * time.Hour, MinIPs: 2},
+	},
+}
+
+func newTestEngine(t *testing.T, now time.Time) (*Engine, *mockdb.MockStore) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	engine := NewEngine(testRules)
+	engine.now = func() time.Time { return now }
+	return engine, store
+}
+
+func TestScreenTransfer(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	args := db.TransferTxParams{
+		FromAccountID: 1,
+		ToAccountID:   2,
+		Amount:        9900,
+		SenderID:      pgtype.Int4{Int32: 7, Valid: true},
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mockdb.MockStore)
+		check      func(t *testing.T, screening db.TransferScreening, err error)
+	}{
+		{
+			name: "Allow",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetOutgoingTransferVelocity(gomock.Any(), gomock.Eq(db.GetOutgoingTransferVelocityParams{
+						AccountID: 1,
+						Since:     now.Add(-10 * time.Minute),
+						Until:     now,
+					})).
+					Times(1).
+					Return(db.GetOutgoingTransferVelocityRow{Transfers: 1, Total: 100}, nil)
+				store.EXPECT().
+					CountTransfersToPayee(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(4), nil)
+				store.EXPECT().
+					CountStructuredTransfers(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(0), nil)
+				store.EXPECT().
+					ListSessionIPs(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]string{"10.0.0.1", "10.0.0.1"}, nil)
+			},
+			check: func(t *testing.T, screening db.TransferScreening, err error) {
+				require.NoError(t, err)
+				require.Equal(t, db.FraudDecisionAllow, screening.Decision)
+				require.Zero(t, screening.Score)
+				require.JSONEq(t, `[]`, string(screening.Hits))
+			},
+		},
+		{
+			name: "Hold",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetOutgoingTransferVelocity(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.GetOutgoingTransferVelocityRow{Transfers: 3, Total: 300}, nil)
+				store.EXPECT().
+					CountTransfersToPayee(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(4), nil)
+				store.EXPECT().
+					CountStructuredTransfers(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(0), nil)
+				store.EXPECT().
+					ListSessionIPs(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]string{"10.0.0.1", "10.0.0.2"}, nil)
+			},
+			check: func(t *testing.T, screening db.TransferScreening, err error) {
+				require.NoError(t, err)
+				require.Equal(t, db.FraudDecisionHold, screening.Decision)
+				require.Equal(t, int32(60), screening.Score)
+
+				var hits []Hit
+				require.NoError(t, json.Unmarshal(screening.Hits, &hits))
+				require.Len(t, hits, 2)
+				require.Equal(t, "burst", hits[0].Rule)
+				require.Equal(t, "ips", hits[1].Rule)
+			},
+		},
+		{
+			name: "Block",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetOutgoingTransferVelocity(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.GetOutgoingTransferVelocityRow{Transfers: 3, Total: 300}, nil)
+				store.EXPECT().
+					CountTransfersToPayee(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(0), nil)
+				store.EXPECT().
+					CountStructuredTransfers(gomock.Any(), gomock.Eq(db.CountStructuredTransfersParams{
+						AccountID: 1,
+						MinAmount: 9500,
+						MaxAmount: 10000,
+						RoundTo:   100,
+						Since:     now.Add(-72 * time.Hour),
+						Until:     now,
+					})).
+					Times(1).
+					Return(int64(1), nil)
+				store.EXPECT().
+					ListSessionIPs(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]string{}, nil)
+			},
+			check: func(t *testing.T, screening db.TransferScreening, err error) {
+				require.NoError(t, err)
+				require.Equal(t, db.FraudDecisionBlock, screening.Decision)
+				require.Equal(t, int32(130), screening.Score)
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetOutgoingTransferVelocity(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.GetOutgoingTransferVelocityRow{}, sql.ErrConnDone)
+			},
+			check: func(t *testing.T, screening db.TransferScreening, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		engine, store := newTestEngine(t, now)
+		tc.buildStubs(store)
+
+		screening, err := engine.ScreenTransfer(context.Background(), store, args)
+		tc.check(t, screening, err)
+	}
+}
+
+func TestScreenSkipsRulesThatDoNotApply(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	engine, store := newTestEngine(t, now)
+
+	// A small transfer made by the bank: only velocity is queried.
+	store.EXPECT().
+		GetOutgoingTransferVelocity(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(db.GetOutgoingTransferVelocityRow{}, nil)
+
+	result, err := engine.Screen(context.Background(), store, Transaction{
+		FromAccountID: 1,
+		ToAccountID:   2,
+		Amount:        950,
+		At:            now,
+	})
+	require.NoError(t, err)
+	require.Equal(t, db.FraudDecisionAllow, result.Decision)
+	require.Empty(t, result.Hits)
+}
@@ -0,0 +1,118 @@
+package fraud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/telemetry"
+)
+
+const batchSize = 100
+
+// Monitor screens completed transfers after the fact, catching patterns that
+// only show once later transfers are in. Transfers are screened once, and a
+// transfer never gets more than one alert. Transfers the bank posts itself,
+// such as interest and close-account sweeps, have no sender and are marked
+// screened without running the rules.
+type Monitor struct {
+	store  db.Store
+	engine *Engine
+}
+
+func NewMonitor(store db.Store, engine *Engine) *Monitor {
+	return &Monitor{
+		store:  store,
+		engine: engine,
+	}
+}
+
+// ScreenPending screens every completed transfer not screened yet and returns
+// how many it screened and how many alerts it raised.
+func (monitor *Monitor) ScreenPending(ctx context.Context) (screened int, alerts int, err error) {
+	system, err := monitor.store.MarkSystemTransfersScreened(ctx)
+	if err != nil {
+		return screened, alerts, fmt.Errorf("cannot mark system transfers screened: %w", err)
+	}
+	screened += int(system)
+
+	for {
+		transfers, err := monitor.store.ListUnscreenedTransfers(ctx, batchSize)
+		if err != nil {
+			return screened, alerts, fmt.Errorf("cannot list unscreened transfers: %w", err)
+		}
+
+		for _, transfer := range transfers {
+			alerted, err := monitor.screen(ctx, transfer)
+			if err != nil {
+				return screened, alerts, fmt.Errorf("cannot screen transfer %d: %w", transfer.ID, err)
+			}
+			screened++
+			if alerted {
+				alerts++
+			}
+		}
+
+		if len(transfers) < batchSize {
+			return screened, alerts, nil
+		}
+	}
+}
+
+func (monitor *Monitor) screen(ctx context.Context, transfer db.Transfer) (bool, error) {
+	result, err := monitor.engine.Screen(ctx, monitor.store, Transaction{
+		TransferID:    pgtype.Int4{Int32: transfer.ID, Valid: true},
+		FromAccountID: transfer.FromAccountID,
+		ToAccountID:   transfer.ToAccountID,
+		Amount:        transfer.Amount,
+		SenderID:      transfer.SenderID,
+		At:            transfer.CreatedAt,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	alerted := false
+	if result.Decision != db.FraudDecisionAllow {
+		screening, err := result.screening()
+		if err != nil {
+			return false, err
+		}
+
+		_, err = monitor.store.CreateFraudAlert(ctx, db.CreateFraudAlertParams{
+			TransferID: transfer.ID,
+			AccountID:  transfer.FromAccountID,
+			Score:      screening.Score,
+			Decision:   screening.Decision,
+			Hits:       screening.Hits,
+			Source:     db.FraudAlertSourceBatch,
+		})
+		switch {
+		case err == nil:
+			alerted = true
+		case errors.Is(err, pgx.ErrNoRows):
+		default:
+			return false, err
+		}
+	}
+
+	return alerted, monitor.store.MarkTransferScreened(ctx, transfer.ID)
+}
+
+// RunOnce screens pending transfers.
+func (monitor *Monitor) RunOnce(ctx context.Context) {
+	logger := telemetry.Logger(ctx)
+
+	screened, alerts, err := monitor.ScreenPending(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("fraud monitor run failed")
+		return
+	}
+
+	if screened > 0 {
+		logger.Info().Int("screened", screened).Int("alerts", alerts).Msg("fraud monitor run")
+	}
+}
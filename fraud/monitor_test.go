@@ -0,0 +1,129 @@
+package fraud
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestMonitor(t *testing.T) (*Monitor, *mockdb.MockStore) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	rules := RuleSet{
+		HoldScore:  50,
+		BlockScore: 100,
+		Rules:      []Rule{{Name: "burst", Type: RuleVelocity, Score: 50, Window: time.Hour, MaxCount: 2}},
+	}
+	return NewMonitor(store, NewEngine(rules)), store
+}
+
+func TestScreenPending(t *testing.T) {
+	monitor, store := newTestMonitor(t)
+	createdAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	transfers := []db.Transfer{
+		{ID: 1, FromAccountID: 10, ToAccountID: 20, Amount: 100, CreatedAt: createdAt},
+		{ID: 2, FromAccountID: 11, ToAccountID: 20, Amount: 100, CreatedAt: createdAt},
+		{ID: 3, FromAccountID: 12, ToAccountID: 20, Amount: 100, CreatedAt: createdAt},
+	}
+
+	store.EXPECT().
+		MarkSystemTransfersScreened(gomock.Any()).
+		Return(int64(0), nil)
+	store.EXPECT().
+		ListUnscreenedTransfers(gomock.Any(), gomock.Eq(int32(batchSize))).
+		Return(transfers, nil)
+
+	store.EXPECT().
+		GetOutgoingTransferVelocity(gomock.Any(), gomock.Eq(db.GetOutgoingTransferVelocityParams{
+			AccountID: 10,
+			Since:     createdAt.Add(-time.Hour),
+			Until:     createdAt,
+			ExcludeID: pgtype.Int4{Int32: 1, Valid: true},
+		})).
+		Return(db.GetOutgoingTransferVelocityRow{}, nil)
+	store.EXPECT().
+		GetOutgoingTransferVelocity(gomock.Any(), gomock.Not(gomock.Cond(func(arg db.GetOutgoingTransferVelocityParams) bool {
+			return arg.AccountID == 10
+		}))).
+		Times(2).
+		Return(db.GetOutgoingTransferVelocityRow{Transfers: 5}, nil)
+
+	store.EXPECT().
+		CreateFraudAlert(gomock.Any(), gomock.Cond(func(arg db.CreateFraudAlertParams) bool {
+			return arg.TransferID == 2 && arg.Decision == db.FraudDecisionHold && arg.Source == db.FraudAlertSourceBatch
+		})).
+		Return(db.FraudAlert{ID: 1}, nil)
+	// Transfer 3 was held when it was made and already has an alert.
+	store.EXPECT().
+		CreateFraudAlert(gomock.Any(), gomock.Cond(func(arg db.CreateFraudAlertParams) bool {
+			return arg.TransferID == 3
+		})).
+		Return(db.FraudAlert{}, pgx.ErrNoRows)
+
+	for _, transfer := range transfers {
+		store.EXPECT().
+			MarkTransferScreened(gomock.Any(), gomock.Eq(transfer.ID)).
+			Return(nil)
+	}
+
+	screened, alerts, err := monitor.ScreenPending(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 3, screened)
+	require.Equal(t, 1, alerts)
+}
+
+func TestScreenPendingStopsOnStoreError(t *testing.T) {
+	monitor, store := newTestMonitor(t)
+
+	store.EXPECT().
+		MarkSystemTransfersScreened(gomock.Any()).
+		Return(int64(0), nil)
+	store.EXPECT().
+		ListUnscreenedTransfers(gomock.Any(), gomock.Any()).
+		Return([]db.Transfer{{ID: 1}, {ID: 2}}, nil)
+	store.EXPECT().
+		GetOutgoingTransferVelocity(gomock.Any(), gomock.Any()).
+		Return(db.GetOutgoingTransferVelocityRow{}, nil)
+	store.EXPECT().
+		MarkTransferScreened(gomock.Any(), gomock.Eq(int32(1))).
+		Return(sql.ErrConnDone)
+
+	screened, _, err := monitor.ScreenPending(context.Background())
+	require.ErrorIs(t, err, sql.ErrConnDone)
+	require.Zero(t, screened)
+}
+
+func TestScreenPendingSkipsSystemTransfers(t *testing.T) {
+	monitor, store := newTestMonitor(t)
+
+	// Interest posted from the interest expense account has no sender. Its
+	// burst of transfers is marked screened without running the rules or
+	// raising an alert.
+	store.EXPECT().
+		MarkSystemTransfersScreened(gomock.Any()).
+		Return(int64(batchSize+1), nil)
+	store.EXPECT().
+		ListUnscreenedTransfers(gomock.Any(), gomock.Any()).
+		Return([]db.Transfer{}, nil)
+	store.EXPECT().
+		GetOutgoingTransferVelocity(gomock.Any(), gomock.Any()).
+		Times(0)
+	store.EXPECT().
+		CreateFraudAlert(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	screened, alerts, err := monitor.ScreenPending(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, batchSize+1, screened)
+	require.Zero(t, alerts)
+}
@@ -0,0 +1,149 @@
+package fraud
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	RuleVelocity    = "velocity"
+	RuleNewPayee    = "new_payee"
+	RuleStructuring = "structuring"
+	RuleIPChange    = "ip_change"
+)
+
+// RuleSet is the set of rules transfers are screened against. Each matching
+// rule adds its score; a transfer scoring HoldScore or more is held for
+// review and one scoring BlockScore or more is blocked.
+type RuleSet struct {
+	HoldScore  int32  `yaml:"hold_score"`
+	BlockScore int32  `yaml:"block_score"`
+	Rules      []Rule `yaml:"rules"`
+}
+
+// Rule is a single check. Which fields apply depends on its type:
+//
+//   - velocity matches when the outgoing transfers of the account within
+//     Window, this one included, go over MaxCount or MaxAmount.
+//   - new_payee matches a transfer of at least MinAmount to an account the
+//     sender never sent money to before.
+//   - structuring matches a multiple of RoundTo that falls within Margin under
+//     Threshold, once MinCount such transfers were sent within Window.
+//   - ip_change matches when the sender logged in from MinIPs or more
+//     distinct addresses within Window.
+type Rule struct {
+	Name  string `yaml:"name"`
+	Type  string `yaml:"type"`
+	Score int32  `yaml:"score"`
+
+	Window    time.Duration `yaml:"window"`
+	MaxCount  int64         `yaml:"max_count"`
+	MaxAmount int64         `yaml:"max_amount"`
+	MinAmount int32         `yaml:"min_amount"`
+	Threshold int32         `yaml:"threshold"`
+	Margin    int32         `yaml:"margin"`
+	RoundTo   int32         `yaml:"round_to"`
+	MinCount  int64         `yaml:"min_count"`
+	MinIPs    int           `yaml:"min_ips"`
+}
+
+// LoadRules reads a rule set from a YAML file.
+func LoadRules(path string) (RuleSet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return RuleSet{}, fmt.Errorf("cannot read fraud rules: %w", err)
+	}
+	return ParseRules(data)
+}
+
+// ParseRules decodes and validates a YAML rule set. Unknown keys are
+// rejected so a misspelt parameter can't silently disable a rule.
+func ParseRules(data []byte) (RuleSet, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	var rules RuleSet
+	if err := decoder.Decode(&rules); err != nil && !errors.Is(err, io.EOF) {
+		return RuleSet{}, fmt.Errorf("cannot parse fraud rules: %w", err)
+	}
+
+	if err := rules.validate(); err != nil {
+		return RuleSet{}, err
+	}
+	return rules, nil
+}
+
+func (rules *RuleSet) validate() error {
+	if rules.HoldScore <= 0 {
+		return errors.New("hold_score must be positive")
+	}
+	if rules.BlockScore < rules.HoldScore {
+		return errors.New("block_score must not be lower than hold_score")
+	}
+
+	names := map[string]bool{}
+	for i := range rules.Rules {
+		rule := &rules.Rules[i]
+		if rule.Name == "" {
+			return fmt.Errorf("rule %d has no name", i+1)
+		}
+		if names[rule.Name] {
+			return fmt.Errorf("duplicate rule %q", rule.Name)
+		}
+		names[rule.Name] = true
+
+		if err := rule.validate(); err != nil {
+			return fmt.Errorf("invalid rule %q: %w", rule.Name, err)
+		}
+	}
+	return nil
+}
+
+func (rule *Rule) validate() error {
+	if rule.Score <= 0 {
+		return errors.New("score must be positive")
+	}
+
+	switch rule.Type {
+	case RuleVelocity:
+		if rule.Window <= 0 {
+			return errors.New("window must be positive")
+		}
+		if rule.MaxCount <= 0 && rule.MaxAmount <= 0 {
+			return errors.New("max_count or max_amount is required")
+		}
+	case RuleNewPayee:
+		if rule.MinAmount < 0 {
+			return errors.New("min_amount must not be negative")
+		}
+	case RuleStructuring:
+		if rule.Threshold <= 0 || rule.RoundTo <= 0 {
+			return errors.New("threshold and round_to must be positive")
+		}
+		if rule.Margin <= 0 || rule.Margin > rule.Threshold {
+			return errors.New("margin must be positive and not above threshold")
+		}
+		if rule.MinCount <= 0 {
+			rule.MinCount = 1
+		}
+		if rule.MinCount > 1 && rule.Window <= 0 {
+			return errors.New("window must be positive when min_count is above 1")
+		}
+	case RuleIPChange:
+		if rule.Window <= 0 {
+			return errors.New("window must be positive")
+		}
+		if rule.MinIPs <= 0 {
+			rule.MinIPs = 2
+		}
+	default:
+		return fmt.Errorf("unknown type %q", rule.Type)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package fraud
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadRules(t *testing.T) {
+	rules, err := LoadRules("rules.yaml")
+	require.NoError(t, err)
+	require.Equal(t, int32(50), rules.HoldScore)
+	require.Equal(t, int32(100), rules.BlockScore)
+	require.NotEmpty(t, rules.Rules)
+
+	types := map[string]bool{}
+	for _, rule := range rules.Rules {
+		types[rule.Type] = true
+	}
+	require.Len(t, types, 4)
+
+	_, err = LoadRules("missing.yaml")
+	require.Error(t, err)
+}
+
+func TestParseRules(t *testing.T) {
+	rules, err := ParseRules([]byte(`
+hold_score: 10
+block_score: 20
+rules:
+  - name: burst
+    type: velocity
+    score: 10
+    window: 10m
+    max_count: 3
+  - name: structuring
+    type: structuring
+    score: 10
+    threshold: 1000
+    margin: 100
+    round_to: 50
+  - name: ips
+    type: ip_change
+    score: 5
+    window: 1h
+`))
+	require.NoError(t, err)
+	require.Len(t, rules.Rules, 3)
+	require.Equal(t, 10*time.Minute, rules.Rules[0].Window)
+	require.Equal(t, int64(1), rules.Rules[1].MinCount)
+	require.Equal(t, 2, rules.Rules[2].MinIPs)
+
+	for _, invalid := range []string{
+		"",
+		"hold_score: 10\nblock_score: 5",
+		"hold_score: 10\nblock_score: 20\nunknown: 1",
+		"hold_score: 10\nblock_score: 20\nrules:\n  - {name: a, type: nope, score: 1}",
+		"hold_score: 10\nblock_score: 20\nrules:\n  - {type: new_payee, score: 1}",
+		"hold_score: 10\nblock_score: 20\nrules:\n  - {name: a, type: new_payee, score: 0}",
+		"hold_score: 10\nblock_score: 20\nrules:\n  - {name: a, type: new_payee, score: 1}\n  - {name: a, type: new_payee, score: 1}",
+		"hold_score: 10\nblock_score: 20\nrules:\n  - {name: a, type: velocity, score: 1, window: 1h}",
+		"hold_score: 10\nblock_score: 20\nrules:\n  - {name: a, type: velocity, score: 1, max_count: 1}",
+		"hold_score: 10\nblock_score: 20\nrules:\n  - {name: a, type: structuring, score: 1, threshold: 100, margin: 200, round_to: 10}",
+		"hold_score: 10\nblock_score: 20\nrules:\n  - {name: a, type: structuring, score: 1, threshold: 100, margin: 20, round_to: 10, min_count: 2}",
+		"hold_score: 10\nblock_score: 20\nrules:\n  - {name: a, type: ip_change, score: 1}",
+	} {
+		_, err := ParseRules([]byte(invalid))
+		require.Error(t, err, invalid)
+	}
+}
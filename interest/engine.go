@@ -0,0 +1,165 @@
+package interest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/telemetry"
+	"github.com/valkyraycho/bank_project/worker"
+)
+
+// Engine accrues interest daily on end-of-day balances and pays it out
+// monthly. Every step is idempotent per account and day or period. All days
+// and periods are UTC.
+type Engine struct {
+	store db.Store
+	now   func() time.Time
+}
+
+func NewEngine(store db.Store) *Engine {
+	return &Engine{
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// AccrueDay records one day of interest for every interest-bearing account
+// that was open at the end of the day. Accounts already accrued for the day
+// are skipped. It returns the number of new accruals.
+func (engine *Engine) AccrueDay(ctx context.Context, day time.Time) (int, error) {
+	day = worker.StartOfDay(day)
+	dayEnd := day.AddDate(0, 0, 1)
+	if dayEnd.After(engine.now()) {
+		return 0, fmt.Errorf("cannot accrue %s: %w", day.Format(time.DateOnly), worker.ErrPeriodNotEnded)
+	}
+
+	tiers, err := engine.store.ListInterestTiers(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("cannot list interest tiers: %w", err)
+	}
+	tiersByProduct := groupTiers(tiers)
+
+	accounts, err := engine.store.ListInterestBearingAccounts(ctx, dayEnd)
+	if err != nil {
+		return 0, fmt.Errorf("cannot list interest bearing accounts: %w", err)
+	}
+
+	accrued := 0
+	for _, account := range accounts {
+		balance, err := engine.store.GetEndOfDayBalance(ctx, db.GetEndOfDayBalanceParams{
+			AccountID: account.ID,
+			DayEnd:    dayEnd,
+		})
+		if err != nil {
+			return accrued, fmt.Errorf("cannot compute end of day balance of account %d: %w", account.ID, err)
+		}
+
+		rows, err := engine.store.CreateInterestAccrual(ctx, db.CreateInterestAccrualParams{
+			AccountID:       account.ID,
+			AccrualDate:     day,
+			EndOfDayBalance: balance,
+			AmountMicros:    DailyInterestMicros(balance, tiersByProduct[account.ProductCode]),
+		})
+		if err != nil {
+			return accrued, fmt.Errorf("cannot accrue interest for account %d: %w", account.ID, err)
+		}
+		accrued += int(rows)
+	}
+	return accrued, nil
+}
+
+// PostMonth pays every account the interest accrued up to the end of the
+// month containing period. Accounts that can't receive money right now, such
+// as frozen ones or those of an owner under screening review, are skipped and
+// picked up again by the next run; interest of closed accounts is written
+// off. It returns the number of postings made.
+func (engine *Engine) PostMonth(ctx context.Context, period time.Time) (int, error) {
+	period = worker.StartOfMonth(period)
+	periodEnd := period.AddDate(0, 1, 0)
+	if periodEnd.After(engine.now()) {
+		return 0, fmt.Errorf("cannot post %s: %w", period.Format("2006-01"), worker.ErrPeriodNotEnded)
+	}
+
+	accountIDs, err := engine.store.ListAccountsWithUnpostedInterest(ctx, db.ListAccountsWithUnpostedInterestParams{
+		Period:    period,
+		PeriodEnd: periodEnd,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("cannot list unposted interest: %w", err)
+	}
+
+	posted := 0
+	for _, accountID := range accountIDs {
+		_, err := engine.store.PostInterestTx(ctx, db.PostInterestTxParams{
+			AccountID: accountID,
+			Period:    period,
+		})
+
+		var notActive *db.AccountNotActiveError
+		var screeningErr *db.OwnerScreeningError
+		switch {
+		case err == nil:
+			posted++
+		case errors.Is(err, db.ErrInterestAlreadyPosted):
+		case errors.As(err, &notActive), errors.As(err, &screeningErr):
+			telemetry.Logger(ctx).Warn().Err(err).Int32("account_id", accountID).Msg("skipping interest posting")
+		default:
+			return posted, fmt.Errorf("cannot post interest for account %d: %w", accountID, err)
+		}
+	}
+	return posted, nil
+}
+
+// Backfill accrues every day from from to to inclusive, then posts every
+// month that has ended since from. It is used to recover days missed while
+// the engine wasn't running.
+func (engine *Engine) Backfill(ctx context.Context, from, to time.Time) (accrued int, posted int, err error) {
+	from, to = worker.StartOfDay(from), worker.StartOfDay(to)
+	if to.Before(from) {
+		return 0, 0, fmt.Errorf("backfill range ends before it starts")
+	}
+
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		n, err := engine.AccrueDay(ctx, day)
+		accrued += n
+		if err != nil {
+			return accrued, posted, err
+		}
+	}
+
+	for period := worker.StartOfMonth(from); !period.AddDate(0, 1, 0).After(engine.now()); period = period.AddDate(0, 1, 0) {
+		n, err := engine.PostMonth(ctx, period)
+		posted += n
+		if err != nil {
+			return accrued, posted, err
+		}
+	}
+	return accrued, posted, nil
+}
+
+// RunOnce accrues the previous day and posts the previous month. Repeating
+// it is harmless, so the interval it runs at only bounds how late interest
+// shows up after midnight.
+func (engine *Engine) RunOnce(ctx context.Context) {
+	today := worker.StartOfDay(engine.now())
+	logger := telemetry.Logger(ctx)
+
+	accrued, err := engine.AccrueDay(ctx, today.AddDate(0, 0, -1))
+	if err != nil {
+		logger.Error().Err(err).Msg("interest accrual failed")
+		return
+	}
+
+	posted, err := engine.PostMonth(ctx, worker.StartOfMonth(today).AddDate(0, -1, 0))
+	if err != nil {
+		logger.Error().Err(err).Msg("interest posting failed")
+		return
+	}
+
+	if accrued > 0 || posted > 0 {
+		logger.Info().Int("accrued", accrued).Int("posted", posted).Msg("interest engine run")
+	}
+}
@@ -0,0 +1,145 @@
+package interest
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/worker"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestEngine(t *testing.T, now time.Time) (*Engine, *mockdb.MockStore) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	engine := NewEngine(store)
+	engine.now = func() time.Time { return now }
+	return engine, store
+}
+
+func TestAccrueDay(t *testing.T) {
+	now := time.Date(2024, 3, 2, 8, 0, 0, 0, time.UTC)
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	dayEnd := day.AddDate(0, 0, 1)
+
+	engine, store := newTestEngine(t, now)
+
+	store.EXPECT().
+		ListInterestTiers(gomock.Any()).
+		Return([]db.InterestTier{{ProductCode: "savings", AprBps: 365}}, nil)
+	store.EXPECT().
+		ListInterestBearingAccounts(gomock.Any(), gomock.Eq(dayEnd)).
+		Return([]db.Account{{ID: 1, ProductCode: "savings"}, {ID: 2, ProductCode: "savings"}}, nil)
+
+	store.EXPECT().
+		GetEndOfDayBalance(gomock.Any(), gomock.Eq(db.GetEndOfDayBalanceParams{AccountID: 1, DayEnd: dayEnd})).
+		Return(int32(10000), nil)
+	store.EXPECT().
+		CreateInterestAccrual(gomock.Any(), gomock.Eq(db.CreateInterestAccrualParams{
+			AccountID:       1,
+			AccrualDate:     day,
+			EndOfDayBalance: 10000,
+			AmountMicros:    1_000_000,
+		})).
+		Return(int64(1), nil)
+
+	// Account 2 was already accrued by an earlier run.
+	store.EXPECT().
+		GetEndOfDayBalance(gomock.Any(), gomock.Eq(db.GetEndOfDayBalanceParams{AccountID: 2, DayEnd: dayEnd})).
+		Return(int32(0), nil)
+	store.EXPECT().
+		CreateInterestAccrual(gomock.Any(), gomock.Any()).
+		Return(int64(0), nil)
+
+	accrued, err := engine.AccrueDay(context.Background(), day.Add(13*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 1, accrued)
+
+	_, err = engine.AccrueDay(context.Background(), now)
+	require.ErrorIs(t, err, worker.ErrPeriodNotEnded)
+}
+
+func TestPostMonth(t *testing.T) {
+	now := time.Date(2024, 3, 1, 0, 30, 0, 0, time.UTC)
+	period := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	engine, store := newTestEngine(t, now)
+
+	store.EXPECT().
+		ListAccountsWithUnpostedInterest(gomock.Any(), gomock.Eq(db.ListAccountsWithUnpostedInterestParams{
+			Period:    period,
+			PeriodEnd: now.Truncate(time.Hour),
+		})).
+		Return([]int32{1, 2, 3, 4}, nil)
+
+	store.EXPECT().
+		PostInterestTx(gomock.Any(), gomock.Eq(db.PostInterestTxParams{AccountID: 1, Period: period})).
+		Return(db.PostInterestTxResult{}, nil)
+	store.EXPECT().
+		PostInterestTx(gomock.Any(), gomock.Eq(db.PostInterestTxParams{AccountID: 2, Period: period})).
+		Return(db.PostInterestTxResult{}, &db.AccountNotActiveError{AccountID: 2, Status: db.AccountStatusFrozen})
+	store.EXPECT().
+		PostInterestTx(gomock.Any(), gomock.Eq(db.PostInterestTxParams{AccountID: 3, Period: period})).
+		Return(db.PostInterestTxResult{}, db.ErrInterestAlreadyPosted)
+	store.EXPECT().
+		PostInterestTx(gomock.Any(), gomock.Eq(db.PostInterestTxParams{AccountID: 4, Period: period})).
+		Return(db.PostInterestTxResult{}, &db.OwnerScreeningError{AccountID: 4, Status: db.ScreeningStatusPendingReview})
+
+	posted, err := engine.PostMonth(context.Background(), period.AddDate(0, 0, 10))
+	require.NoError(t, err)
+	require.Equal(t, 1, posted)
+
+	_, err = engine.PostMonth(context.Background(), now)
+	require.ErrorIs(t, err, worker.ErrPeriodNotEnded)
+}
+
+func TestPostMonthStopsOnStoreError(t *testing.T) {
+	now := time.Date(2024, 3, 1, 0, 30, 0, 0, time.UTC)
+	engine, store := newTestEngine(t, now)
+
+	store.EXPECT().
+		ListAccountsWithUnpostedInterest(gomock.Any(), gomock.Any()).
+		Return([]int32{1, 2}, nil)
+	store.EXPECT().
+		PostInterestTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(db.PostInterestTxResult{}, sql.ErrConnDone)
+
+	_, err := engine.PostMonth(context.Background(), time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+	require.ErrorIs(t, err, sql.ErrConnDone)
+}
+
+func TestBackfill(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	engine, store := newTestEngine(t, now)
+
+	from := time.Date(2024, 2, 28, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+
+	store.EXPECT().ListInterestTiers(gomock.Any()).Times(2).Return(nil, nil)
+	store.EXPECT().
+		ListInterestBearingAccounts(gomock.Any(), gomock.Eq(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC))).
+		Return([]db.Account{}, nil)
+	store.EXPECT().
+		ListInterestBearingAccounts(gomock.Any(), gomock.Eq(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))).
+		Return([]db.Account{}, nil)
+
+	// Only February has ended.
+	store.EXPECT().
+		ListAccountsWithUnpostedInterest(gomock.Any(), gomock.Eq(db.ListAccountsWithUnpostedInterestParams{
+			Period:    time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			PeriodEnd: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		})).
+		Return([]int32{}, nil)
+
+	_, _, err := engine.Backfill(context.Background(), from, to)
+	require.NoError(t, err)
+
+	_, _, err = engine.Backfill(context.Background(), to, from)
+	require.Error(t, err)
+}
@@ -0,0 +1,46 @@
+package interest
+
+import (
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+)
+
+const (
+	basisPoints = 10_000
+	daysPerYear = 365
+)
+
+// DailyInterestMicros returns the interest earned by an end-of-day balance
+// over one day, in millionths of the currency minor unit. Tiers are marginal:
+// each one applies its APR only to the part of the balance between its
+// min_balance and the next tier's. The tiers must be sorted by min_balance.
+// Rates are divided over a fixed 365 day year, and negative balances earn
+// nothing.
+func DailyInterestMicros(balance int32, tiers []db.InterestTier) int64 {
+	var micros int64
+
+	for i, tier := range tiers {
+		if balance <= tier.MinBalance {
+			break
+		}
+
+		upper := int64(balance)
+		if i+1 < len(tiers) && int64(tiers[i+1].MinBalance) < upper {
+			upper = int64(tiers[i+1].MinBalance)
+		}
+
+		portion := upper - int64(max(tier.MinBalance, 0))
+		if portion <= 0 {
+			continue
+		}
+		micros += portion * int64(tier.AprBps) * (db.MicrosPerMinorUnit / basisPoints) / daysPerYear
+	}
+	return micros
+}
+
+func groupTiers(tiers []db.InterestTier) map[string][]db.InterestTier {
+	byProduct := map[string][]db.InterestTier{}
+	for _, tier := range tiers {
+		byProduct[tier.ProductCode] = append(byProduct[tier.ProductCode], tier)
+	}
+	return byProduct
+}
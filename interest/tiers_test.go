@@ -0,0 +1,31 @@
+package interest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+)
+
+func TestDailyInterestMicros(t *testing.T) {
+	tiers := []db.InterestTier{
+		{ProductCode: "savings", MinBalance: 0, AprBps: 365},
+		{ProductCode: "savings", MinBalance: 10000, AprBps: 730},
+	}
+
+	// 3.65% of 10000 over one day is exactly 1 minor unit.
+	require.Equal(t, int64(1_000_000), DailyInterestMicros(10000, tiers))
+	// The part above 10000 earns the second tier's rate.
+	require.Equal(t, int64(3_000_000), DailyInterestMicros(20000, tiers))
+	require.Equal(t, int64(100), DailyInterestMicros(1, tiers))
+	require.Zero(t, DailyInterestMicros(0, tiers))
+	require.Zero(t, DailyInterestMicros(-500, tiers))
+	require.Zero(t, DailyInterestMicros(10000, nil))
+
+	// Balances below the first tier earn nothing.
+	require.Zero(t, DailyInterestMicros(999, []db.InterestTier{{MinBalance: 1000, AprBps: 365}}))
+	require.Equal(t, int64(100), DailyInterestMicros(1001, []db.InterestTier{{MinBalance: 1000, AprBps: 365}}))
+
+	// The largest balance doesn't overflow.
+	require.Positive(t, DailyInterestMicros(2147483647, []db.InterestTier{{AprBps: 10000}}))
+}
@@ -0,0 +1,41 @@
+package ledger
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+)
+
+// Report lists every violation of the double-entry invariants.
+type Report struct {
+	// AccountMismatches are accounts whose balance differs from the sum of
+	// their entries.
+	AccountMismatches []db.ListAccountBalanceMismatchesRow
+	// UnbalancedJournals are journals whose entries don't sum to zero in a
+	// currency.
+	UnbalancedJournals []db.ListUnbalancedJournalsRow
+}
+
+func (report Report) Balanced() bool {
+	return len(report.AccountMismatches) == 0 && len(report.UnbalancedJournals) == 0
+}
+
+// Check verifies that every account balance equals the sum of its entries and
+// that every journal is balanced per currency. Together they prove that money
+// only moves between accounts and is never created or lost.
+func Check(ctx context.Context, querier db.Querier) (Report, error) {
+	var report Report
+
+	var err error
+	report.AccountMismatches, err = querier.ListAccountBalanceMismatches(ctx)
+	if err != nil {
+		return report, fmt.Errorf("cannot check account balances: %w", err)
+	}
+
+	report.UnbalancedJournals, err = querier.ListUnbalancedJournals(ctx)
+	if err != nil {
+		return report, fmt.Errorf("cannot check journals: %w", err)
+	}
+	return report, nil
+}
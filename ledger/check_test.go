@@ -0,0 +1,83 @@
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCheck(t *testing.T) {
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, report Report, err error)
+	}{
+		{
+			name: "Balanced",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAccountBalanceMismatches(gomock.Any()).Times(1).Return(nil, nil)
+				store.EXPECT().ListUnbalancedJournals(gomock.Any()).Times(1).Return(nil, nil)
+			},
+			checkResponse: func(t *testing.T, report Report, err error) {
+				require.NoError(t, err)
+				require.True(t, report.Balanced())
+			},
+		},
+		{
+			name: "AccountMismatch",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAccountBalanceMismatches(gomock.Any()).
+					Times(1).
+					Return([]db.ListAccountBalanceMismatchesRow{{AccountID: 1, Currency: "USD", Balance: 100, EntriesTotal: 90}}, nil)
+				store.EXPECT().ListUnbalancedJournals(gomock.Any()).Times(1).Return(nil, nil)
+			},
+			checkResponse: func(t *testing.T, report Report, err error) {
+				require.NoError(t, err)
+				require.False(t, report.Balanced())
+				require.Len(t, report.AccountMismatches, 1)
+			},
+		},
+		{
+			name: "UnbalancedJournal",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAccountBalanceMismatches(gomock.Any()).Times(1).Return(nil, nil)
+				store.EXPECT().
+					ListUnbalancedJournals(gomock.Any()).
+					Times(1).
+					Return([]db.ListUnbalancedJournalsRow{{JournalID: 7, Currency: "EUR", Total: 5}}, nil)
+			},
+			checkResponse: func(t *testing.T, report Report, err error) {
+				require.NoError(t, err)
+				require.False(t, report.Balanced())
+				require.Len(t, report.UnbalancedJournals, 1)
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAccountBalanceMismatches(gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				store.EXPECT().ListUnbalancedJournals(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, report Report, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mockdb.NewMockStore(ctrl)
+		testCase.buildStubs(store)
+
+		report, err := Check(context.Background(), store)
+		testCase.checkResponse(t, report, err)
+	}
+}
@@ -2,47 +2,129 @@ package main
 
 import (
 	"context"
+	"errors"
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
-	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/valkyraycho/bank_project/api"
+	"github.com/valkyraycho/bank_project/approvals"
 	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/fees"
+	"github.com/valkyraycho/bank_project/fraud"
+	"github.com/valkyraycho/bank_project/interest"
+	"github.com/valkyraycho/bank_project/metrics"
 	"github.com/valkyraycho/bank_project/pb"
+	"github.com/valkyraycho/bank_project/sanctions"
+	"github.com/valkyraycho/bank_project/telemetry"
+	"github.com/valkyraycho/bank_project/tlsutil"
 	"github.com/valkyraycho/bank_project/utils"
+	"github.com/valkyraycho/bank_project/worker"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
-	"google.golang.org/protobuf/encoding/protojson"
 )
 
 const migrationURL = "file://db/migration"
 
+const (
+	grpcServerWorker  = "grpc-server"
+	httpGatewayWorker = "http-gateway"
+	adminServerWorker = "admin-server"
+
+	interestEngineWorker = "interest-engine"
+	feeEngineWorker      = "fee-engine"
+	approvalExpiryWorker = "approval-expiry"
+	fraudMonitorWorker   = "fraud-monitor"
+	sanctionsWorker      = "sanctions-rescreen"
+)
+
+var interruptSignals = []os.Signal{
+	os.Interrupt,
+	syscall.SIGTERM,
+	syscall.SIGINT,
+}
+
 func main() {
-	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr}).Hook(telemetry.TracingHook{})
 
 	cfg, err := utils.LoadConfig(".")
 	if err != nil {
 		log.Fatal().Msgf("cannot load environment variables: %s", err)
 	}
 
+	ctx, stop := signal.NotifyContext(context.Background(), interruptSignals...)
+	defer stop()
+
+	shutdownTracer, err := telemetry.InitTracer(ctx, cfg)
+	if err != nil {
+		log.Fatal().Msgf("cannot initialize tracer: %s", err)
+	}
+	defer func() {
+		if err := shutdownTracer(context.Background()); err != nil {
+			log.Error().Err(err).Msg("failed to shutdown tracer")
+		}
+	}()
+
 	runDBMigrations(migrationURL, cfg.DBSource)
 
-	connPool, err := pgxpool.New(context.Background(), cfg.DBSource)
+	migrationVersion, err := latestMigrationVersion(migrationURL)
+	if err != nil {
+		log.Fatal().Msgf("cannot read latest migration version: %s", err)
+	}
+
+	poolConfig, err := pgxpool.ParseConfig(cfg.DBSource)
+	if err != nil {
+		log.Fatal().Msgf("cannot parse db source: %s", err)
+	}
+	poolConfig.ConnConfig.Tracer = telemetry.NewQueryTracer()
+
+	connPool, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
 		log.Fatal().Msgf("cannot connect to db: %s", err)
 	}
 
+	metrics.Registry.MustRegister(metrics.NewPoolCollector(connPool))
+
 	store := db.NewStore(connPool)
-	go runHTTPServer(context.Background(), cfg, store)
-	runGRPCServer(context.Background(), cfg, store)
+	healthChecker := api.NewHealthChecker(store, migrationVersion)
+
+	server, err := api.NewServer(cfg, store)
+	if err != nil {
+		log.Fatal().Msgf("failed to create server: %s", err)
+	}
+
+	waitGroup, ctx := errgroup.WithContext(ctx)
+
+	grpcServer := newGRPCServer(ctx, waitGroup, cfg, server, healthChecker)
+
+	runAdminServer(ctx, waitGroup, cfg, healthChecker)
+	runHTTPServer(ctx, waitGroup, cfg, server, grpcServer, healthChecker)
+	runGRPCServer(ctx, waitGroup, cfg, grpcServer, healthChecker)
+	runInterestEngine(ctx, waitGroup, cfg, store, healthChecker)
+	runFeeEngine(ctx, waitGroup, cfg, store, healthChecker)
+	runApprovalExpirer(ctx, waitGroup, cfg, store, healthChecker)
+	runFraudMonitor(ctx, waitGroup, cfg, store, healthChecker)
+	runSanctionsRescreener(ctx, waitGroup, cfg, store, healthChecker)
+
+	if err := waitGroup.Wait(); err != nil {
+		log.Error().Msgf("error from wait group: %s", err)
+	}
 }
 
 func runDBMigrations(migrationURL, dbsource string) {
@@ -61,47 +143,279 @@ func runDBMigrations(migrationURL, dbsource string) {
 	log.Info().Msg("database migrated successfully")
 }
 
-func runGRPCServer(ctx context.Context, cfg utils.Config, store db.Store) {
-	server, err := api.NewServer(cfg, store)
+func latestMigrationVersion(migrationURL string) (uint, error) {
+	driver, err := source.Open(migrationURL)
 	if err != nil {
-		log.Fatal().Msgf("failed to create server: %s", err)
+		return 0, err
 	}
+	defer driver.Close()
 
-	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(api.GRPCLogger))
+	version, err := driver.First()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		next, err := driver.Next(version)
+		if errors.Is(err, os.ErrNotExist) {
+			return version, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		version = next
+	}
+}
+
+func newGRPCServer(ctx context.Context, waitGroup *errgroup.Group, cfg utils.Config, server *api.Server, healthChecker *api.HealthChecker) *grpc.Server {
+	serverOptions := []grpc.ServerOption{}
+	if cfg.GRPCTLSCertFile != "" {
+		reloader := newTLSReloader(ctx, waitGroup, cfg.GRPCTLSCertFile, cfg.GRPCTLSKeyFile, cfg.GRPCClientCAFile)
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(reloader.ServerConfig(cfg.GRPCRequireClientCert))))
+	}
+
+	grpcServer := grpc.NewServer(append(serverOptions,
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(
+			api.GRPCRequestID,
+			api.GRPCMetrics,
+			api.GRPCLogger,
+			api.GRPCRecovery,
+			server.GRPCServiceIdentity,
+			server.GRPCRateLimit,
+			server.GRPCAudit,
+		),
+		grpc.ChainStreamInterceptor(
+			api.GRPCStreamRequestID,
+			api.GRPCStreamMetrics,
+			api.GRPCStreamLogger,
+			api.GRPCStreamRecovery,
+			server.GRPCStreamServiceIdentity,
+		),
+	)...)
 
 	pb.RegisterBankServiceServer(grpcServer, server)
+	healthpb.RegisterHealthServer(grpcServer, healthChecker.GRPCHealthServer())
 	reflection.Register(grpcServer)
+	return grpcServer
+}
 
+func runGRPCServer(ctx context.Context, waitGroup *errgroup.Group, cfg utils.Config, grpcServer *grpc.Server, healthChecker *api.HealthChecker) {
 	lis, err := net.Listen("tcp", cfg.GRPCServerAddress)
 	if err != nil {
 		log.Fatal().Msgf("failed to listen: %v", err)
 	}
-	log.Info().Msgf("start gRPC server at %s", cfg.GRPCServerAddress)
-	grpcServer.Serve(lis)
+
+	healthChecker.RegisterWorker(grpcServerWorker)
+	waitGroup.Go(func() error {
+		log.Info().Msgf("start gRPC server at %s", cfg.GRPCServerAddress)
+		healthChecker.SetWorkerRunning(grpcServerWorker, true)
+		defer healthChecker.SetWorkerRunning(grpcServerWorker, false)
+
+		if err := grpcServer.Serve(lis); err != nil {
+			if errors.Is(err, grpc.ErrServerStopped) {
+				return nil
+			}
+			log.Error().Err(err).Msg("gRPC server failed to serve")
+			return err
+		}
+		return nil
+	})
+
+	waitGroup.Go(func() error {
+		<-ctx.Done()
+		log.Info().Msg("graceful shutdown gRPC server")
+
+		healthChecker.Shutdown()
+		grpcServer.GracefulStop()
+
+		log.Info().Msg("gRPC server is stopped")
+		return nil
+	})
 }
 
-func runHTTPServer(ctx context.Context, cfg utils.Config, store db.Store) {
-	server, err := api.NewServer(cfg, store)
+func runHTTPServer(ctx context.Context, waitGroup *errgroup.Group, cfg utils.Config, server *api.Server, grpcServer *grpc.Server, healthChecker *api.HealthChecker) {
+	grpcMux, err := server.NewGatewayMux(ctx)
 	if err != nil {
-		log.Fatal().Msgf("failed to create server: %s", err)
+		log.Fatal().Msgf("failed to create gateway: %s", err)
 	}
 
-	mux := runtime.NewServeMux(
-		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
-			MarshalOptions: protojson.MarshalOptions{
-				EmitUnpopulated: true,
-				UseProtoNames:   true,
-			},
-			UnmarshalOptions: protojson.UnmarshalOptions{
-				DiscardUnknown: true,
-			},
-		}),
-	)
+	mux := http.NewServeMux()
+	mux.Handle("/", api.GRPCWebHandler(grpcServer, grpcMux))
+	mux.Handle("GET /v1/ws/{method}", server.WebSocketBridge())
+	mux.Handle("GET /swagger/", api.SwaggerHandler())
+	mux.HandleFunc("GET /healthz", healthChecker.LivenessHandler)
+	mux.HandleFunc("GET /readyz", healthChecker.ReadinessHandler)
 
-	if err := pb.RegisterBankServiceHandlerServer(ctx, mux, server); err != nil {
-		log.Fatal().Msg("failed to register http handler server")
+	httpServer := &http.Server{
+		Addr:    cfg.HTTPServerAddress,
+		Handler: otelhttp.NewHandler(api.HTTPRequestID(api.HTTPLogger(api.HTTPRecovery(server.HTTPSecurityHeaders(server.HTTPCORS(mux))))), "http-gateway",
+			otelhttp.WithFilter(func(r *http.Request) bool {
+				return r.URL.Path != "/healthz" && r.URL.Path != "/readyz"
+			}),
+		),
 	}
 
-	log.Info().Msgf("start http server at %s", cfg.HTTPServerAddress)
-	http.ListenAndServe(cfg.HTTPServerAddress, api.HTTPLogger(mux))
+	if cfg.HTTPTLSCertFile != "" {
+		reloader := newTLSReloader(ctx, waitGroup, cfg.HTTPTLSCertFile, cfg.HTTPTLSKeyFile, "")
+		httpServer.TLSConfig = reloader.ServerConfig(false)
+	}
+
+	healthChecker.RegisterWorker(httpGatewayWorker)
+	waitGroup.Go(func() error {
+		log.Info().Msgf("start http server at %s", cfg.HTTPServerAddress)
+		healthChecker.SetWorkerRunning(httpGatewayWorker, true)
+		defer healthChecker.SetWorkerRunning(httpGatewayWorker, false)
+
+		serve := httpServer.ListenAndServe
+		if httpServer.TLSConfig != nil {
+			serve = func() error { return httpServer.ListenAndServeTLS("", "") }
+		}
+
+		if err := serve(); err != nil {
+			if errors.Is(err, http.ErrServerClosed) {
+				return nil
+			}
+			log.Error().Err(err).Msg("http server failed to serve")
+			return err
+		}
+		return nil
+	})
+
+	waitGroup.Go(func() error {
+		<-ctx.Done()
+		log.Info().Msg("graceful shutdown http server")
+
+		healthChecker.Shutdown()
+		if err := httpServer.Shutdown(context.Background()); err != nil {
+			log.Error().Err(err).Msg("failed to shutdown http server")
+			return err
+		}
+
+		log.Info().Msg("http server is stopped")
+		return nil
+	})
+}
+
+func newTLSReloader(ctx context.Context, waitGroup *errgroup.Group, certFile, keyFile, clientCAFile string) *tlsutil.Reloader {
+	reloader, err := tlsutil.NewReloader(certFile, keyFile, clientCAFile)
+	if err != nil {
+		log.Fatal().Msgf("cannot load TLS certificate: %s", err)
+	}
+
+	waitGroup.Go(func() error {
+		return reloader.Watch(ctx)
+	})
+	return reloader
+}
+
+func runInterestEngine(ctx context.Context, waitGroup *errgroup.Group, cfg utils.Config, store db.Store, healthChecker *api.HealthChecker) {
+	if cfg.InterestEngineInterval <= 0 {
+		log.Info().Msg("interest engine is disabled")
+		return
+	}
+
+	engine := interest.NewEngine(store)
+	runWorker(ctx, waitGroup, healthChecker, interestEngineWorker, cfg.InterestEngineInterval, engine.RunOnce)
+}
+
+func runFeeEngine(ctx context.Context, waitGroup *errgroup.Group, cfg utils.Config, store db.Store, healthChecker *api.HealthChecker) {
+	if cfg.FeeEngineInterval <= 0 {
+		log.Info().Msg("fee engine is disabled")
+		return
+	}
+
+	engine := fees.NewEngine(store)
+	runWorker(ctx, waitGroup, healthChecker, feeEngineWorker, cfg.FeeEngineInterval, engine.RunOnce)
+}
+
+func runApprovalExpirer(ctx context.Context, waitGroup *errgroup.Group, cfg utils.Config, store db.Store, healthChecker *api.HealthChecker) {
+	if cfg.ApprovalExpiryInterval <= 0 {
+		log.Info().Msg("approval expirer is disabled")
+		return
+	}
+
+	expirer := approvals.NewExpirer(store)
+	runWorker(ctx, waitGroup, healthChecker, approvalExpiryWorker, cfg.ApprovalExpiryInterval, expirer.RunOnce)
+}
+
+func runFraudMonitor(ctx context.Context, waitGroup *errgroup.Group, cfg utils.Config, store db.Store, healthChecker *api.HealthChecker) {
+	if cfg.FraudRulesFile == "" || cfg.FraudMonitorInterval <= 0 {
+		log.Info().Msg("fraud monitor is disabled")
+		return
+	}
+
+	rules, err := fraud.LoadRules(cfg.FraudRulesFile)
+	if err != nil {
+		log.Fatal().Msgf("failed to load fraud rules: %s", err)
+	}
+	monitor := fraud.NewMonitor(store, fraud.NewEngine(rules))
+	runWorker(ctx, waitGroup, healthChecker, fraudMonitorWorker, cfg.FraudMonitorInterval, monitor.RunOnce)
+}
+
+func runSanctionsRescreener(ctx context.Context, waitGroup *errgroup.Group, cfg utils.Config, store db.Store, healthChecker *api.HealthChecker) {
+	if cfg.SanctionsListFile == "" || cfg.SanctionsRescreenInterval <= 0 {
+		log.Info().Msg("sanctions rescreener is disabled")
+		return
+	}
+
+	screener, err := sanctions.LoadScreener(cfg.SanctionsListFile, cfg.SanctionsMatchThreshold)
+	if err != nil {
+		log.Fatal().Msgf("failed to load sanctions list: %s", err)
+	}
+	rescreener := sanctions.NewRescreener(store, screener)
+	runWorker(ctx, waitGroup, healthChecker, sanctionsWorker, cfg.SanctionsRescreenInterval, rescreener.RunOnce)
+}
+
+// runWorker runs job every interval in the background and reports it to the
+// health checker as the named worker.
+func runWorker(ctx context.Context, waitGroup *errgroup.Group, healthChecker *api.HealthChecker, name string, interval time.Duration, job func(context.Context)) {
+	healthChecker.RegisterWorker(name)
+	waitGroup.Go(func() error {
+		log.Info().Msgf("start %s worker every %s", name, interval)
+		healthChecker.SetWorkerRunning(name, true)
+		defer healthChecker.SetWorkerRunning(name, false)
+
+		err := worker.Run(ctx, interval, job)
+		log.Info().Msgf("%s worker is stopped", name)
+		return err
+	})
+}
+
+func runAdminServer(ctx context.Context, waitGroup *errgroup.Group, cfg utils.Config, healthChecker *api.HealthChecker) {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", metrics.Handler())
+
+	adminServer := &http.Server{
+		Addr:    cfg.AdminServerAddress,
+		Handler: mux,
+	}
+
+	healthChecker.RegisterWorker(adminServerWorker)
+	waitGroup.Go(func() error {
+		log.Info().Msgf("start admin server at %s", cfg.AdminServerAddress)
+		healthChecker.SetWorkerRunning(adminServerWorker, true)
+		defer healthChecker.SetWorkerRunning(adminServerWorker, false)
+
+		if err := adminServer.ListenAndServe(); err != nil {
+			if errors.Is(err, http.ErrServerClosed) {
+				return nil
+			}
+			log.Error().Err(err).Msg("admin server failed to serve")
+			return err
+		}
+		return nil
+	})
+
+	waitGroup.Go(func() error {
+		<-ctx.Done()
+		log.Info().Msg("graceful shutdown admin server")
+
+		if err := adminServer.Shutdown(context.Background()); err != nil {
+			log.Error().Err(err).Msg("failed to shutdown admin server")
+			return err
+		}
+
+		log.Info().Msg("admin server is stopped")
+		return nil
+	})
 }
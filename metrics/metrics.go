@@ -0,0 +1,103 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "bank"
+
+var Registry = prometheus.NewRegistry()
+
+var (
+	GRPCRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of gRPC requests handled by the server.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	GRPCRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "Number of gRPC requests handled by the server, by status code.",
+	}, []string{"method", "code"})
+
+	HTTPRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of HTTP gateway requests handled by the server.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	HTTPRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Number of HTTP gateway requests handled by the server, by status code.",
+	}, []string{"method", "route", "status"})
+
+	GRPCStreamMessages = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "stream_messages_total",
+		Help:      "Number of messages sent and received on gRPC streams.",
+	}, []string{"method", "direction"})
+
+	PanicsRecovered = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "panics_recovered_total",
+		Help:      "Number of handler panics converted into internal errors.",
+	}, []string{"protocol"})
+
+	TransfersCreated = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "transfers_created_total",
+		Help:      "Number of transfers committed.",
+	}, []string{"currency"})
+
+	TransferVolume = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "transfer_volume_total",
+		Help:      "Sum of committed transfer amounts in minor units.",
+	}, []string{"currency"})
+
+	FailedLogins = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "failed_logins_total",
+		Help:      "Number of rejected login attempts.",
+	}, []string{"reason"})
+
+	AuditLogWriteFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "audit_log_write_failures_total",
+		Help:      "Number of audited calls whose audit log entry could not be written.",
+	}, []string{"method"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		GRPCRequestDuration,
+		GRPCRequestsTotal,
+		HTTPRequestDuration,
+		HTTPRequestsTotal,
+		GRPCStreamMessages,
+		PanicsRecovered,
+		TransfersCreated,
+		TransferVolume,
+		FailedLogins,
+		AuditLogWriteFailures,
+	)
+}
+
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
@@ -19,13 +19,44 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BankService_CreateUser_FullMethodName     = "/pb.BankService/CreateUser"
-	BankService_UpdateUser_FullMethodName     = "/pb.BankService/UpdateUser"
-	BankService_LoginUser_FullMethodName      = "/pb.BankService/LoginUser"
-	BankService_CreateAccount_FullMethodName  = "/pb.BankService/CreateAccount"
-	BankService_GetAccount_FullMethodName     = "/pb.BankService/GetAccount"
-	BankService_GetAccounts_FullMethodName    = "/pb.BankService/GetAccounts"
-	BankService_CreateTransfer_FullMethodName = "/pb.BankService/CreateTransfer"
+	BankService_CreateUser_FullMethodName            = "/pb.BankService/CreateUser"
+	BankService_UpdateUser_FullMethodName            = "/pb.BankService/UpdateUser"
+	BankService_ChangeUserRole_FullMethodName        = "/pb.BankService/ChangeUserRole"
+	BankService_LoginUser_FullMethodName             = "/pb.BankService/LoginUser"
+	BankService_RenewAccessToken_FullMethodName      = "/pb.BankService/RenewAccessToken"
+	BankService_ListAccountProducts_FullMethodName   = "/pb.BankService/ListAccountProducts"
+	BankService_CreateAccount_FullMethodName         = "/pb.BankService/CreateAccount"
+	BankService_GetAccount_FullMethodName            = "/pb.BankService/GetAccount"
+	BankService_GetAccounts_FullMethodName           = "/pb.BankService/GetAccounts"
+	BankService_FreezeAccount_FullMethodName         = "/pb.BankService/FreezeAccount"
+	BankService_UnfreezeAccount_FullMethodName       = "/pb.BankService/UnfreezeAccount"
+	BankService_CloseAccount_FullMethodName          = "/pb.BankService/CloseAccount"
+	BankService_SearchAccounts_FullMethodName        = "/pb.BankService/SearchAccounts"
+	BankService_SearchUsers_FullMethodName           = "/pb.BankService/SearchUsers"
+	BankService_CreateTransfer_FullMethodName        = "/pb.BankService/CreateTransfer"
+	BankService_ListAccountHistory_FullMethodName    = "/pb.BankService/ListAccountHistory"
+	BankService_UpdateEntryCategory_FullMethodName   = "/pb.BankService/UpdateEntryCategory"
+	BankService_CreateCategoryRule_FullMethodName    = "/pb.BankService/CreateCategoryRule"
+	BankService_ListCategoryRules_FullMethodName     = "/pb.BankService/ListCategoryRules"
+	BankService_DeleteCategoryRule_FullMethodName    = "/pb.BankService/DeleteCategoryRule"
+	BankService_GetTransferLimits_FullMethodName     = "/pb.BankService/GetTransferLimits"
+	BankService_LowerTransferLimits_FullMethodName   = "/pb.BankService/LowerTransferLimits"
+	BankService_RaiseTransferLimits_FullMethodName   = "/pb.BankService/RaiseTransferLimits"
+	BankService_Deposit_FullMethodName               = "/pb.BankService/Deposit"
+	BankService_Withdraw_FullMethodName              = "/pb.BankService/Withdraw"
+	BankService_PreviewTransferFee_FullMethodName    = "/pb.BankService/PreviewTransferFee"
+	BankService_ListApprovalRequests_FullMethodName  = "/pb.BankService/ListApprovalRequests"
+	BankService_GetApprovalRequest_FullMethodName    = "/pb.BankService/GetApprovalRequest"
+	BankService_ApproveAction_FullMethodName         = "/pb.BankService/ApproveAction"
+	BankService_RejectAction_FullMethodName          = "/pb.BankService/RejectAction"
+	BankService_ListAuditLog_FullMethodName          = "/pb.BankService/ListAuditLog"
+	BankService_ListFraudAlerts_FullMethodName       = "/pb.BankService/ListFraudAlerts"
+	BankService_GetFraudAlert_FullMethodName         = "/pb.BankService/GetFraudAlert"
+	BankService_DismissFraudAlert_FullMethodName     = "/pb.BankService/DismissFraudAlert"
+	BankService_ConfirmFraudAlert_FullMethodName     = "/pb.BankService/ConfirmFraudAlert"
+	BankService_ListScreeningMatches_FullMethodName  = "/pb.BankService/ListScreeningMatches"
+	BankService_ClearScreeningReview_FullMethodName  = "/pb.BankService/ClearScreeningReview"
+	BankService_ConfirmScreeningMatch_FullMethodName = "/pb.BankService/ConfirmScreeningMatch"
 )
 
 // BankServiceClient is the client API for BankService service.
@@ -34,11 +65,42 @@ const (
 type BankServiceClient interface {
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
+	ChangeUserRole(ctx context.Context, in *ChangeUserRoleRequest, opts ...grpc.CallOption) (*ChangeUserRoleResponse, error)
 	LoginUser(ctx context.Context, in *LoginUserRequest, opts ...grpc.CallOption) (*LoginUserResponse, error)
+	RenewAccessToken(ctx context.Context, in *RenewAccessTokenRequest, opts ...grpc.CallOption) (*RenewAccessTokenResponse, error)
+	ListAccountProducts(ctx context.Context, in *ListAccountProductsRequest, opts ...grpc.CallOption) (*ListAccountProductsResponse, error)
 	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
 	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error)
 	GetAccounts(ctx context.Context, in *GetAccountsRequest, opts ...grpc.CallOption) (*GetAccountsResponse, error)
+	FreezeAccount(ctx context.Context, in *FreezeAccountRequest, opts ...grpc.CallOption) (*FreezeAccountResponse, error)
+	UnfreezeAccount(ctx context.Context, in *UnfreezeAccountRequest, opts ...grpc.CallOption) (*UnfreezeAccountResponse, error)
+	CloseAccount(ctx context.Context, in *CloseAccountRequest, opts ...grpc.CallOption) (*CloseAccountResponse, error)
+	SearchAccounts(ctx context.Context, in *SearchAccountsRequest, opts ...grpc.CallOption) (*SearchAccountsResponse, error)
+	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
 	CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*CreateTransferResponse, error)
+	ListAccountHistory(ctx context.Context, in *ListAccountHistoryRequest, opts ...grpc.CallOption) (*ListAccountHistoryResponse, error)
+	UpdateEntryCategory(ctx context.Context, in *UpdateEntryCategoryRequest, opts ...grpc.CallOption) (*UpdateEntryCategoryResponse, error)
+	CreateCategoryRule(ctx context.Context, in *CreateCategoryRuleRequest, opts ...grpc.CallOption) (*CreateCategoryRuleResponse, error)
+	ListCategoryRules(ctx context.Context, in *ListCategoryRulesRequest, opts ...grpc.CallOption) (*ListCategoryRulesResponse, error)
+	DeleteCategoryRule(ctx context.Context, in *DeleteCategoryRuleRequest, opts ...grpc.CallOption) (*DeleteCategoryRuleResponse, error)
+	GetTransferLimits(ctx context.Context, in *GetTransferLimitsRequest, opts ...grpc.CallOption) (*GetTransferLimitsResponse, error)
+	LowerTransferLimits(ctx context.Context, in *LowerTransferLimitsRequest, opts ...grpc.CallOption) (*LowerTransferLimitsResponse, error)
+	RaiseTransferLimits(ctx context.Context, in *RaiseTransferLimitsRequest, opts ...grpc.CallOption) (*RaiseTransferLimitsResponse, error)
+	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositResponse, error)
+	Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
+	PreviewTransferFee(ctx context.Context, in *PreviewTransferFeeRequest, opts ...grpc.CallOption) (*PreviewTransferFeeResponse, error)
+	ListApprovalRequests(ctx context.Context, in *ListApprovalRequestsRequest, opts ...grpc.CallOption) (*ListApprovalRequestsResponse, error)
+	GetApprovalRequest(ctx context.Context, in *GetApprovalRequestRequest, opts ...grpc.CallOption) (*GetApprovalRequestResponse, error)
+	ApproveAction(ctx context.Context, in *ApproveActionRequest, opts ...grpc.CallOption) (*ApproveActionResponse, error)
+	RejectAction(ctx context.Context, in *RejectActionRequest, opts ...grpc.CallOption) (*RejectActionResponse, error)
+	ListAuditLog(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*ListAuditLogResponse, error)
+	ListFraudAlerts(ctx context.Context, in *ListFraudAlertsRequest, opts ...grpc.CallOption) (*ListFraudAlertsResponse, error)
+	GetFraudAlert(ctx context.Context, in *GetFraudAlertRequest, opts ...grpc.CallOption) (*GetFraudAlertResponse, error)
+	DismissFraudAlert(ctx context.Context, in *DismissFraudAlertRequest, opts ...grpc.CallOption) (*DismissFraudAlertResponse, error)
+	ConfirmFraudAlert(ctx context.Context, in *ConfirmFraudAlertRequest, opts ...grpc.CallOption) (*ConfirmFraudAlertResponse, error)
+	ListScreeningMatches(ctx context.Context, in *ListScreeningMatchesRequest, opts ...grpc.CallOption) (*ListScreeningMatchesResponse, error)
+	ClearScreeningReview(ctx context.Context, in *ClearScreeningReviewRequest, opts ...grpc.CallOption) (*ClearScreeningReviewResponse, error)
+	ConfirmScreeningMatch(ctx context.Context, in *ConfirmScreeningMatchRequest, opts ...grpc.CallOption) (*ConfirmScreeningMatchResponse, error)
 }
 
 type bankServiceClient struct {
@@ -69,6 +131,16 @@ func (c *bankServiceClient) UpdateUser(ctx context.Context, in *UpdateUserReques
 	return out, nil
 }
 
+func (c *bankServiceClient) ChangeUserRole(ctx context.Context, in *ChangeUserRoleRequest, opts ...grpc.CallOption) (*ChangeUserRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeUserRoleResponse)
+	err := c.cc.Invoke(ctx, BankService_ChangeUserRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bankServiceClient) LoginUser(ctx context.Context, in *LoginUserRequest, opts ...grpc.CallOption) (*LoginUserResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LoginUserResponse)
@@ -79,6 +151,26 @@ func (c *bankServiceClient) LoginUser(ctx context.Context, in *LoginUserRequest,
 	return out, nil
 }
 
+func (c *bankServiceClient) RenewAccessToken(ctx context.Context, in *RenewAccessTokenRequest, opts ...grpc.CallOption) (*RenewAccessTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenewAccessTokenResponse)
+	err := c.cc.Invoke(ctx, BankService_RenewAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) ListAccountProducts(ctx context.Context, in *ListAccountProductsRequest, opts ...grpc.CallOption) (*ListAccountProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountProductsResponse)
+	err := c.cc.Invoke(ctx, BankService_ListAccountProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bankServiceClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateAccountResponse)
@@ -109,6 +201,56 @@ func (c *bankServiceClient) GetAccounts(ctx context.Context, in *GetAccountsRequ
 	return out, nil
 }
 
+func (c *bankServiceClient) FreezeAccount(ctx context.Context, in *FreezeAccountRequest, opts ...grpc.CallOption) (*FreezeAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FreezeAccountResponse)
+	err := c.cc.Invoke(ctx, BankService_FreezeAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) UnfreezeAccount(ctx context.Context, in *UnfreezeAccountRequest, opts ...grpc.CallOption) (*UnfreezeAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnfreezeAccountResponse)
+	err := c.cc.Invoke(ctx, BankService_UnfreezeAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) CloseAccount(ctx context.Context, in *CloseAccountRequest, opts ...grpc.CallOption) (*CloseAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseAccountResponse)
+	err := c.cc.Invoke(ctx, BankService_CloseAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) SearchAccounts(ctx context.Context, in *SearchAccountsRequest, opts ...grpc.CallOption) (*SearchAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchAccountsResponse)
+	err := c.cc.Invoke(ctx, BankService_SearchAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchUsersResponse)
+	err := c.cc.Invoke(ctx, BankService_SearchUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bankServiceClient) CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*CreateTransferResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateTransferResponse)
@@ -119,17 +261,278 @@ func (c *bankServiceClient) CreateTransfer(ctx context.Context, in *CreateTransf
 	return out, nil
 }
 
+func (c *bankServiceClient) ListAccountHistory(ctx context.Context, in *ListAccountHistoryRequest, opts ...grpc.CallOption) (*ListAccountHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountHistoryResponse)
+	err := c.cc.Invoke(ctx, BankService_ListAccountHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) UpdateEntryCategory(ctx context.Context, in *UpdateEntryCategoryRequest, opts ...grpc.CallOption) (*UpdateEntryCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateEntryCategoryResponse)
+	err := c.cc.Invoke(ctx, BankService_UpdateEntryCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) CreateCategoryRule(ctx context.Context, in *CreateCategoryRuleRequest, opts ...grpc.CallOption) (*CreateCategoryRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCategoryRuleResponse)
+	err := c.cc.Invoke(ctx, BankService_CreateCategoryRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) ListCategoryRules(ctx context.Context, in *ListCategoryRulesRequest, opts ...grpc.CallOption) (*ListCategoryRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoryRulesResponse)
+	err := c.cc.Invoke(ctx, BankService_ListCategoryRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) DeleteCategoryRule(ctx context.Context, in *DeleteCategoryRuleRequest, opts ...grpc.CallOption) (*DeleteCategoryRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCategoryRuleResponse)
+	err := c.cc.Invoke(ctx, BankService_DeleteCategoryRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) GetTransferLimits(ctx context.Context, in *GetTransferLimitsRequest, opts ...grpc.CallOption) (*GetTransferLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransferLimitsResponse)
+	err := c.cc.Invoke(ctx, BankService_GetTransferLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) LowerTransferLimits(ctx context.Context, in *LowerTransferLimitsRequest, opts ...grpc.CallOption) (*LowerTransferLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LowerTransferLimitsResponse)
+	err := c.cc.Invoke(ctx, BankService_LowerTransferLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) RaiseTransferLimits(ctx context.Context, in *RaiseTransferLimitsRequest, opts ...grpc.CallOption) (*RaiseTransferLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RaiseTransferLimitsResponse)
+	err := c.cc.Invoke(ctx, BankService_RaiseTransferLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DepositResponse)
+	err := c.cc.Invoke(ctx, BankService_Deposit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WithdrawResponse)
+	err := c.cc.Invoke(ctx, BankService_Withdraw_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) PreviewTransferFee(ctx context.Context, in *PreviewTransferFeeRequest, opts ...grpc.CallOption) (*PreviewTransferFeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewTransferFeeResponse)
+	err := c.cc.Invoke(ctx, BankService_PreviewTransferFee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) ListApprovalRequests(ctx context.Context, in *ListApprovalRequestsRequest, opts ...grpc.CallOption) (*ListApprovalRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApprovalRequestsResponse)
+	err := c.cc.Invoke(ctx, BankService_ListApprovalRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) GetApprovalRequest(ctx context.Context, in *GetApprovalRequestRequest, opts ...grpc.CallOption) (*GetApprovalRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetApprovalRequestResponse)
+	err := c.cc.Invoke(ctx, BankService_GetApprovalRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) ApproveAction(ctx context.Context, in *ApproveActionRequest, opts ...grpc.CallOption) (*ApproveActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveActionResponse)
+	err := c.cc.Invoke(ctx, BankService_ApproveAction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) RejectAction(ctx context.Context, in *RejectActionRequest, opts ...grpc.CallOption) (*RejectActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectActionResponse)
+	err := c.cc.Invoke(ctx, BankService_RejectAction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) ListAuditLog(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*ListAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditLogResponse)
+	err := c.cc.Invoke(ctx, BankService_ListAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) ListFraudAlerts(ctx context.Context, in *ListFraudAlertsRequest, opts ...grpc.CallOption) (*ListFraudAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFraudAlertsResponse)
+	err := c.cc.Invoke(ctx, BankService_ListFraudAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) GetFraudAlert(ctx context.Context, in *GetFraudAlertRequest, opts ...grpc.CallOption) (*GetFraudAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFraudAlertResponse)
+	err := c.cc.Invoke(ctx, BankService_GetFraudAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) DismissFraudAlert(ctx context.Context, in *DismissFraudAlertRequest, opts ...grpc.CallOption) (*DismissFraudAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DismissFraudAlertResponse)
+	err := c.cc.Invoke(ctx, BankService_DismissFraudAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) ConfirmFraudAlert(ctx context.Context, in *ConfirmFraudAlertRequest, opts ...grpc.CallOption) (*ConfirmFraudAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmFraudAlertResponse)
+	err := c.cc.Invoke(ctx, BankService_ConfirmFraudAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) ListScreeningMatches(ctx context.Context, in *ListScreeningMatchesRequest, opts ...grpc.CallOption) (*ListScreeningMatchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScreeningMatchesResponse)
+	err := c.cc.Invoke(ctx, BankService_ListScreeningMatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) ClearScreeningReview(ctx context.Context, in *ClearScreeningReviewRequest, opts ...grpc.CallOption) (*ClearScreeningReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearScreeningReviewResponse)
+	err := c.cc.Invoke(ctx, BankService_ClearScreeningReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) ConfirmScreeningMatch(ctx context.Context, in *ConfirmScreeningMatchRequest, opts ...grpc.CallOption) (*ConfirmScreeningMatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmScreeningMatchResponse)
+	err := c.cc.Invoke(ctx, BankService_ConfirmScreeningMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BankServiceServer is the server API for BankService service.
 // All implementations must embed UnimplementedBankServiceServer
 // for forward compatibility.
 type BankServiceServer interface {
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
+	ChangeUserRole(context.Context, *ChangeUserRoleRequest) (*ChangeUserRoleResponse, error)
 	LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error)
+	RenewAccessToken(context.Context, *RenewAccessTokenRequest) (*RenewAccessTokenResponse, error)
+	ListAccountProducts(context.Context, *ListAccountProductsRequest) (*ListAccountProductsResponse, error)
 	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
 	GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error)
 	GetAccounts(context.Context, *GetAccountsRequest) (*GetAccountsResponse, error)
+	FreezeAccount(context.Context, *FreezeAccountRequest) (*FreezeAccountResponse, error)
+	UnfreezeAccount(context.Context, *UnfreezeAccountRequest) (*UnfreezeAccountResponse, error)
+	CloseAccount(context.Context, *CloseAccountRequest) (*CloseAccountResponse, error)
+	SearchAccounts(context.Context, *SearchAccountsRequest) (*SearchAccountsResponse, error)
+	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
 	CreateTransfer(context.Context, *CreateTransferRequest) (*CreateTransferResponse, error)
+	ListAccountHistory(context.Context, *ListAccountHistoryRequest) (*ListAccountHistoryResponse, error)
+	UpdateEntryCategory(context.Context, *UpdateEntryCategoryRequest) (*UpdateEntryCategoryResponse, error)
+	CreateCategoryRule(context.Context, *CreateCategoryRuleRequest) (*CreateCategoryRuleResponse, error)
+	ListCategoryRules(context.Context, *ListCategoryRulesRequest) (*ListCategoryRulesResponse, error)
+	DeleteCategoryRule(context.Context, *DeleteCategoryRuleRequest) (*DeleteCategoryRuleResponse, error)
+	GetTransferLimits(context.Context, *GetTransferLimitsRequest) (*GetTransferLimitsResponse, error)
+	LowerTransferLimits(context.Context, *LowerTransferLimitsRequest) (*LowerTransferLimitsResponse, error)
+	RaiseTransferLimits(context.Context, *RaiseTransferLimitsRequest) (*RaiseTransferLimitsResponse, error)
+	Deposit(context.Context, *DepositRequest) (*DepositResponse, error)
+	Withdraw(context.Context, *WithdrawRequest) (*WithdrawResponse, error)
+	PreviewTransferFee(context.Context, *PreviewTransferFeeRequest) (*PreviewTransferFeeResponse, error)
+	ListApprovalRequests(context.Context, *ListApprovalRequestsRequest) (*ListApprovalRequestsResponse, error)
+	GetApprovalRequest(context.Context, *GetApprovalRequestRequest) (*GetApprovalRequestResponse, error)
+	ApproveAction(context.Context, *ApproveActionRequest) (*ApproveActionResponse, error)
+	RejectAction(context.Context, *RejectActionRequest) (*RejectActionResponse, error)
+	ListAuditLog(context.Context, *ListAuditLogRequest) (*ListAuditLogResponse, error)
+	ListFraudAlerts(context.Context, *ListFraudAlertsRequest) (*ListFraudAlertsResponse, error)
+	GetFraudAlert(context.Context, *GetFraudAlertRequest) (*GetFraudAlertResponse, error)
+	DismissFraudAlert(context.Context, *DismissFraudAlertRequest) (*DismissFraudAlertResponse, error)
+	ConfirmFraudAlert(context.Context, *ConfirmFraudAlertRequest) (*ConfirmFraudAlertResponse, error)
+	ListScreeningMatches(context.Context, *ListScreeningMatchesRequest) (*ListScreeningMatchesResponse, error)
+	ClearScreeningReview(context.Context, *ClearScreeningReviewRequest) (*ClearScreeningReviewResponse, error)
+	ConfirmScreeningMatch(context.Context, *ConfirmScreeningMatchRequest) (*ConfirmScreeningMatchResponse, error)
 	mustEmbedUnimplementedBankServiceServer()
 }
 
@@ -146,9 +549,18 @@ func (UnimplementedBankServiceServer) CreateUser(context.Context, *CreateUserReq
 func (UnimplementedBankServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
 }
+func (UnimplementedBankServiceServer) ChangeUserRole(context.Context, *ChangeUserRoleRequest) (*ChangeUserRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeUserRole not implemented")
+}
 func (UnimplementedBankServiceServer) LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LoginUser not implemented")
 }
+func (UnimplementedBankServiceServer) RenewAccessToken(context.Context, *RenewAccessTokenRequest) (*RenewAccessTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewAccessToken not implemented")
+}
+func (UnimplementedBankServiceServer) ListAccountProducts(context.Context, *ListAccountProductsRequest) (*ListAccountProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccountProducts not implemented")
+}
 func (UnimplementedBankServiceServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
 }
@@ -158,9 +570,93 @@ func (UnimplementedBankServiceServer) GetAccount(context.Context, *GetAccountReq
 func (UnimplementedBankServiceServer) GetAccounts(context.Context, *GetAccountsRequest) (*GetAccountsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccounts not implemented")
 }
+func (UnimplementedBankServiceServer) FreezeAccount(context.Context, *FreezeAccountRequest) (*FreezeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeAccount not implemented")
+}
+func (UnimplementedBankServiceServer) UnfreezeAccount(context.Context, *UnfreezeAccountRequest) (*UnfreezeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeAccount not implemented")
+}
+func (UnimplementedBankServiceServer) CloseAccount(context.Context, *CloseAccountRequest) (*CloseAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseAccount not implemented")
+}
+func (UnimplementedBankServiceServer) SearchAccounts(context.Context, *SearchAccountsRequest) (*SearchAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAccounts not implemented")
+}
+func (UnimplementedBankServiceServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
+}
 func (UnimplementedBankServiceServer) CreateTransfer(context.Context, *CreateTransferRequest) (*CreateTransferResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTransfer not implemented")
 }
+func (UnimplementedBankServiceServer) ListAccountHistory(context.Context, *ListAccountHistoryRequest) (*ListAccountHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccountHistory not implemented")
+}
+func (UnimplementedBankServiceServer) UpdateEntryCategory(context.Context, *UpdateEntryCategoryRequest) (*UpdateEntryCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEntryCategory not implemented")
+}
+func (UnimplementedBankServiceServer) CreateCategoryRule(context.Context, *CreateCategoryRuleRequest) (*CreateCategoryRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategoryRule not implemented")
+}
+func (UnimplementedBankServiceServer) ListCategoryRules(context.Context, *ListCategoryRulesRequest) (*ListCategoryRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategoryRules not implemented")
+}
+func (UnimplementedBankServiceServer) DeleteCategoryRule(context.Context, *DeleteCategoryRuleRequest) (*DeleteCategoryRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategoryRule not implemented")
+}
+func (UnimplementedBankServiceServer) GetTransferLimits(context.Context, *GetTransferLimitsRequest) (*GetTransferLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransferLimits not implemented")
+}
+func (UnimplementedBankServiceServer) LowerTransferLimits(context.Context, *LowerTransferLimitsRequest) (*LowerTransferLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LowerTransferLimits not implemented")
+}
+func (UnimplementedBankServiceServer) RaiseTransferLimits(context.Context, *RaiseTransferLimitsRequest) (*RaiseTransferLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RaiseTransferLimits not implemented")
+}
+func (UnimplementedBankServiceServer) Deposit(context.Context, *DepositRequest) (*DepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (UnimplementedBankServiceServer) Withdraw(context.Context, *WithdrawRequest) (*WithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+}
+func (UnimplementedBankServiceServer) PreviewTransferFee(context.Context, *PreviewTransferFeeRequest) (*PreviewTransferFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewTransferFee not implemented")
+}
+func (UnimplementedBankServiceServer) ListApprovalRequests(context.Context, *ListApprovalRequestsRequest) (*ListApprovalRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApprovalRequests not implemented")
+}
+func (UnimplementedBankServiceServer) GetApprovalRequest(context.Context, *GetApprovalRequestRequest) (*GetApprovalRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApprovalRequest not implemented")
+}
+func (UnimplementedBankServiceServer) ApproveAction(context.Context, *ApproveActionRequest) (*ApproveActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAction not implemented")
+}
+func (UnimplementedBankServiceServer) RejectAction(context.Context, *RejectActionRequest) (*RejectActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectAction not implemented")
+}
+func (UnimplementedBankServiceServer) ListAuditLog(context.Context, *ListAuditLogRequest) (*ListAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditLog not implemented")
+}
+func (UnimplementedBankServiceServer) ListFraudAlerts(context.Context, *ListFraudAlertsRequest) (*ListFraudAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFraudAlerts not implemented")
+}
+func (UnimplementedBankServiceServer) GetFraudAlert(context.Context, *GetFraudAlertRequest) (*GetFraudAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFraudAlert not implemented")
+}
+func (UnimplementedBankServiceServer) DismissFraudAlert(context.Context, *DismissFraudAlertRequest) (*DismissFraudAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DismissFraudAlert not implemented")
+}
+func (UnimplementedBankServiceServer) ConfirmFraudAlert(context.Context, *ConfirmFraudAlertRequest) (*ConfirmFraudAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmFraudAlert not implemented")
+}
+func (UnimplementedBankServiceServer) ListScreeningMatches(context.Context, *ListScreeningMatchesRequest) (*ListScreeningMatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScreeningMatches not implemented")
+}
+func (UnimplementedBankServiceServer) ClearScreeningReview(context.Context, *ClearScreeningReviewRequest) (*ClearScreeningReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearScreeningReview not implemented")
+}
+func (UnimplementedBankServiceServer) ConfirmScreeningMatch(context.Context, *ConfirmScreeningMatchRequest) (*ConfirmScreeningMatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmScreeningMatch not implemented")
+}
 func (UnimplementedBankServiceServer) mustEmbedUnimplementedBankServiceServer() {}
 func (UnimplementedBankServiceServer) testEmbeddedByValue()                     {}
 
@@ -218,27 +714,81 @@ func _BankService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BankService_LoginUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginUserRequest)
+func _BankService_ChangeUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeUserRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BankServiceServer).LoginUser(ctx, in)
+		return srv.(BankServiceServer).ChangeUserRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BankService_LoginUser_FullMethodName,
+		FullMethod: BankService_ChangeUserRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BankServiceServer).LoginUser(ctx, req.(*LoginUserRequest))
+		return srv.(BankServiceServer).ChangeUserRole(ctx, req.(*ChangeUserRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BankService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAccountRequest)
-	if err := dec(in); err != nil {
+func _BankService_LoginUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).LoginUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_LoginUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).LoginUser(ctx, req.(*LoginUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_RenewAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).RenewAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_RenewAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).RenewAccessToken(ctx, req.(*RenewAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_ListAccountProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).ListAccountProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_ListAccountProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).ListAccountProducts(ctx, req.(*ListAccountProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
@@ -290,6 +840,96 @@ func _BankService_GetAccounts_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BankService_FreezeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).FreezeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_FreezeAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).FreezeAccount(ctx, req.(*FreezeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_UnfreezeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfreezeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).UnfreezeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_UnfreezeAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).UnfreezeAccount(ctx, req.(*UnfreezeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_CloseAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).CloseAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_CloseAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).CloseAccount(ctx, req.(*CloseAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_SearchAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).SearchAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_SearchAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).SearchAccounts(ctx, req.(*SearchAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).SearchUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_SearchUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).SearchUsers(ctx, req.(*SearchUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BankService_CreateTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateTransferRequest)
 	if err := dec(in); err != nil {
@@ -308,6 +948,420 @@ func _BankService_CreateTransfer_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BankService_ListAccountHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).ListAccountHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_ListAccountHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).ListAccountHistory(ctx, req.(*ListAccountHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_UpdateEntryCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEntryCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).UpdateEntryCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_UpdateEntryCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).UpdateEntryCategory(ctx, req.(*UpdateEntryCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_CreateCategoryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).CreateCategoryRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_CreateCategoryRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).CreateCategoryRule(ctx, req.(*CreateCategoryRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_ListCategoryRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoryRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).ListCategoryRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_ListCategoryRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).ListCategoryRules(ctx, req.(*ListCategoryRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_DeleteCategoryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).DeleteCategoryRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_DeleteCategoryRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).DeleteCategoryRule(ctx, req.(*DeleteCategoryRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_GetTransferLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransferLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).GetTransferLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_GetTransferLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).GetTransferLimits(ctx, req.(*GetTransferLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_LowerTransferLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LowerTransferLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).LowerTransferLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_LowerTransferLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).LowerTransferLimits(ctx, req.(*LowerTransferLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_RaiseTransferLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaiseTransferLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).RaiseTransferLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_RaiseTransferLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).RaiseTransferLimits(ctx, req.(*RaiseTransferLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_Deposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).Deposit(ctx, req.(*DepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_Withdraw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).Withdraw(ctx, req.(*WithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_PreviewTransferFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewTransferFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).PreviewTransferFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_PreviewTransferFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).PreviewTransferFee(ctx, req.(*PreviewTransferFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_ListApprovalRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApprovalRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).ListApprovalRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_ListApprovalRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).ListApprovalRequests(ctx, req.(*ListApprovalRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_GetApprovalRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApprovalRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).GetApprovalRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_GetApprovalRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).GetApprovalRequest(ctx, req.(*GetApprovalRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_ApproveAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).ApproveAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_ApproveAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).ApproveAction(ctx, req.(*ApproveActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_RejectAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).RejectAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_RejectAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).RejectAction(ctx, req.(*RejectActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_ListAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).ListAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_ListAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).ListAuditLog(ctx, req.(*ListAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_ListFraudAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFraudAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).ListFraudAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_ListFraudAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).ListFraudAlerts(ctx, req.(*ListFraudAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_GetFraudAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFraudAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).GetFraudAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_GetFraudAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).GetFraudAlert(ctx, req.(*GetFraudAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_DismissFraudAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DismissFraudAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).DismissFraudAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_DismissFraudAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).DismissFraudAlert(ctx, req.(*DismissFraudAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_ConfirmFraudAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmFraudAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).ConfirmFraudAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_ConfirmFraudAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).ConfirmFraudAlert(ctx, req.(*ConfirmFraudAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_ListScreeningMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScreeningMatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).ListScreeningMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_ListScreeningMatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).ListScreeningMatches(ctx, req.(*ListScreeningMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_ClearScreeningReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearScreeningReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).ClearScreeningReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_ClearScreeningReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).ClearScreeningReview(ctx, req.(*ClearScreeningReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_ConfirmScreeningMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmScreeningMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).ConfirmScreeningMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_ConfirmScreeningMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).ConfirmScreeningMatch(ctx, req.(*ConfirmScreeningMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BankService_ServiceDesc is the grpc.ServiceDesc for BankService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -323,10 +1377,22 @@ var BankService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUser",
 			Handler:    _BankService_UpdateUser_Handler,
 		},
+		{
+			MethodName: "ChangeUserRole",
+			Handler:    _BankService_ChangeUserRole_Handler,
+		},
 		{
 			MethodName: "LoginUser",
 			Handler:    _BankService_LoginUser_Handler,
 		},
+		{
+			MethodName: "RenewAccessToken",
+			Handler:    _BankService_RenewAccessToken_Handler,
+		},
+		{
+			MethodName: "ListAccountProducts",
+			Handler:    _BankService_ListAccountProducts_Handler,
+		},
 		{
 			MethodName: "CreateAccount",
 			Handler:    _BankService_CreateAccount_Handler,
@@ -339,10 +1405,122 @@ var BankService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAccounts",
 			Handler:    _BankService_GetAccounts_Handler,
 		},
+		{
+			MethodName: "FreezeAccount",
+			Handler:    _BankService_FreezeAccount_Handler,
+		},
+		{
+			MethodName: "UnfreezeAccount",
+			Handler:    _BankService_UnfreezeAccount_Handler,
+		},
+		{
+			MethodName: "CloseAccount",
+			Handler:    _BankService_CloseAccount_Handler,
+		},
+		{
+			MethodName: "SearchAccounts",
+			Handler:    _BankService_SearchAccounts_Handler,
+		},
+		{
+			MethodName: "SearchUsers",
+			Handler:    _BankService_SearchUsers_Handler,
+		},
 		{
 			MethodName: "CreateTransfer",
 			Handler:    _BankService_CreateTransfer_Handler,
 		},
+		{
+			MethodName: "ListAccountHistory",
+			Handler:    _BankService_ListAccountHistory_Handler,
+		},
+		{
+			MethodName: "UpdateEntryCategory",
+			Handler:    _BankService_UpdateEntryCategory_Handler,
+		},
+		{
+			MethodName: "CreateCategoryRule",
+			Handler:    _BankService_CreateCategoryRule_Handler,
+		},
+		{
+			MethodName: "ListCategoryRules",
+			Handler:    _BankService_ListCategoryRules_Handler,
+		},
+		{
+			MethodName: "DeleteCategoryRule",
+			Handler:    _BankService_DeleteCategoryRule_Handler,
+		},
+		{
+			MethodName: "GetTransferLimits",
+			Handler:    _BankService_GetTransferLimits_Handler,
+		},
+		{
+			MethodName: "LowerTransferLimits",
+			Handler:    _BankService_LowerTransferLimits_Handler,
+		},
+		{
+			MethodName: "RaiseTransferLimits",
+			Handler:    _BankService_RaiseTransferLimits_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _BankService_Deposit_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _BankService_Withdraw_Handler,
+		},
+		{
+			MethodName: "PreviewTransferFee",
+			Handler:    _BankService_PreviewTransferFee_Handler,
+		},
+		{
+			MethodName: "ListApprovalRequests",
+			Handler:    _BankService_ListApprovalRequests_Handler,
+		},
+		{
+			MethodName: "GetApprovalRequest",
+			Handler:    _BankService_GetApprovalRequest_Handler,
+		},
+		{
+			MethodName: "ApproveAction",
+			Handler:    _BankService_ApproveAction_Handler,
+		},
+		{
+			MethodName: "RejectAction",
+			Handler:    _BankService_RejectAction_Handler,
+		},
+		{
+			MethodName: "ListAuditLog",
+			Handler:    _BankService_ListAuditLog_Handler,
+		},
+		{
+			MethodName: "ListFraudAlerts",
+			Handler:    _BankService_ListFraudAlerts_Handler,
+		},
+		{
+			MethodName: "GetFraudAlert",
+			Handler:    _BankService_GetFraudAlert_Handler,
+		},
+		{
+			MethodName: "DismissFraudAlert",
+			Handler:    _BankService_DismissFraudAlert_Handler,
+		},
+		{
+			MethodName: "ConfirmFraudAlert",
+			Handler:    _BankService_ConfirmFraudAlert_Handler,
+		},
+		{
+			MethodName: "ListScreeningMatches",
+			Handler:    _BankService_ListScreeningMatches_Handler,
+		},
+		{
+			MethodName: "ClearScreeningReview",
+			Handler:    _BankService_ClearScreeningReview_Handler,
+		},
+		{
+			MethodName: "ConfirmScreeningMatch",
+			Handler:    _BankService_ConfirmScreeningMatch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
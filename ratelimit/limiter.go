@@ -0,0 +1,83 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	BackendMemory   = "memory"
+	BackendPostgres = "postgres"
+
+	// DefaultMethod is the key of the limit applied to methods without an
+	// explicit entry.
+	DefaultMethod = "*"
+)
+
+// Limit describes a token bucket that refills at Rate tokens per second and
+// holds at most Burst tokens.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+type Result struct {
+	Allowed    bool
+	RetryAfter time.Duration
+}
+
+type Limiter interface {
+	Allow(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// ParseLimits parses per-method limits written as a comma separated list of
+// method=rate:burst pairs, e.g. "LoginUser=0.2:5,*=10:20".
+func ParseLimits(s string) (map[string]Limit, error) {
+	limits := map[string]Limit{}
+
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		method, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid rate limit %q: expected method=rate:burst", entry)
+		}
+		rateValue, burstValue, ok := strings.Cut(value, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid rate limit %q: expected method=rate:burst", entry)
+		}
+
+		rate, err := strconv.ParseFloat(rateValue, 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("invalid rate in rate limit %q", entry)
+		}
+		burst, err := strconv.Atoi(burstValue)
+		if err != nil || burst < 1 {
+			return nil, fmt.Errorf("invalid burst in rate limit %q", entry)
+		}
+
+		limits[strings.TrimSpace(method)] = Limit{Rate: rate, Burst: burst}
+	}
+	return limits, nil
+}
+
+// refill returns the number of tokens in a bucket after elapsed time.
+func refill(tokens float64, elapsed time.Duration, limit Limit) float64 {
+	return math.Min(float64(limit.Burst), tokens+elapsed.Seconds()*limit.Rate)
+}
+
+// retryAfter returns how long it takes a bucket holding tokens to refill
+// enough for one request.
+func retryAfter(tokens float64, limit Limit) time.Duration {
+	if tokens >= 1 {
+		return 0
+	}
+	return time.Duration(math.Ceil((1 - tokens) / limit.Rate * float64(time.Second)))
+}
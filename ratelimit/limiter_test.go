@@ -0,0 +1,76 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLimits(t *testing.T) {
+	limits, err := ParseLimits("LoginUser=0.5:5, *=10:20")
+	require.NoError(t, err)
+	require.Equal(t, Limit{Rate: 0.5, Burst: 5}, limits["LoginUser"])
+	require.Equal(t, Limit{Rate: 10, Burst: 20}, limits[DefaultMethod])
+
+	limits, err = ParseLimits("")
+	require.NoError(t, err)
+	require.Empty(t, limits)
+
+	for _, invalid := range []string{"LoginUser", "LoginUser=1", "LoginUser=x:1", "LoginUser=1:0", "LoginUser=-1:1"} {
+		_, err := ParseLimits(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestMemoryLimiter(t *testing.T) {
+	now := time.Now()
+	limiter := NewMemoryLimiter()
+	limiter.now = func() time.Time { return now }
+
+	limit := Limit{Rate: 1, Burst: 2}
+	ctx := context.Background()
+
+	for i := 0; i < limit.Burst; i++ {
+		result, err := limiter.Allow(ctx, "key", limit)
+		require.NoError(t, err)
+		require.True(t, result.Allowed)
+	}
+
+	result, err := limiter.Allow(ctx, "key", limit)
+	require.NoError(t, err)
+	require.False(t, result.Allowed)
+	require.Equal(t, time.Second, result.RetryAfter)
+
+	result, err = limiter.Allow(ctx, "other", limit)
+	require.NoError(t, err)
+	require.True(t, result.Allowed)
+
+	now = now.Add(500 * time.Millisecond)
+	result, err = limiter.Allow(ctx, "key", limit)
+	require.NoError(t, err)
+	require.False(t, result.Allowed)
+	require.Equal(t, 500*time.Millisecond, result.RetryAfter)
+
+	now = now.Add(500 * time.Millisecond)
+	result, err = limiter.Allow(ctx, "key", limit)
+	require.NoError(t, err)
+	require.True(t, result.Allowed)
+}
+
+func TestMemoryLimiterSweep(t *testing.T) {
+	now := time.Now()
+	limiter := NewMemoryLimiter()
+	limiter.now = func() time.Time { return now }
+
+	_, err := limiter.Allow(context.Background(), "key", Limit{Rate: 1, Burst: 1})
+	require.NoError(t, err)
+	require.Len(t, limiter.buckets, 1)
+
+	now = now.Add(memorySweepInterval)
+	_, err = limiter.Allow(context.Background(), "other", Limit{Rate: 1, Burst: 1})
+	require.NoError(t, err)
+	require.Len(t, limiter.buckets, 1)
+	require.Contains(t, limiter.buckets, "other")
+}
@@ -0,0 +1,70 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const memorySweepInterval = time.Minute
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+	limit     Limit
+}
+
+// MemoryLimiter keeps buckets in process memory. Limits are enforced per
+// replica, so it is only suitable for single-instance deployments.
+type MemoryLimiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewMemoryLimiter() *MemoryLimiter {
+	return &MemoryLimiter{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+func (limiter *MemoryLimiter) Allow(ctx context.Context, key string, limit Limit) (Result, error) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	now := limiter.now()
+	limiter.sweep(now)
+
+	b, ok := limiter.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), updatedAt: now}
+		limiter.buckets[key] = b
+	}
+
+	b.tokens = refill(b.tokens, now.Sub(b.updatedAt), limit)
+	b.updatedAt = now
+	b.limit = limit
+
+	if b.tokens < 1 {
+		return Result{Allowed: false, RetryAfter: retryAfter(b.tokens, limit)}, nil
+	}
+	b.tokens--
+	return Result{Allowed: true}, nil
+}
+
+// sweep drops buckets that have refilled completely, since they are
+// indistinguishable from new ones.
+func (limiter *MemoryLimiter) sweep(now time.Time) {
+	if now.Sub(limiter.lastSweep) < memorySweepInterval {
+		return
+	}
+	limiter.lastSweep = now
+
+	for key, b := range limiter.buckets {
+		if refill(b.tokens, now.Sub(b.updatedAt), b.limit) >= float64(b.limit.Burst) {
+			delete(limiter.buckets, key)
+		}
+	}
+}
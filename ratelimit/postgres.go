@@ -0,0 +1,66 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+)
+
+const (
+	postgresSweepInterval = 10 * time.Minute
+	postgresIdleTimeout   = time.Hour
+)
+
+type Queries interface {
+	TakeRateLimitToken(ctx context.Context, arg db.TakeRateLimitTokenParams) (db.TakeRateLimitTokenRow, error)
+	DeleteIdleRateLimitBuckets(ctx context.Context, idleSince time.Time) error
+}
+
+// PostgresLimiter keeps buckets in the rate_limit_buckets table so that all
+// replicas share the same limits. Each check is a single upsert, which row
+// locks the bucket for the duration of the statement.
+type PostgresLimiter struct {
+	queries Queries
+
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+func NewPostgresLimiter(queries Queries) *PostgresLimiter {
+	return &PostgresLimiter{queries: queries, lastSweep: time.Now()}
+}
+
+func (limiter *PostgresLimiter) Allow(ctx context.Context, key string, limit Limit) (Result, error) {
+	limiter.sweep(ctx)
+
+	row, err := limiter.queries.TakeRateLimitToken(ctx, db.TakeRateLimitTokenParams{
+		Key:   key,
+		Burst: float64(limit.Burst),
+		Rate:  limit.Rate,
+	})
+	if err != nil {
+		return Result{}, fmt.Errorf("cannot take rate limit token: %w", err)
+	}
+
+	if !row.Allowed {
+		return Result{Allowed: false, RetryAfter: retryAfter(row.Tokens, limit)}, nil
+	}
+	return Result{Allowed: true}, nil
+}
+
+func (limiter *PostgresLimiter) sweep(ctx context.Context) {
+	limiter.mu.Lock()
+	if time.Since(limiter.lastSweep) < postgresSweepInterval {
+		limiter.mu.Unlock()
+		return
+	}
+	limiter.lastSweep = time.Now()
+	limiter.mu.Unlock()
+
+	// A failed sweep only leaves stale rows behind, so it must not fail the
+	// request being limited.
+	limiter.queries.DeleteIdleRateLimitBuckets(ctx, time.Now().Add(-postgresIdleTimeout))
+}
@@ -0,0 +1,136 @@
+package sanctions
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Entry is a sanctioned person or organisation.
+type Entry struct {
+	ID      string   `xml:"id,attr"`
+	Name    string   `xml:"name"`
+	Aliases []string `xml:"alias"`
+	Program string   `xml:"program"`
+}
+
+// LoadList reads a sanctions list from a .csv or .xml file.
+func LoadList(path string) ([]Entry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open sanctions list: %w", err)
+	}
+	defer file.Close()
+
+	var entries []Entry
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		entries, err = ParseCSV(file)
+	case ".xml":
+		entries, err = ParseXML(file)
+	default:
+		return nil, fmt.Errorf("unsupported sanctions list format: %s", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse sanctions list: %w", err)
+	}
+	return entries, nil
+}
+
+// ParseCSV reads a list with a header row naming its columns. id and name are
+// required; aliases holds several names separated by semicolons and program
+// is optional.
+func ParseCSV(r io.Reader) ([]Entry, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read header: %w", err)
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"id", "name"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("missing %s column", required)
+		}
+	}
+
+	column := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	entries := []Entry{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		entry := Entry{
+			ID:      column(record, "id"),
+			Name:    column(record, "name"),
+			Program: column(record, "program"),
+		}
+		for _, alias := range strings.Split(column(record, "aliases"), ";") {
+			if alias = strings.TrimSpace(alias); alias != "" {
+				entry.Aliases = append(entry.Aliases, alias)
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, validateEntries(entries)
+}
+
+// ParseXML reads a list of <entry id="..."> elements, each holding a <name>,
+// any number of <alias> and an optional <program>, under a single root
+// element.
+func ParseXML(r io.Reader) ([]Entry, error) {
+	var list struct {
+		Entries []Entry `xml:"entry"`
+	}
+	if err := xml.NewDecoder(r).Decode(&list); err != nil {
+		return nil, err
+	}
+
+	entries := list.Entries
+	if entries == nil {
+		entries = []Entry{}
+	}
+	for i := range entries {
+		entries[i].ID = strings.TrimSpace(entries[i].ID)
+		entries[i].Name = strings.TrimSpace(entries[i].Name)
+		entries[i].Program = strings.TrimSpace(entries[i].Program)
+	}
+	return entries, validateEntries(entries)
+}
+
+func validateEntries(entries []Entry) error {
+	ids := map[string]bool{}
+	for i, entry := range entries {
+		if entry.ID == "" || entry.Name == "" {
+			return fmt.Errorf("entry %d has no id or name", i+1)
+		}
+		if ids[entry.ID] {
+			return fmt.Errorf("duplicate entry %q", entry.ID)
+		}
+		ids[entry.ID] = true
+	}
+	return nil
+}
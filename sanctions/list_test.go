@@ -0,0 +1,66 @@
+package sanctions
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadList(t *testing.T) {
+	entries, err := LoadList("example_list.csv")
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+
+	_, err = LoadList("missing.csv")
+	require.Error(t, err)
+
+	_, err = LoadList("list.json")
+	require.Error(t, err)
+}
+
+func TestParseCSV(t *testing.T) {
+	entries, err := ParseCSV(strings.NewReader("name,id,aliases\nJohn Doe,X-1,Johnny Doe; J. Doe\nJane Roe,X-2,\n"))
+	require.NoError(t, err)
+	require.Equal(t, []Entry{
+		{ID: "X-1", Name: "John Doe", Aliases: []string{"Johnny Doe", "J. Doe"}},
+		{ID: "X-2", Name: "Jane Roe"},
+	}, entries)
+
+	for _, invalid := range []string{
+		"",
+		"id,aliases\nX-1,John Doe\n",
+		"id,name\nX-1,\n",
+		"id,name\nX-1,John Doe\nX-1,Jane Roe\n",
+		"id,name\n\"X-1,John Doe\n",
+	} {
+		_, err := ParseCSV(strings.NewReader(invalid))
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestParseXML(t *testing.T) {
+	entries, err := ParseXML(strings.NewReader(`
+<sanctions_list>
+  <entry id="X-1">
+    <name>John Doe</name>
+    <alias>Johnny Doe</alias>
+    <alias>J. Doe</alias>
+    <program>EXAMPLE</program>
+  </entry>
+  <entry id=" X-2 ">
+    <name> Jane Roe </name>
+  </entry>
+</sanctions_list>`))
+	require.NoError(t, err)
+	require.Equal(t, []Entry{
+		{ID: "X-1", Name: "John Doe", Aliases: []string{"Johnny Doe", "J. Doe"}, Program: "EXAMPLE"},
+		{ID: "X-2", Name: "Jane Roe"},
+	}, entries)
+
+	_, err = ParseXML(strings.NewReader(`<sanctions_list><entry><name>John Doe</name></entry></sanctions_list>`))
+	require.Error(t, err)
+
+	_, err = ParseXML(strings.NewReader(`<sanctions_list>`))
+	require.Error(t, err)
+}
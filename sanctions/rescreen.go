@@ -0,0 +1,70 @@
+package sanctions
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"github.com/valkyraycho/bank_project/telemetry"
+)
+
+const batchSize = 100
+
+// Rescreener screens every user again, catching users who match entries
+// added to the list since they were last screened. Matches already recorded
+// for a user are not raised again.
+type Rescreener struct {
+	store    db.Store
+	screener *Screener
+}
+
+func NewRescreener(store db.Store, screener *Screener) *Rescreener {
+	return &Rescreener{
+		store:    store,
+		screener: screener,
+	}
+}
+
+// RescreenAll screens all users and returns how many it screened and how
+// many had new matches.
+func (rescreener *Rescreener) RescreenAll(ctx context.Context) (screened int, flagged int, err error) {
+	afterID := int32(0)
+	for {
+		users, err := rescreener.store.ListUsersForScreening(ctx, db.ListUsersForScreeningParams{
+			AfterID:   afterID,
+			BatchSize: batchSize,
+		})
+		if err != nil {
+			return screened, flagged, fmt.Errorf("cannot list users to screen: %w", err)
+		}
+
+		for _, user := range users {
+			result, err := rescreener.screener.ScreenUser(ctx, rescreener.store, user, db.ScreeningSourceRescreen)
+			if err != nil {
+				return screened, flagged, fmt.Errorf("cannot screen user %d: %w", user.ID, err)
+			}
+			screened++
+			if len(result.Matches) > 0 {
+				flagged++
+			}
+			afterID = user.ID
+		}
+
+		if len(users) < batchSize {
+			return screened, flagged, nil
+		}
+	}
+}
+
+// RunOnce rescreens all users.
+func (rescreener *Rescreener) RunOnce(ctx context.Context) {
+	logger := telemetry.Logger(ctx)
+
+	screened, flagged, err := rescreener.RescreenAll(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("sanctions rescreen run failed")
+		return
+	}
+
+	logger.Info().Int("screened", screened).Int("flagged", flagged).Msg("sanctions rescreen run")
+}
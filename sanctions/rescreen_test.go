@@ -0,0 +1,72 @@
+package sanctions
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestRescreener(t *testing.T) (*Rescreener, *mockdb.MockStore) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	screener, err := NewScreener(testEntries, 0.85)
+	require.NoError(t, err)
+	return NewRescreener(store, screener), store
+}
+
+func TestRescreenAll(t *testing.T) {
+	rescreener, store := newTestRescreener(t)
+
+	batch := make([]db.User, batchSize)
+	for i := range batch {
+		batch[i] = db.User{ID: int32(i + 1), FullName: "Jane Roe"}
+	}
+	batch[1].FullName = "Ivan Drago Petrovich"
+
+	gomock.InOrder(
+		store.EXPECT().
+			ListUsersForScreening(gomock.Any(), gomock.Eq(db.ListUsersForScreeningParams{AfterID: 0, BatchSize: batchSize})).
+			Return(batch, nil),
+		store.EXPECT().
+			ListUsersForScreening(gomock.Any(), gomock.Eq(db.ListUsersForScreeningParams{AfterID: batchSize, BatchSize: batchSize})).
+			Return([]db.User{}, nil),
+	)
+
+	store.EXPECT().
+		RecordScreeningMatchesTx(gomock.Any(), gomock.Cond(func(args db.RecordScreeningMatchesTxParams) bool {
+			return args.UserID == 2 && len(args.Matches) == 1 && args.Source == db.ScreeningSourceRescreen
+		})).
+		Return(db.RecordScreeningMatchesTxResult{Matches: []db.ScreeningMatch{{ID: 1}}}, nil)
+	store.EXPECT().
+		RecordScreeningMatchesTx(gomock.Any(), gomock.Cond(func(args db.RecordScreeningMatchesTxParams) bool {
+			return args.UserID != 2 && len(args.Matches) == 0
+		})).
+		Times(batchSize-1).
+		Return(db.RecordScreeningMatchesTxResult{Matches: []db.ScreeningMatch{}}, nil)
+
+	screened, flagged, err := rescreener.RescreenAll(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, batchSize, screened)
+	require.Equal(t, 1, flagged)
+}
+
+func TestRescreenAllStopsOnStoreError(t *testing.T) {
+	rescreener, store := newTestRescreener(t)
+
+	store.EXPECT().
+		ListUsersForScreening(gomock.Any(), gomock.Any()).
+		Return([]db.User{{ID: 1, FullName: "Jane Roe"}, {ID: 2, FullName: "John Doe"}}, nil)
+	store.EXPECT().
+		RecordScreeningMatchesTx(gomock.Any(), gomock.Any()).
+		Return(db.RecordScreeningMatchesTxResult{}, sql.ErrConnDone)
+
+	screened, _, err := rescreener.RescreenAll(context.Background())
+	require.ErrorIs(t, err, sql.ErrConnDone)
+	require.Zero(t, screened)
+}
@@ -0,0 +1,185 @@
+package sanctions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// DefaultThreshold is used when no threshold is configured.
+const DefaultThreshold = 0.85
+
+// letters that don't decompose into a base letter and a mark.
+var foldedLetters = strings.NewReplacer(
+	"ß", "ss",
+	"æ", "ae",
+	"œ", "oe",
+	"ø", "o",
+	"ł", "l",
+	"đ", "d",
+	"ð", "d",
+	"þ", "th",
+	"ı", "i",
+)
+
+// Normalize reduces a name to lower case ASCII-like tokens in sorted order,
+// so "Müller-Lüdenscheidt, Hans" and "hans muller ludenscheidt" compare
+// equal.
+func Normalize(name string) string {
+	stripMarks := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	folded, _, err := transform.String(stripMarks, strings.ToLower(name))
+	if err != nil {
+		folded = strings.ToLower(name)
+	}
+	folded = foldedLetters.Replace(folded)
+
+	tokens := strings.FieldsFunc(folded, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	sort.Strings(tokens)
+	return strings.Join(tokens, " ")
+}
+
+// Similarity scores two normalized names from 0, nothing in common, to 1,
+// identical, by their edit distance.
+func Similarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+func levenshtein(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+// Match is a list entry a name scored at least the threshold against.
+type Match struct {
+	Entry Entry
+	// MatchedName is the name or alias of the entry that scored best.
+	MatchedName string
+	Score       float64
+}
+
+type indexedName struct {
+	name       string
+	normalized string
+}
+
+type indexedEntry struct {
+	entry Entry
+	names []indexedName
+}
+
+// Screener matches names against a sanctions list.
+type Screener struct {
+	entries   []indexedEntry
+	threshold float64
+}
+
+// NewScreener indexes the list. A zero threshold uses DefaultThreshold.
+func NewScreener(entries []Entry, threshold float64) (*Screener, error) {
+	if threshold == 0 {
+		threshold = DefaultThreshold
+	}
+	if threshold <= 0 || threshold > 1 {
+		return nil, fmt.Errorf("sanctions match threshold must be within (0, 1], got %g", threshold)
+	}
+
+	screener := &Screener{threshold: threshold}
+	for _, entry := range entries {
+		indexed := indexedEntry{entry: entry}
+		for _, name := range append([]string{entry.Name}, entry.Aliases...) {
+			if normalized := Normalize(name); normalized != "" {
+				indexed.names = append(indexed.names, indexedName{name: name, normalized: normalized})
+			}
+		}
+		screener.entries = append(screener.entries, indexed)
+	}
+	return screener, nil
+}
+
+// LoadScreener builds a screener from a list file.
+func LoadScreener(path string, threshold float64) (*Screener, error) {
+	entries, err := LoadList(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewScreener(entries, threshold)
+}
+
+// Screen returns the entries name matches, best first.
+func (screener *Screener) Screen(name string) []Match {
+	normalized := Normalize(name)
+	if normalized == "" {
+		return nil
+	}
+
+	var matches []Match
+	for _, indexed := range screener.entries {
+		best := Match{Entry: indexed.entry}
+		for _, candidate := range indexed.names {
+			if score := Similarity(normalized, candidate.normalized); score > best.Score {
+				best.Score, best.MatchedName = score, candidate.name
+			}
+		}
+		if best.Score >= screener.threshold {
+			matches = append(matches, best)
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+	return matches
+}
+
+// ScreenName returns the matches of name in the form they are recorded.
+func (screener *Screener) ScreenName(name string) []db.NewScreeningMatch {
+	matches := []db.NewScreeningMatch{}
+	for _, match := range screener.Screen(name) {
+		matches = append(matches, db.NewScreeningMatch{
+			EntryID:     match.Entry.ID,
+			EntryName:   match.Entry.Name,
+			MatchedName: match.MatchedName,
+			Score:       match.Score,
+		})
+	}
+	return matches
+}
+
+// ScreenUser screens the full name of a user and records the outcome,
+// putting the user up for review on a new match.
+func (screener *Screener) ScreenUser(ctx context.Context, store db.Store, user db.User, source string) (db.RecordScreeningMatchesTxResult, error) {
+	return store.RecordScreeningMatchesTx(ctx, db.RecordScreeningMatchesTxParams{
+		UserID:  user.ID,
+		Matches: screener.ScreenName(user.FullName),
+		Source:  source,
+	})
+}
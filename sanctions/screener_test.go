@@ -0,0 +1,95 @@
+package sanctions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/valkyraycho/bank_project/db/mock"
+	db "github.com/valkyraycho/bank_project/db/sqlc"
+	"go.uber.org/mock/gomock"
+)
+
+var testEntries = []Entry{
+	{ID: "X-1", Name: "Hans Müller", Aliases: []string{"Johann Mueller"}},
+	{ID: "X-2", Name: "Ivan Petrovich Drago"},
+	{ID: "X-3", Name: "Søren Łukasz"},
+}
+
+func TestNormalize(t *testing.T) {
+	require.Equal(t, "hans muller", Normalize("Müller, Hans"))
+	require.Equal(t, "hans muller", Normalize("  HANS   MÜLLER "))
+	require.Equal(t, "lukasz soren", Normalize("Søren Łukasz"))
+	require.Equal(t, "francois jose", Normalize("José-François"))
+	require.Equal(t, "", Normalize(" - "))
+}
+
+func TestSimilarity(t *testing.T) {
+	require.Equal(t, 1.0, Similarity("hans muller", "hans muller"))
+	require.Equal(t, 1.0, Similarity("", ""))
+	require.InDelta(t, 1-1.0/11, Similarity("hans muller", "hans mullor"), 1e-9)
+	require.Less(t, Similarity("hans muller", "jane roe"), 0.5)
+}
+
+func TestNewScreener(t *testing.T) {
+	screener, err := NewScreener(testEntries, 0)
+	require.NoError(t, err)
+	require.Equal(t, DefaultThreshold, screener.threshold)
+
+	for _, invalid := range []float64{-0.5, 1.5} {
+		_, err := NewScreener(testEntries, invalid)
+		require.Error(t, err)
+	}
+}
+
+func TestScreen(t *testing.T) {
+	screener, err := NewScreener(testEntries, 0.85)
+	require.NoError(t, err)
+
+	matches := screener.Screen("MULLER Hans")
+	require.Len(t, matches, 1)
+	require.Equal(t, "X-1", matches[0].Entry.ID)
+	require.Equal(t, "Hans Müller", matches[0].MatchedName)
+	require.Equal(t, 1.0, matches[0].Score)
+
+	matches = screener.Screen("Johann Muller")
+	require.Len(t, matches, 1)
+	require.Equal(t, "Johann Mueller", matches[0].MatchedName)
+	require.Less(t, matches[0].Score, 1.0)
+
+	matches = screener.Screen("Ivan Petrovic Drago")
+	require.Len(t, matches, 1)
+	require.Equal(t, "X-2", matches[0].Entry.ID)
+
+	require.Len(t, screener.Screen("Soren Lukasz"), 1)
+	require.Empty(t, screener.Screen("Jane Roe"))
+	require.Empty(t, screener.Screen("Ivan Drago"))
+	require.Empty(t, screener.Screen(""))
+}
+
+func TestScreenUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	screener, err := NewScreener(testEntries, 0.85)
+	require.NoError(t, err)
+
+	user := db.User{ID: 7, FullName: "Hans Muller"}
+	flagged := user
+	flagged.ScreeningStatus = db.ScreeningStatusPendingReview
+
+	store.EXPECT().
+		RecordScreeningMatchesTx(gomock.Any(), gomock.Eq(db.RecordScreeningMatchesTxParams{
+			UserID: user.ID,
+			Matches: []db.NewScreeningMatch{
+				{EntryID: "X-1", EntryName: "Hans Müller", MatchedName: "Hans Müller", Score: 1},
+			},
+			Source: db.ScreeningSourceCreateUser,
+		})).
+		Times(1).
+		Return(db.RecordScreeningMatchesTxResult{User: flagged, Matches: []db.ScreeningMatch{{ID: 1, UserID: user.ID}}}, nil)
+
+	result, err := screener.ScreenUser(context.Background(), store, user, db.ScreeningSourceCreateUser)
+	require.NoError(t, err)
+	require.Equal(t, db.ScreeningStatusPendingReview, result.User.ScreeningStatus)
+}
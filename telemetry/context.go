@@ -0,0 +1,42 @@
+package telemetry
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+type contextKey int
+
+const requestIDKey contextKey = iota
+
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+func RequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey).(string)
+	return requestID
+}
+
+// Logger returns the request-scoped logger stored in ctx, falling back to the
+// global logger outside of a request.
+func Logger(ctx context.Context) *zerolog.Logger {
+	logger := zerolog.Ctx(ctx)
+	if logger.GetLevel() == zerolog.Disabled {
+		return &log.Logger
+	}
+	return logger
+}
+
+// UpdateLogger adds fields to the request-scoped logger in place, so that
+// middleware logging after the handler returns sees them too. It is a no-op
+// when ctx carries no request-scoped logger.
+func UpdateLogger(ctx context.Context, update func(c zerolog.Context) zerolog.Context) {
+	logger := zerolog.Ctx(ctx)
+	if logger.GetLevel() == zerolog.Disabled {
+		return
+	}
+	logger.UpdateContext(update)
+}
@@ -0,0 +1,19 @@
+package telemetry
+
+import (
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracingHook adds the trace and span IDs of the event's context to every
+// log line written with zerolog's Ctx.
+type TracingHook struct{}
+
+func (TracingHook) Run(e *zerolog.Event, level zerolog.Level, msg string) {
+	spanContext := trace.SpanContextFromContext(e.GetCtx())
+	if !spanContext.IsValid() {
+		return
+	}
+	e.Str("trace_id", spanContext.TraceID().String()).
+		Str("span_id", spanContext.SpanID().String())
+}
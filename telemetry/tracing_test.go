@@ -0,0 +1,57 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestQuerySpanName(t *testing.T) {
+	require.Equal(t, "db.GetAccount", querySpanName("-- name: GetAccount :one\nSELECT * FROM accounts WHERE id = $1"))
+	require.Equal(t, "db.SELECT", querySpanName("select version, dirty from schema_migrations"))
+	require.Equal(t, "db.query", querySpanName(""))
+}
+
+func TestQueryTracer(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	queryTracer := &QueryTracer{tracer: provider.Tracer("test")}
+
+	ctx := queryTracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: "-- name: GetUser :one\nSELECT 1"})
+	queryTracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{Err: errors.New("boom")})
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	require.Equal(t, "db.GetUser", spans[0].Name())
+	require.Equal(t, codes.Error, spans[0].Status().Code)
+}
+
+func TestTracingHook(t *testing.T) {
+	provider := sdktrace.NewTracerProvider()
+	ctx, span := provider.Tracer("test").Start(context.Background(), "test")
+	defer span.End()
+
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf).Hook(TracingHook{})
+
+	logger.Info().Ctx(ctx).Msg("with span")
+	line := map[string]string{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &line))
+	require.Equal(t, span.SpanContext().TraceID().String(), line["trace_id"])
+	require.Equal(t, span.SpanContext().SpanID().String(), line["span_id"])
+
+	buf.Reset()
+	logger.Info().Ctx(context.Background()).Msg("without span")
+	line = map[string]string{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &line))
+	require.NotContains(t, line, "trace_id")
+}
@@ -0,0 +1,38 @@
+package tlsutil
+
+import (
+	"crypto/x509"
+	"fmt"
+	"strings"
+)
+
+// ParseServiceIdentities parses a semicolon separated list of
+// subject=identity pairs mapping client certificate subjects to service
+// identities, e.g. "ledger.internal=ledger;CN=reports,O=Bank=reporting". The
+// subject is either the certificate's common name or its full distinguished
+// name.
+func ParseServiceIdentities(s string) (map[string]string, error) {
+	identities := map[string]string{}
+
+	for _, entry := range strings.Split(s, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		index := strings.LastIndex(entry, "=")
+		if index <= 0 || index == len(entry)-1 {
+			return nil, fmt.Errorf("invalid service identity %q: expected subject=identity", entry)
+		}
+		identities[strings.TrimSpace(entry[:index])] = strings.TrimSpace(entry[index+1:])
+	}
+	return identities, nil
+}
+
+// ServiceIdentity returns the identity mapped to the subject of cert.
+func ServiceIdentity(identities map[string]string, cert *x509.Certificate) (string, bool) {
+	if identity, ok := identities[cert.Subject.String()]; ok {
+		return identity, true
+	}
+	identity, ok := identities[cert.Subject.CommonName]
+	return identity, ok
+}
@@ -0,0 +1,155 @@
+package tlsutil
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/rs/zerolog/log"
+)
+
+// Reloader serves a certificate, and optionally a client CA pool, that are
+// re-read from disk whenever one of the files changes, so certificates can
+// be rotated without restarting the listeners.
+type Reloader struct {
+	certFile     string
+	keyFile      string
+	clientCAFile string
+
+	mu          sync.RWMutex
+	certificate *tls.Certificate
+	clientCAs   *x509.CertPool
+}
+
+func NewReloader(certFile, keyFile, clientCAFile string) (*Reloader, error) {
+	reloader := &Reloader{
+		certFile:     certFile,
+		keyFile:      keyFile,
+		clientCAFile: clientCAFile,
+	}
+	if err := reloader.Reload(); err != nil {
+		return nil, err
+	}
+	return reloader, nil
+}
+
+func (reloader *Reloader) Reload() error {
+	certificate, err := tls.LoadX509KeyPair(reloader.certFile, reloader.keyFile)
+	if err != nil {
+		return fmt.Errorf("cannot load key pair: %w", err)
+	}
+
+	var clientCAs *x509.CertPool
+	if reloader.clientCAFile != "" {
+		pem, err := os.ReadFile(reloader.clientCAFile)
+		if err != nil {
+			return fmt.Errorf("cannot read client CA file: %w", err)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates found in client CA file %s", reloader.clientCAFile)
+		}
+	}
+
+	reloader.mu.Lock()
+	reloader.certificate = &certificate
+	reloader.clientCAs = clientCAs
+	reloader.mu.Unlock()
+	return nil
+}
+
+func (reloader *Reloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	reloader.mu.RLock()
+	defer reloader.mu.RUnlock()
+	return reloader.certificate, nil
+}
+
+// ServerConfig returns a TLS config that picks up reloaded files on every
+// handshake. When a client CA file is configured, client certificates are
+// verified against it if presented; requireClientCert makes them mandatory.
+func (reloader *Reloader) ServerConfig(requireClientCert bool) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			reloader.mu.RLock()
+			defer reloader.mu.RUnlock()
+
+			config := &tls.Config{
+				MinVersion:     tls.VersionTLS12,
+				GetCertificate: reloader.GetCertificate,
+				NextProtos:     []string{"h2", "http/1.1"},
+			}
+			if reloader.clientCAs != nil {
+				config.ClientCAs = reloader.clientCAs
+				config.ClientAuth = tls.VerifyClientCertIfGiven
+				if requireClientCert {
+					config.ClientAuth = tls.RequireAndVerifyClientCert
+				}
+			}
+			return config, nil
+		},
+	}
+}
+
+// Watch reloads the files whenever they change until ctx is done. The parent
+// directories are watched rather than the files themselves, because secret
+// mounts and most deploy tools replace files by renaming them.
+func (reloader *Reloader) Watch(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("cannot create file watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	files := map[string]bool{}
+	for _, file := range []string{reloader.certFile, reloader.keyFile, reloader.clientCAFile} {
+		if file == "" {
+			continue
+		}
+		path, err := filepath.Abs(file)
+		if err != nil {
+			return err
+		}
+		files[path] = true
+		if err := watcher.Add(filepath.Dir(path)); err != nil {
+			return fmt.Errorf("cannot watch %s: %w", file, err)
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if !files[filepath.Clean(event.Name)] && !isSymlinkSwap(event.Name) {
+				continue
+			}
+			if err := reloader.Reload(); err != nil {
+				// Files are often written one at a time, so a half-updated
+				// pair is expected; keep serving the previous certificate.
+				log.Warn().Err(err).Str("file", event.Name).Msg("cannot reload TLS certificate")
+				continue
+			}
+			log.Info().Str("file", reloader.certFile).Msg("reloaded TLS certificate")
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			log.Error().Err(err).Msg("TLS certificate watcher error")
+		}
+	}
+}
+
+// isSymlinkSwap reports whether event is for the ..data symlink Kubernetes
+// swaps when it updates a mounted secret.
+func isSymlinkSwap(name string) bool {
+	return filepath.Base(name) == "..data"
+}
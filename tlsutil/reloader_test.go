@@ -0,0 +1,164 @@
+package tlsutil
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/valkyraycho/bank_project/tlsutil/tlstest"
+)
+
+func serveTLS(t *testing.T, config *tls.Config) string {
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", config)
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				conn.(*tls.Conn).Handshake()
+			}()
+		}
+	}()
+	return listener.Addr().String()
+}
+
+func dialTLS(addr string, rootCAs *x509.CertPool, clientCert *tls.Certificate) (*x509.Certificate, error) {
+	config := &tls.Config{RootCAs: rootCAs, ServerName: "localhost"}
+	if clientCert != nil {
+		// Always present the certificate, even when its issuer is not in
+		// the server's list of acceptable CAs.
+		config.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return clientCert, nil
+		}
+	}
+	conn, err := tls.Dial("tcp", addr, config)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	// With TLS 1.3 a rejected client certificate only surfaces on the first read.
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	if _, err := conn.Read(make([]byte, 1)); err != nil && !isTimeoutOrEOF(err) {
+		return nil, err
+	}
+	return conn.ConnectionState().PeerCertificates[0], nil
+}
+
+func isTimeoutOrEOF(err error) bool {
+	if netErr, ok := err.(interface{ Timeout() bool }); ok && netErr.Timeout() {
+		return true
+	}
+	return err.Error() == "EOF"
+}
+
+func TestReloaderWatch(t *testing.T) {
+	ca := tlstest.NewCA(t, "test-ca")
+	dir := t.TempDir()
+	certFile, keyFile := ca.ServerCert(t, "first").WriteFiles(t, dir)
+
+	reloader, err := NewReloader(certFile, keyFile, "")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go reloader.Watch(ctx)
+
+	addr := serveTLS(t, reloader.ServerConfig(false))
+
+	cert, err := dialTLS(addr, ca.Pool(), nil)
+	require.NoError(t, err)
+	require.Equal(t, "first", cert.Subject.CommonName)
+
+	// Replace both files atomically the way deploy tools do.
+	second := ca.ServerCert(t, "second")
+	staging := t.TempDir()
+	stagedCert, stagedKey := second.WriteFiles(t, staging)
+	require.NoError(t, os.Rename(stagedKey, filepath.Join(dir, "key.pem")))
+	require.NoError(t, os.Rename(stagedCert, filepath.Join(dir, "cert.pem")))
+
+	require.Eventually(t, func() bool {
+		cert, err := dialTLS(addr, ca.Pool(), nil)
+		return err == nil && cert.Subject.CommonName == "second"
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestReloaderClientAuth(t *testing.T) {
+	ca := tlstest.NewCA(t, "test-ca")
+	clientCA := tlstest.NewCA(t, "client-ca")
+	otherCA := tlstest.NewCA(t, "other-ca")
+
+	dir := t.TempDir()
+	certFile, keyFile := ca.ServerCert(t, "server").WriteFiles(t, dir)
+	clientCAFile := filepath.Join(dir, "client-ca.pem")
+	require.NoError(t, os.WriteFile(clientCAFile, clientCA.CertPEM(), 0o600))
+
+	reloader, err := NewReloader(certFile, keyFile, clientCAFile)
+	require.NoError(t, err)
+
+	clientCert := clientCA.ClientCert(t, pkix.Name{CommonName: "ledger"}).TLSCertificate(t)
+	untrustedCert := otherCA.ClientCert(t, pkix.Name{CommonName: "ledger"}).TLSCertificate(t)
+
+	optional := serveTLS(t, reloader.ServerConfig(false))
+	_, err = dialTLS(optional, ca.Pool(), nil)
+	require.NoError(t, err)
+	_, err = dialTLS(optional, ca.Pool(), &clientCert)
+	require.NoError(t, err)
+	_, err = dialTLS(optional, ca.Pool(), &untrustedCert)
+	require.Error(t, err)
+
+	required := serveTLS(t, reloader.ServerConfig(true))
+	_, err = dialTLS(required, ca.Pool(), nil)
+	require.Error(t, err)
+	_, err = dialTLS(required, ca.Pool(), &clientCert)
+	require.NoError(t, err)
+}
+
+func TestNewReloaderInvalidFiles(t *testing.T) {
+	_, err := NewReloader("missing-cert.pem", "missing-key.pem", "")
+	require.Error(t, err)
+
+	ca := tlstest.NewCA(t, "test-ca")
+	dir := t.TempDir()
+	certFile, keyFile := ca.ServerCert(t, "server").WriteFiles(t, dir)
+	clientCAFile := filepath.Join(dir, "client-ca.pem")
+	require.NoError(t, os.WriteFile(clientCAFile, []byte("not a certificate"), 0o600))
+
+	_, err = NewReloader(certFile, keyFile, clientCAFile)
+	require.Error(t, err)
+}
+
+func TestParseServiceIdentities(t *testing.T) {
+	identities, err := ParseServiceIdentities("ledger.internal=ledger; CN=reports,O=Bank=reporting")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"ledger.internal":   "ledger",
+		"CN=reports,O=Bank": "reporting",
+	}, identities)
+
+	identity, ok := ServiceIdentity(identities, &x509.Certificate{Subject: pkix.Name{CommonName: "ledger.internal"}})
+	require.True(t, ok)
+	require.Equal(t, "ledger", identity)
+
+	identity, ok = ServiceIdentity(identities, &x509.Certificate{Subject: pkix.Name{CommonName: "reports", Organization: []string{"Bank"}}})
+	require.True(t, ok)
+	require.Equal(t, "reporting", identity)
+
+	_, ok = ServiceIdentity(identities, &x509.Certificate{Subject: pkix.Name{CommonName: "unknown"}})
+	require.False(t, ok)
+
+	_, err = ParseServiceIdentities("missing-identity")
+	require.Error(t, err)
+}
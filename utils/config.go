@@ -7,12 +7,48 @@ import (
 )
 
 type Config struct {
-	DBSource             string        `mapstructure:"DB_SOURCE"`
-	HTTPServerAddress    string        `mapstructure:"HTTP_SERVER_ADDRESS"`
-	GRPCServerAddress    string        `mapstructure:"GRPC_SERVER_ADDRESS"`
-	TokenSymmetricKey    string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
-	AccessTokenDuration  time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
-	RefreshTokenDuration time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	DBSource                   string        `mapstructure:"DB_SOURCE"`
+	HTTPServerAddress          string        `mapstructure:"HTTP_SERVER_ADDRESS"`
+	GRPCServerAddress          string        `mapstructure:"GRPC_SERVER_ADDRESS"`
+	AdminServerAddress         string        `mapstructure:"ADMIN_SERVER_ADDRESS"`
+	TokenSymmetricKey          string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	AccessTokenDuration        time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
+	RefreshTokenDuration       time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	TraceExporter              string        `mapstructure:"TRACE_EXPORTER"`
+	TraceFilePath              string        `mapstructure:"TRACE_FILE_PATH"`
+	TraceSampleRatio           float64       `mapstructure:"TRACE_SAMPLE_RATIO"`
+	OTLPEndpoint               string        `mapstructure:"OTLP_ENDPOINT"`
+	OTLPInsecure               bool          `mapstructure:"OTLP_INSECURE"`
+	GRPCTLSCertFile            string        `mapstructure:"GRPC_TLS_CERT_FILE"`
+	GRPCTLSKeyFile             string        `mapstructure:"GRPC_TLS_KEY_FILE"`
+	GRPCClientCAFile           string        `mapstructure:"GRPC_CLIENT_CA_FILE"`
+	GRPCRequireClientCert      bool          `mapstructure:"GRPC_REQUIRE_CLIENT_CERT"`
+	HTTPTLSCertFile            string        `mapstructure:"HTTP_TLS_CERT_FILE"`
+	HTTPTLSKeyFile             string        `mapstructure:"HTTP_TLS_KEY_FILE"`
+	ServiceIdentities          string        `mapstructure:"SERVICE_IDENTITIES"`
+	RateLimitBackend           string        `mapstructure:"RATE_LIMIT_BACKEND"`
+	RateLimits                 string        `mapstructure:"RATE_LIMITS"`
+	TrustedProxies             string        `mapstructure:"TRUSTED_PROXIES"`
+	CORSAllowedOrigins         string        `mapstructure:"CORS_ALLOWED_ORIGINS"`
+	CORSAllowedMethods         string        `mapstructure:"CORS_ALLOWED_METHODS"`
+	CORSAllowedHeaders         string        `mapstructure:"CORS_ALLOWED_HEADERS"`
+	CORSAllowCredentials       bool          `mapstructure:"CORS_ALLOW_CREDENTIALS"`
+	CORSMaxAge                 time.Duration `mapstructure:"CORS_MAX_AGE"`
+	HSTSMaxAge                 time.Duration `mapstructure:"HSTS_MAX_AGE"`
+	ContentSecurityPolicy      string        `mapstructure:"CONTENT_SECURITY_POLICY"`
+	RefreshTokenCookie         bool          `mapstructure:"REFRESH_TOKEN_COOKIE"`
+	RefreshTokenCookieSecure   bool          `mapstructure:"REFRESH_TOKEN_COOKIE_SECURE"`
+	RefreshTokenCookieSameSite string        `mapstructure:"REFRESH_TOKEN_COOKIE_SAME_SITE"`
+	InterestEngineInterval     time.Duration `mapstructure:"INTEREST_ENGINE_INTERVAL"`
+	FeeEngineInterval          time.Duration `mapstructure:"FEE_ENGINE_INTERVAL"`
+	ApprovalThreshold          int32         `mapstructure:"APPROVAL_THRESHOLD"`
+	ApprovalTTL                time.Duration `mapstructure:"APPROVAL_TTL"`
+	ApprovalExpiryInterval     time.Duration `mapstructure:"APPROVAL_EXPIRY_INTERVAL"`
+	FraudRulesFile             string        `mapstructure:"FRAUD_RULES_FILE"`
+	FraudMonitorInterval       time.Duration `mapstructure:"FRAUD_MONITOR_INTERVAL"`
+	SanctionsListFile          string        `mapstructure:"SANCTIONS_LIST_FILE"`
+	SanctionsMatchThreshold    float64       `mapstructure:"SANCTIONS_MATCH_THRESHOLD"`
+	SanctionsRescreenInterval  time.Duration `mapstructure:"SANCTIONS_RESCREEN_INTERVAL"`
 }
 
 func LoadConfig(path string) (Config, error) {
@@ -3,6 +3,10 @@ package utils
 const (
 	CustomerRole = "customer"
 	BankerRole   = "banker"
+	// AuditorRole can only read the audit log.
+	AuditorRole = "auditor"
 )
 
 var SelfAndBanker = []string{CustomerRole, BankerRole}
+
+var Roles = []string{CustomerRole, BankerRole, AuditorRole}
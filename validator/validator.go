@@ -9,9 +9,13 @@ import (
 	"github.com/valkyraycho/bank_project/utils"
 )
 
+const MaxPageSize = 100
+
 var (
-	isValidUsername = regexp.MustCompile("^[a-z0-9_]+$").MatchString
-	isValidFullName = regexp.MustCompile("^[a-zA-Z\\s]+$").MatchString
+	isValidUsername  = regexp.MustCompile("^[a-z0-9_]+$").MatchString
+	isValidFullName  = regexp.MustCompile("^[a-zA-Z\\s]+$").MatchString
+	isValidReference = regexp.MustCompile(`^[A-Za-z0-9/?:().,'+ -]+$`).MatchString
+	isValidCategory  = regexp.MustCompile("^[a-z0-9_ -]+$").MatchString
 )
 
 func ValidateString(s string, minLength, maxLength int) error {
@@ -88,3 +92,43 @@ func ValidateAmount(amount int32) error {
 	}
 	return nil
 }
+
+func ValidatePageSize(size int32) error {
+	if size <= 0 || size > MaxPageSize {
+		return fmt.Errorf("must be between 1 and %d", MaxPageSize)
+	}
+	return nil
+}
+
+func ValidateRole(role string) error {
+	if !slices.Contains(utils.Roles, role) {
+		return fmt.Errorf("unsupported role")
+	}
+	return nil
+}
+
+func ValidateMemo(memo string) error {
+	return ValidateString(memo, 0, 140)
+}
+
+// ValidateReference accepts end-to-end references as payment networks carry
+// them: up to 35 characters of the SWIFT character set.
+func ValidateReference(reference string) error {
+	if err := ValidateString(reference, 1, 35); err != nil {
+		return err
+	}
+	if !isValidReference(reference) {
+		return fmt.Errorf("must contain only letters, digits, spaces, or / - ? : ( ) . , ' +")
+	}
+	return nil
+}
+
+func ValidateCategory(category string) error {
+	if err := ValidateString(category, 1, 50); err != nil {
+		return err
+	}
+	if !isValidCategory(category) {
+		return fmt.Errorf("must contain only lower case letters, digits, spaces, hyphens, or underscores")
+	}
+	return nil
+}
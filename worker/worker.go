@@ -0,0 +1,42 @@
+// Package worker runs background jobs on a fixed interval. Every replica of
+// the server runs them, so jobs must be idempotent and safe to run
+// concurrently.
+package worker
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrPeriodNotEnded is returned for a day or month that is not over yet.
+var ErrPeriodNotEnded = errors.New("period has not ended yet")
+
+// Run calls job right away and then every interval until ctx is done. Jobs
+// log their own failures; a failed run is simply retried by the next one.
+func Run(ctx context.Context, interval time.Duration, job func(context.Context)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		job(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// StartOfDay truncates t to midnight UTC.
+func StartOfDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// StartOfMonth truncates t to the first day of its month, UTC.
+func StartOfMonth(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
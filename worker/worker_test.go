@@ -0,0 +1,30 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	runs := 0
+	err := Run(ctx, time.Millisecond, func(context.Context) {
+		runs++
+		if runs == 3 {
+			cancel()
+		}
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, runs)
+}
+
+func TestStartOfPeriod(t *testing.T) {
+	at := time.Date(2024, 2, 29, 23, 30, 0, 0, time.FixedZone("UTC-2", -2*60*60))
+
+	require.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), StartOfDay(at))
+	require.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), StartOfMonth(at))
+}